@@ -0,0 +1,26 @@
+package backend
+
+import (
+	"github.com/loomnetwork/loomchain/log"
+	pv "github.com/loomnetwork/loomchain/privval"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// keyRotationApp passes the validator updates returned at the end of each block to a RotatingPV,
+// so the node switches to its next consensus key at the same height as the rest of the cluster.
+// EndBlock is called synchronously by the consensus engine, so the switch height is always known
+// before the node has to sign anything at that height.
+type keyRotationApp struct {
+	abci.Application
+	privVal *pv.RotatingPV
+}
+
+func (a *keyRotationApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	resp := a.Application.EndBlock(req)
+	if err := a.privVal.EndBlock(req.Height, resp.ValidatorUpdates); err != nil {
+		log.Error("Failed to save validator key rotation state", "height", req.Height, "err", err)
+	} else if switchHeight := a.privVal.SwitchHeight(); switchHeight == req.Height+2 {
+		log.Info("Validator key rotation scheduled", "switchHeight", switchHeight)
+	}
+	return resp
+}
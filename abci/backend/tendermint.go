@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	loom "github.com/loomnetwork/go-loom"
@@ -165,6 +166,8 @@ type OverrideConfig struct {
 	MempoolWalEnabled        bool
 	HsmConfig                *hsmpv.HsmConfig
 	FnConsensusReactorConfig *fnConsensus.ReactorConfigParsable
+	// Path to the priv validator file of the key the node's consensus key is being rotated to
+	NextPrivValidatorFile string
 }
 
 func (b *TendermintBackend) Init() (*loom.Validator, error) {
@@ -371,6 +374,21 @@ func (b *TendermintBackend) Start(app abci.Application) error {
 		return err
 	}
 
+	if b.OverrideCfg.NextPrivValidatorFile != "" {
+		nextPrivVal, err := pv.LoadFilePV(b.OverrideCfg.NextPrivValidatorFile)
+		if err != nil {
+			return errors.Wrap(err, "failed to load next priv validator")
+		}
+		rotationStateFile := filepath.Join(filepath.Dir(cfg.PrivValidatorFile()), "priv_validator_rotation.json")
+		lastHeight := app.Info(abci.RequestInfo{}).LastBlockHeight
+		rotatingPV, err := pv.NewRotatingPV(privVal, nextPrivVal, rotationStateFile, lastHeight)
+		if err != nil {
+			return errors.Wrap(err, "failed to load validator key rotation state")
+		}
+		privVal = rotatingPV
+		app = &keyRotationApp{Application: app, privVal: rotatingPV}
+	}
+
 	//Load genesis validators
 	genDoc, err := types.GenesisDocFromFile(cfg.GenesisFile())
 	if err != nil {
@@ -335,15 +335,27 @@ type ChainConfigManager interface {
 	UpdateConfig() (int, error)
 }
 
+// GovernanceHandler processes the proposals submitted to the Governance contract.
+type GovernanceHandler interface {
+	// TallyProposals closes voting on proposals whose voting period has ended, and returns the IDs
+	// of passed proposals that are ready to be executed.
+	TallyProposals() ([]uint64, error)
+	// ExecuteProposal executes the contract call carried by a passed proposal.
+	ExecuteProposal(id uint64) error
+	// SetProposalFailed marks a passed proposal as failed when its execution returns an error.
+	SetProposalFailed(id uint64, reason error) error
+}
+
 type GetValidatorSet func(state State) (loom.ValidatorSet, error)
 
 type ValidatorsManagerFactoryFunc func(state State) (ValidatorsManager, error)
 
 type ChainConfigManagerFactoryFunc func(state State) (ChainConfigManager, error)
 
+type GovernanceHandlerFactoryFunc func(state State) (GovernanceHandler, error)
+
 type CommittedTx struct {
 	result TxHandlerResult
-	txHash []byte
 }
 
 type Application struct {
@@ -363,12 +375,15 @@ type Application struct {
 	// Callback function used to construct a contract upkeep handler at the start of each block,
 	// should return a nil handler when the contract upkeep feature is disabled.
 	CreateContractUpkeepHandler func(state State) (KarmaHandler, error)
-	GetValidatorSet             GetValidatorSet
-	EventStore                  store.EventStore
-	config                      *cctypes.Config
-	childTxRefs                 []evmaux.ChildTxRef // links Tendermint txs to EVM txs
-	ReceiptsVersion             int32
-	committedTxs                []CommittedTx
+	// Callback function used to construct a governance handler at the start of each block,
+	// should return a nil handler when on-chain governance is disabled.
+	CreateGovernanceHandler GovernanceHandlerFactoryFunc
+	GetValidatorSet         GetValidatorSet
+	EventStore              store.EventStore
+	config                  *cctypes.Config
+	childTxRefs             []evmaux.ChildTxRef // links Tendermint txs to EVM txs
+	ReceiptsVersion         int32
+	committedTxs            []CommittedTx
 }
 
 var _ abci.Application = &Application{}
@@ -569,9 +584,91 @@ func (a *Application) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginB
 
 	storeTx.Commit()
 
+	if a.CreateGovernanceHandler != nil {
+		a.processGovernanceProposals()
+	}
+
 	return abci.ResponseBeginBlock{}
 }
 
+// processGovernanceProposals executes governance proposals that have passed, each proposal is
+// executed in a separate store tx so that a failed proposal doesn't leave any partial changes
+// behind, or affect the execution of other proposals. Errors are logged rather than returned since
+// a bad proposal must never halt the chain.
+func (a *Application) processGovernanceProposals() {
+	createHandler := func(storeTx store.KVStoreTx) (GovernanceHandler, error) {
+		state := NewStoreState(
+			context.Background(),
+			storeTx,
+			a.curBlockHeader,
+			a.curBlockHash,
+			a.GetValidatorSet,
+		).WithOnChainConfig(a.config)
+		return a.CreateGovernanceHandler(state)
+	}
+
+	storeTx := store.WrapAtomic(a.Store).BeginTx()
+	handler, err := createHandler(storeTx)
+	if err != nil || handler == nil {
+		if err != nil {
+			log.Error("Failed to create governance handler", "err", err)
+		}
+		storeTx.Rollback()
+		return
+	}
+	proposalIDs, err := handler.TallyProposals()
+	if err != nil {
+		log.Error("Failed to tally governance proposals", "err", err)
+		storeTx.Rollback()
+		return
+	}
+	storeTx.Commit()
+
+	for _, id := range proposalIDs {
+		storeTx = store.WrapAtomic(a.Store).BeginTx()
+		if execErr := a.executeGovernanceProposal(storeTx, createHandler, id); execErr != nil {
+			storeTx.Rollback()
+			storeTx = store.WrapAtomic(a.Store).BeginTx()
+			handler, err := createHandler(storeTx)
+			if err == nil {
+				err = handler.SetProposalFailed(id, execErr)
+			}
+			if err != nil {
+				log.Error("Failed to mark governance proposal as failed", "id", id, "err", err)
+				storeTx.Rollback()
+				continue
+			}
+		}
+		storeTx.Commit()
+	}
+
+	if len(proposalIDs) > 0 {
+		// proposals may have changed the on-chain config
+		a.config = nil
+	}
+}
+
+// executeGovernanceProposal executes a single proposal, a panic in the contract called by the
+// proposal is converted to an error so the proposal can be marked as failed.
+func (a *Application) executeGovernanceProposal(
+	storeTx store.KVStoreTx,
+	createHandler func(store.KVStoreTx) (GovernanceHandler, error),
+	id uint64,
+) (err error) {
+	defer func() {
+		if rval := recover(); rval != nil {
+			log.Error("Panic in governance proposal", "id", id, "rvalue", rval)
+			err = rvalError(rval)
+		}
+	}()
+
+	handler, err := createHandler(storeTx)
+	if err != nil {
+		return err
+	}
+	return handler.ExecuteProposal(id)
+}
+
 func (a *Application) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
 	defer func(begin time.Time) {
 		lvs := []string{"method", "EndBlock"}
@@ -668,6 +765,7 @@ func (a *Application) CheckTx(txBytes []byte) abci.ResponseCheckTx {
 		return abci.ResponseCheckTx{Code: 1, Log: err.Error()}
 	}
 
+	a.EventHandler.EthSubscriptionSet().EmitPendingTx(txBytes)
 	return abci.ResponseCheckTx{Code: abci.CodeTypeOK}
 }
 
@@ -750,9 +848,6 @@ func (a *Application) processTx(storeTx store.KVStoreTx, txBytes []byte, isCheck
 			reader := a.ReceiptHandlerProvider.Reader()
 			if reader.GetCurrentReceipt() != nil {
 				receiptTxHash := reader.GetCurrentReceipt().TxHash
-				if err := a.EventHandler.EthSubscriptionSet().EmitTxEvent(receiptTxHash); err != nil {
-					log.Error("failed to emit tx event to subscribers", "err", err)
-				}
 				txHash := ttypes.Tx(txBytes).Hash()
 				// If a receipt was generated for an EVM tx add a link between the TM tx hash and the EVM tx hash
 				// so that we can use it to lookup relevant events using the TM tx hash.
@@ -815,7 +910,6 @@ func (a *Application) deliverTx2(storeTx store.KVStoreTx, txBytes []byte) abci.R
 
 	a.committedTxs = append(a.committedTxs, CommittedTx{
 		result: r,
-		txHash: receiptTxHash,
 	})
 
 	return abci.ResponseDeliverTx{Code: abci.CodeTypeOK, Data: r.Data, Tags: r.Tags, Info: r.Info}
@@ -867,12 +961,6 @@ func (a *Application) Commit() abci.ResponseCommit {
 			if err := a.EventHandler.LegacyEthSubscriptionSet().EmitTxEvent(tx.result.Data, tx.result.Info); err != nil {
 				log.Error("Emit Tx Event error", "err", err)
 			}
-
-			if len(tx.txHash) > 0 {
-				if err := a.EventHandler.EthSubscriptionSet().EmitTxEvent(tx.txHash); err != nil {
-					log.Error("failed to emit tx event to subscribers", "err", err)
-				}
-			}
 		}
 	}(height, a.curBlockHeader, a.committedTxs)
 	a.committedTxs = nil
@@ -14,6 +14,7 @@ import (
 	loom "github.com/loomnetwork/go-loom"
 	"github.com/loomnetwork/go-loom/util"
 	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/store"
 )
 
@@ -159,14 +160,46 @@ func (n *NonceHandler) Nonce(
 		}
 	}
 
+	if isCheckTx {
+		recordNonceCheck(state.Context(), origin, tx.Sequence, seq)
+	}
+
 	if tx.Sequence != seq {
 		nonceErrorCount.Add(1)
 		return r, fmt.Errorf("sequence number does not match expected %d got %d", seq, tx.Sequence)
 	}
 
+	if state.FeatureEnabled(features.TxBindingFeature, false) {
+		if err := checkTxBinding(state, txBytes); err != nil {
+			return r, err
+		}
+	}
+
 	return next(state, tx.Inner, isCheckTx)
 }
 
+// checkTxBinding verifies that a tx wrapped in a BoundNonceTx is being executed on the chain it was
+// signed for, and that it hasn't expired yet. Txs that aren't bound to a chain are always valid.
+func checkTxBinding(state loomchain.State, nonceTxBytes []byte) error {
+	var tx BoundNonceTx
+	if err := proto.Unmarshal(nonceTxBytes, &tx); err != nil {
+		return err
+	}
+	if tx.Binding == nil {
+		return nil
+	}
+	if tx.Binding.Version != 1 {
+		return fmt.Errorf("unsupported tx binding version %d", tx.Binding.Version)
+	}
+	if tx.Binding.ChainId != state.Block().ChainID {
+		return fmt.Errorf("tx is bound to chain %s", tx.Binding.ChainId)
+	}
+	if tx.Binding.ValidUntilHeight != 0 && uint64(state.Block().Height) > tx.Binding.ValidUntilHeight {
+		return fmt.Errorf("tx expired at block %d", tx.Binding.ValidUntilHeight)
+	}
+	return nil
+}
+
 func (n *NonceHandler) IncNonce(
 	state loomchain.State,
 	txBytes []byte,
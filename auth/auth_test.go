@@ -14,6 +14,7 @@ import (
 	"github.com/loomnetwork/go-loom/auth"
 	"github.com/loomnetwork/go-loom/config"
 	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/store"
 )
 
@@ -221,3 +222,56 @@ func TestRevertedTxNonceMiddleware(t *testing.T) {
 	currentNonce = Nonce(state, origin)
 	require.Equal(t, uint64(2), currentNonce)
 }
+
+func TestTxBindingNonceMiddleware(t *testing.T) {
+	pubkey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	origin := loom.Address{
+		ChainID: "default",
+		Local:   loom.LocalAddressFromPublicKey(pubkey),
+	}
+	inner := []byte{1, 2, 3}
+
+	processTx := func(binding *TxBinding, height int64, featureEnabled bool) error {
+		nonceTxBytes, err := proto.Marshal(&BoundNonceTx{
+			Inner:    inner,
+			Sequence: 1,
+			Binding:  binding,
+		})
+		require.NoError(t, err)
+
+		ctx := context.WithValue(context.Background(), ContextKeyOrigin, origin)
+		kvStore := store.NewMemStore()
+		state := loomchain.NewStoreState(ctx, kvStore, abci.Header{ChainID: "default", Height: height}, nil, nil).
+			WithOnChainConfig(config.DefaultConfig())
+		state.SetFeature(features.TxBindingFeature, featureEnabled)
+		_, err = NewNonceHandler().Nonce(state, kvStore, nonceTxBytes,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				require.Equal(t, inner, txBytes)
+				return loomchain.TxHandlerResult{}, nil
+			}, false,
+		)
+		return err
+	}
+
+	// unbound txs are always valid
+	require.NoError(t, processTx(nil, 50, true))
+
+	binding := &TxBinding{Version: 1, ChainId: "default", ValidUntilHeight: 40}
+	require.NoError(t, processTx(binding, 40, true))
+	require.Error(t, processTx(binding, 41, true))
+	// binding is ignored until the feature is enabled
+	require.NoError(t, processTx(binding, 41, false))
+
+	require.NoError(t, processTx(&TxBinding{Version: 1, ChainId: "default"}, 1000, true))
+	require.Error(t, processTx(&TxBinding{Version: 1, ChainId: "other", ValidUntilHeight: 40}, 30, true))
+	require.Error(t, processTx(&TxBinding{Version: 2, ChainId: "default", ValidUntilHeight: 40}, 30, true))
+
+	// the binding must survive NonceTx decoding by middleware that doesn't know about it
+	boundTxBytes, err := proto.Marshal(&BoundNonceTx{Inner: inner, Sequence: 1, Binding: binding})
+	require.NoError(t, err)
+	var nonceTx NonceTx
+	require.NoError(t, proto.Unmarshal(boundTxBytes, &nonceTx))
+	require.Equal(t, inner, nonceTx.Inner)
+	require.Equal(t, uint64(1), nonceTx.Sequence)
+}
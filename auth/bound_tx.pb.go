@@ -0,0 +1,157 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/auth/bound_tx.proto
+
+package auth
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type BoundNonceTx struct {
+	Inner                []byte     `protobuf:"bytes,1,opt,name=inner,proto3" json:"inner,omitempty"`
+	Sequence             uint64     `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Binding              *TxBinding `protobuf:"bytes,3,opt,name=binding,proto3" json:"binding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BoundNonceTx) Reset()         { *m = BoundNonceTx{} }
+func (m *BoundNonceTx) String() string { return proto.CompactTextString(m) }
+func (*BoundNonceTx) ProtoMessage()    {}
+func (*BoundNonceTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f428b69dfa7f48, []int{0}
+}
+func (m *BoundNonceTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoundNonceTx.Unmarshal(m, b)
+}
+func (m *BoundNonceTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoundNonceTx.Marshal(b, m, deterministic)
+}
+func (m *BoundNonceTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoundNonceTx.Merge(m, src)
+}
+func (m *BoundNonceTx) XXX_Size() int {
+	return xxx_messageInfo_BoundNonceTx.Size(m)
+}
+func (m *BoundNonceTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoundNonceTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BoundNonceTx proto.InternalMessageInfo
+
+func (m *BoundNonceTx) GetInner() []byte {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *BoundNonceTx) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *BoundNonceTx) GetBinding() *TxBinding {
+	if m != nil {
+		return m.Binding
+	}
+	return nil
+}
+
+type TxBinding struct {
+	Version              uint32   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainId              string   `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ValidUntilHeight     uint64   `protobuf:"varint,3,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxBinding) Reset()         { *m = TxBinding{} }
+func (m *TxBinding) String() string { return proto.CompactTextString(m) }
+func (*TxBinding) ProtoMessage()    {}
+func (*TxBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47f428b69dfa7f48, []int{1}
+}
+func (m *TxBinding) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxBinding.Unmarshal(m, b)
+}
+func (m *TxBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxBinding.Marshal(b, m, deterministic)
+}
+func (m *TxBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxBinding.Merge(m, src)
+}
+func (m *TxBinding) XXX_Size() int {
+	return xxx_messageInfo_TxBinding.Size(m)
+}
+func (m *TxBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxBinding proto.InternalMessageInfo
+
+func (m *TxBinding) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *TxBinding) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *TxBinding) GetValidUntilHeight() uint64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BoundNonceTx)(nil), "auth.BoundNonceTx")
+	proto.RegisterType((*TxBinding)(nil), "auth.TxBinding")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/auth/bound_tx.proto", fileDescriptor_47f428b69dfa7f48)
+}
+
+var fileDescriptor_47f428b69dfa7f48 = []byte{
+	// 228 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x8f, 0xc1, 0x4e, 0x83, 0x40,
+	0x10, 0x86, 0x83, 0xa2, 0xb4, 0x63, 0x8d, 0x66, 0xe3, 0x01, 0x3d, 0x91, 0x9e, 0x30, 0x31, 0x90,
+	0xa8, 0x4f, 0xd0, 0x93, 0x5e, 0x3c, 0x6c, 0xea, 0x99, 0x00, 0xbb, 0x61, 0x27, 0xa5, 0x33, 0x4a,
+	0x77, 0x2b, 0x8f, 0x6f, 0x98, 0xa6, 0xdc, 0xe6, 0xfb, 0xfe, 0x64, 0xfe, 0x19, 0x78, 0xef, 0xd0,
+	0xbb, 0xd0, 0x14, 0x2d, 0xef, 0xcb, 0x9e, 0x79, 0x4f, 0xd6, 0xff, 0xf1, 0xb0, 0x93, 0xb9, 0x75,
+	0x35, 0x52, 0x59, 0x07, 0xef, 0xca, 0x86, 0x03, 0x99, 0xca, 0x8f, 0xc5, 0xcf, 0xc0, 0x9e, 0x55,
+	0x3c, 0xc9, 0xf5, 0x0e, 0x56, 0x9b, 0xc9, 0x7f, 0x31, 0xb5, 0x76, 0x3b, 0xaa, 0x07, 0xb8, 0x42,
+	0x22, 0x3b, 0xa4, 0x51, 0x16, 0xe5, 0x2b, 0x7d, 0x02, 0xf5, 0x04, 0x8b, 0x83, 0xfd, 0x0d, 0x96,
+	0x5a, 0x9b, 0x5e, 0x64, 0x51, 0x1e, 0xeb, 0x99, 0xd5, 0x33, 0x24, 0x0d, 0x92, 0x41, 0xea, 0xd2,
+	0xcb, 0x2c, 0xca, 0x6f, 0x5e, 0xef, 0x8a, 0x69, 0x73, 0xb1, 0x1d, 0x37, 0x27, 0xad, 0xcf, 0xf9,
+	0x9a, 0x60, 0x39, 0x5b, 0x95, 0x42, 0x72, 0xb4, 0xc3, 0x01, 0x99, 0xa4, 0xeb, 0x56, 0x9f, 0x51,
+	0x3d, 0xc2, 0x42, 0xce, 0xae, 0xd0, 0x48, 0xdb, 0x52, 0x27, 0xc2, 0x9f, 0x46, 0xbd, 0x80, 0x3a,
+	0xd6, 0x3d, 0x9a, 0x2a, 0x90, 0xc7, 0xbe, 0x72, 0x16, 0x3b, 0xe7, 0xa5, 0x37, 0xd6, 0xf7, 0x92,
+	0x7c, 0x4f, 0xc1, 0x87, 0xf8, 0xe6, 0x5a, 0x3e, 0x7d, 0xfb, 0x1f, 0x00, 0x15, 0xc4, 0x4d, 0x53,
+	0x21, 0x01, 0x00, 0x00,
+}
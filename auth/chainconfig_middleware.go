@@ -11,25 +11,41 @@ import (
 
 // NewChainConfigMiddleware returns middleware that verifies signed txs using either
 // SignedTxMiddleware or MultiChainSignatureTxMiddleware, it switches the underlying middleware
-// based on the on-chain and off-chain auth config settings.
+// based on the on-chain and off-chain auth config settings. Txs sent from multisig accounts are
+// verified by MultisigTxMiddleware once the auth:multisig feature is enabled, and txs submitted by
+// relayers are verified by MetaTxMiddleware once the auth:meta-tx feature is enabled.
 func NewChainConfigMiddleware(
 	authConfig *Config,
 	createAddressMapperCtx func(state loomchain.State) (contractpb.StaticContext, error),
+	createMultisigCtx func(state loomchain.State) (contractpb.StaticContext, error),
+	createSessionKeysCtx func(state loomchain.State) (contractpb.StaticContext, error),
 ) loomchain.TxMiddlewareFunc {
-	return loomchain.TxMiddlewareFunc(func(
+	var chainConfigMiddleware loomchain.TxMiddlewareFunc
+	chainConfigMiddleware = loomchain.TxMiddlewareFunc(func(
 		state loomchain.State,
 		txBytes []byte,
 		next loomchain.TxHandlerFunc,
 		isCheckTx bool,
 	) (loomchain.TxHandlerResult, error) {
+		if state.FeatureEnabled(features.MetaTxFeature, false) && IsMetaTx(txBytes) {
+			mw := NewMetaTxMiddleware(chainConfigMiddleware)
+			return mw(state, txBytes, next, isCheckTx)
+		}
+
+		if state.FeatureEnabled(features.MultisigAccountFeature, false) && IsMultiSignedTx(txBytes) {
+			mw := NewMultisigTxMiddleware(createMultisigCtx)
+			return mw(state, txBytes, next, isCheckTx)
+		}
+
 		chains := getEnabledChains(authConfig.Chains, state)
 		if len(chains) > 0 {
-			mw := NewMultiChainSignatureTxMiddleware(chains, createAddressMapperCtx)
+			mw := NewMultiChainSignatureTxMiddleware(chains, createAddressMapperCtx, createSessionKeysCtx)
 			return mw(state, txBytes, next, isCheckTx)
 		}
 
 		return SignatureTxMiddleware(state, txBytes, next, isCheckTx)
 	})
+	return chainConfigMiddleware
 }
 
 // Filters out any auth.ChainConfig(s) that haven't been enabled by the majority of validators.
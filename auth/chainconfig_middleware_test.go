@@ -38,7 +38,12 @@ func TestChainConfigMiddlewareSingleChain(t *testing.T) {
 		Chains: map[string]ChainConfig{},
 	}
 
-	chainConfigMiddleware := NewChainConfigMiddleware(&authConfig, func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil })
+	chainConfigMiddleware := NewChainConfigMiddleware(
+		&authConfig,
+		func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+	)
 	_, err = chainConfigMiddleware.ProcessTx(state, signedTxBytes,
 		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
 			require.Equal(t, txBytes, origBytes)
@@ -85,6 +90,8 @@ func TestChainConfigMiddlewareMultipleChain(t *testing.T) {
 	tmx := NewChainConfigMiddleware(
 		&authConfig,
 		func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
 	)
 
 	txSigned := mockEd25519SignedTx(t, priKey1)
@@ -1,5 +1,12 @@
 package auth
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/loomnetwork/go-loom/common/evmcompat"
+)
+
 type Config struct {
 	// Per-chain tx signing config, indexed by chain ID
 	Chains map[string]ChainConfig
@@ -8,6 +15,36 @@ type Config struct {
 type ChainConfig struct {
 	TxType SignedTxType
 	AccountType
+	// Signature types that can be used to sign txs originating from this chain, if none are
+	// specified the built-in defaults for the chain ID will be used. Valid values are "eip712",
+	// "geth", "trezor", "tron", and "binance". Only applies once mw:mulcsigtx:v1.1 is enabled,
+	// and is overridden by the on-chain signature type flags once auth:sigtypes:v1.0 is enabled.
+	SignatureTypes []string
+}
+
+var signatureTypesByName = map[string]evmcompat.SignatureType{
+	"eip712":  evmcompat.SignatureType_EIP712,
+	"geth":    evmcompat.SignatureType_GETH,
+	"trezor":  evmcompat.SignatureType_TREZOR,
+	"tron":    evmcompat.SignatureType_TRON,
+	"binance": evmcompat.SignatureType_BINANCE,
+}
+
+// signatureTypeNames lists the names in signatureTypesByName in a fixed order, so the signature
+// types enabled via on-chain flags are always returned in the same order.
+var signatureTypeNames = []string{"eip712", "geth", "trezor", "tron", "binance"}
+
+// AllowedSignatureTypes returns the signature types listed in the chain config.
+func (c ChainConfig) AllowedSignatureTypes() ([]evmcompat.SignatureType, error) {
+	sigTypes := make([]evmcompat.SignatureType, 0, len(c.SignatureTypes))
+	for _, name := range c.SignatureTypes {
+		sigType, found := signatureTypesByName[strings.ToLower(name)]
+		if !found {
+			return nil, fmt.Errorf("unknown signature type %s", name)
+		}
+		sigTypes = append(sigTypes, sigType)
+	}
+	return sigTypes, nil
 }
 
 func DefaultConfig() *Config {
@@ -27,11 +64,27 @@ func (c *Config) Clone() *Config {
 	clone := *c
 	clone.Chains = make(map[string]ChainConfig, len(c.Chains))
 	for k, v := range c.Chains {
+		if v.SignatureTypes != nil {
+			v.SignatureTypes = append([]string(nil), v.SignatureTypes...)
+		}
 		clone.Chains[k] = v
 	}
 	return &clone
 }
 
+// Validate checks that every chain config has a known tx type & valid signature types.
+func (c *Config) Validate() error {
+	for chainID, chain := range c.Chains {
+		if _, found := originRecoveryFuncs[chain.TxType]; !found {
+			return fmt.Errorf("unsupported tx type %s for chain ID %s", chain.TxType, chainID)
+		}
+		if _, err := chain.AllowedSignatureTypes(); err != nil {
+			return fmt.Errorf("invalid signature types for chain ID %s: %v", chainID, err)
+		}
+	}
+	return nil
+}
+
 func (c *Config) AddressMapperContractRequired() bool {
 	for _, v := range c.Chains {
 		if v.AccountType == MappedAccountType {
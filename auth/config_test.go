@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/loomnetwork/go-loom/common/evmcompat"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+)
+
+func TestConfigValidate(t *testing.T) {
+	cfg := DefaultConfig()
+	require.NoError(t, cfg.Validate())
+
+	cfg.Chains["tron"] = ChainConfig{TxType: TronSignedTxType, SignatureTypes: []string{"TRON"}}
+	require.NoError(t, cfg.Validate())
+
+	cfg.Chains["tron"] = ChainConfig{TxType: TronSignedTxType, SignatureTypes: []string{"tron", "schnorr"}}
+	require.Error(t, cfg.Validate())
+
+	cfg.Chains["tron"] = ChainConfig{TxType: "solana"}
+	require.Error(t, cfg.Validate())
+
+	RegisterOriginRecoveryFunc("solana", verifyEd25519)
+	defer delete(originRecoveryFuncs, "solana")
+	require.NoError(t, cfg.Validate())
+	require.Panics(t, func() { RegisterOriginRecoveryFunc("solana", verifyEd25519) })
+
+	clone := cfg.Clone()
+	clone.Chains["eth"] = ChainConfig{TxType: EthereumSignedTxType, SignatureTypes: []string{"geth"}}
+	require.Nil(t, cfg.Chains["eth"].SignatureTypes)
+}
+
+func TestAllowedSignatureTypes(t *testing.T) {
+	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: "default"}, nil, nil)
+	chain := ChainConfig{
+		TxType:         EthereumSignedTxType,
+		SignatureTypes: []string{"eip712", "binance"},
+	}
+
+	// the config is ignored until mw:mulcsigtx:v1.1 is enabled
+	sigTypes, err := getAllowedSignatureTypes(state, "eth", chain)
+	require.NoError(t, err)
+	require.Contains(t, sigTypes, evmcompat.SignatureType_TRON)
+	require.NotContains(t, sigTypes, evmcompat.SignatureType_BINANCE)
+
+	state.SetFeature(features.MultiChainSigTxMiddlewareVersion1_1, true)
+	sigTypes, err = getAllowedSignatureTypes(state, "eth", chain)
+	require.NoError(t, err)
+	require.Equal(t, []evmcompat.SignatureType{
+		evmcompat.SignatureType_EIP712,
+		evmcompat.SignatureType_BINANCE,
+	}, sigTypes)
+
+	// chains without any configured signature types fall back to the defaults
+	sigTypes, err = getAllowedSignatureTypes(state, "eth", ChainConfig{TxType: EthereumSignedTxType})
+	require.NoError(t, err)
+	require.Equal(t, []evmcompat.SignatureType{
+		evmcompat.SignatureType_EIP712,
+		evmcompat.SignatureType_GETH,
+		evmcompat.SignatureType_TREZOR,
+	}, sigTypes)
+
+	// on-chain signature type flags are ignored until auth:sigtypes:v1.0 is enabled
+	state.SetFeature(features.AuthSigTypeFeaturePrefix+"eth:geth", true)
+	sigTypes, err = getAllowedSignatureTypes(state, "eth", chain)
+	require.NoError(t, err)
+	require.Equal(t, []evmcompat.SignatureType{
+		evmcompat.SignatureType_EIP712,
+		evmcompat.SignatureType_BINANCE,
+	}, sigTypes)
+
+	// and then override the loom.yml for chains that have at least one flag enabled
+	state.SetFeature(features.AuthSigTypesFeature, true)
+	sigTypes, err = getAllowedSignatureTypes(state, "eth", chain)
+	require.NoError(t, err)
+	require.Equal(t, []evmcompat.SignatureType{evmcompat.SignatureType_GETH}, sigTypes)
+
+	sigTypes, err = getAllowedSignatureTypes(state, "tron", ChainConfig{
+		TxType:         TronSignedTxType,
+		SignatureTypes: []string{"tron"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []evmcompat.SignatureType{evmcompat.SignatureType_TRON}, sigTypes)
+}
@@ -0,0 +1,98 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/auth/meta_tx.proto
+
+package auth
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MetaTx struct {
+	Inner                []byte   `protobuf:"bytes,1,opt,name=inner,proto3" json:"inner,omitempty"`
+	RelayerSignature     []byte   `protobuf:"bytes,6,opt,name=relayer_signature,json=relayerSignature,proto3" json:"relayer_signature,omitempty"`
+	RelayerPublicKey     []byte   `protobuf:"bytes,7,opt,name=relayer_public_key,json=relayerPublicKey,proto3" json:"relayer_public_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MetaTx) Reset()         { *m = MetaTx{} }
+func (m *MetaTx) String() string { return proto.CompactTextString(m) }
+func (*MetaTx) ProtoMessage()    {}
+func (*MetaTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89561b07d563c8fc, []int{0}
+}
+func (m *MetaTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MetaTx.Unmarshal(m, b)
+}
+func (m *MetaTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MetaTx.Marshal(b, m, deterministic)
+}
+func (m *MetaTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetaTx.Merge(m, src)
+}
+func (m *MetaTx) XXX_Size() int {
+	return xxx_messageInfo_MetaTx.Size(m)
+}
+func (m *MetaTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetaTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetaTx proto.InternalMessageInfo
+
+func (m *MetaTx) GetInner() []byte {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *MetaTx) GetRelayerSignature() []byte {
+	if m != nil {
+		return m.RelayerSignature
+	}
+	return nil
+}
+
+func (m *MetaTx) GetRelayerPublicKey() []byte {
+	if m != nil {
+		return m.RelayerPublicKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MetaTx)(nil), "auth.MetaTx")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/auth/meta_tx.proto", fileDescriptor_89561b07d563c8fc)
+}
+
+var fileDescriptor_89561b07d563c8fc = []byte{
+	// 164 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0xcf, 0x2c, 0xc9,
+	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0xcf, 0xc9, 0xcf, 0xcf, 0xcd, 0x4b, 0x2d, 0x29, 0xcf,
+	0x2f, 0xca, 0x06, 0xb3, 0x93, 0x33, 0x12, 0x33, 0xf3, 0xf4, 0x13, 0x4b, 0x4b, 0x32, 0xf4, 0x73,
+	0x53, 0x4b, 0x12, 0xe3, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x58, 0x40, 0x62,
+	0x4a, 0x95, 0x5c, 0x6c, 0xbe, 0xa9, 0x25, 0x89, 0x21, 0x15, 0x42, 0x22, 0x5c, 0xac, 0x99, 0x79,
+	0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x97, 0x60,
+	0x51, 0x6a, 0x4e, 0x62, 0x65, 0x6a, 0x51, 0x7c, 0x71, 0x66, 0x7a, 0x5e, 0x62, 0x49, 0x69, 0x51,
+	0xaa, 0x04, 0x1b, 0x58, 0x85, 0x00, 0x54, 0x22, 0x18, 0x26, 0x2e, 0xa4, 0xc3, 0x25, 0x04, 0x53,
+	0x5c, 0x50, 0x9a, 0x94, 0x93, 0x99, 0x1c, 0x9f, 0x9d, 0x5a, 0x29, 0xc1, 0x8e, 0xa2, 0x3a, 0x00,
+	0x2c, 0xe1, 0x9d, 0x5a, 0x99, 0xc4, 0x06, 0x76, 0x87, 0x31, 0x60, 0x00, 0x5e, 0xaf, 0x3f, 0x32,
+	0xbe, 0x00, 0x00, 0x00,
+}
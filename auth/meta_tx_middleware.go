@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/loomchain"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+)
+
+var (
+	ContextKeyRelayer = contextKey("relayer")
+)
+
+// Relayer returns the address of the account that submitted the current tx on behalf of the tx
+// origin, or an empty address if the tx wasn't relayed.
+func Relayer(ctx context.Context) loom.Address {
+	relayer, _ := ctx.Value(ContextKeyRelayer).(loom.Address)
+	return relayer
+}
+
+// NewMetaTxMiddleware returns tx signing middleware that verifies the relayer signature on a
+// MetaTx, and then passes the inner SignedTx to the given middleware to verify the user signature.
+// The origin of the tx is set by the inner middleware to the user, so only the user's nonce is
+// incremented, the relayer address is recorded in the context so the relayer can be charged any
+// tx fees.
+func NewMetaTxMiddleware(verifyInnerTx loomchain.TxMiddlewareFunc) loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (loomchain.TxHandlerResult, error) {
+		var r loomchain.TxHandlerResult
+
+		if !Relayer(state.Context()).IsEmpty() {
+			return r, errors.New("nested meta txs are not allowed")
+		}
+
+		var tx MetaTx
+		if err := proto.Unmarshal(txBytes, &tx); err != nil {
+			return r, errors.Wrap(err, "failed to unmarshal MetaTx")
+		}
+		if len(tx.RelayerPublicKey) != ed25519.PublicKeySize {
+			return r, errors.New("invalid relayer public key length")
+		}
+		if len(tx.RelayerSignature) != ed25519.SignatureSize {
+			return r, errors.New("invalid relayer signature length")
+		}
+		if !ed25519.Verify(tx.RelayerPublicKey, tx.Inner, tx.RelayerSignature) {
+			return r, errors.New("invalid relayer signature")
+		}
+
+		relayer := loom.Address{
+			ChainID: state.Block().ChainID,
+			Local:   loom.LocalAddressFromPublicKey(tx.RelayerPublicKey),
+		}
+		ctx := context.WithValue(state.Context(), ContextKeyRelayer, relayer)
+		return verifyInnerTx(state.WithContext(ctx), tx.Inner, next, isCheckTx)
+	})
+}
+
+// IsMetaTx checks if the given tx bytes contain a MetaTx rather than a SignedTx.
+func IsMetaTx(txBytes []byte) bool {
+	var signedTx SignedTx
+	if err := proto.Unmarshal(txBytes, &signedTx); err != nil {
+		return false
+	}
+	if len(signedTx.Signature) > 0 || len(signedTx.PublicKey) > 0 {
+		return false
+	}
+	var tx MetaTx
+	if err := proto.Unmarshal(txBytes, &tx); err != nil {
+		return false
+	}
+	return len(tx.RelayerSignature) > 0
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	lauth "github.com/loomnetwork/go-loom/auth"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+)
+
+func TestMetaTxMiddleware(t *testing.T) {
+	origBytes := []byte("hello")
+	userPubKey, userPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	relayerPubKey, relayerPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	user := loom.Address{ChainID: "default", Local: loom.LocalAddressFromPublicKey(userPubKey)}
+	relayer := loom.Address{ChainID: "default", Local: loom.LocalAddressFromPublicKey(relayerPubKey)}
+
+	signedTxBytes, err := proto.Marshal(lauth.SignTx(lauth.NewEd25519Signer(userPrivKey), origBytes))
+	require.NoError(t, err)
+	metaTx := &MetaTx{
+		Inner:            signedTxBytes,
+		RelayerSignature: ed25519.Sign(relayerPrivKey, signedTxBytes),
+		RelayerPublicKey: relayerPubKey,
+	}
+	metaTxBytes, err := proto.Marshal(metaTx)
+	require.NoError(t, err)
+	require.True(t, IsMetaTx(metaTxBytes))
+	require.False(t, IsMetaTx(signedTxBytes))
+
+	mw := NewChainConfigMiddleware(
+		&Config{Chains: map[string]ChainConfig{}},
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+	)
+	processTx := func(txBytes []byte, metaTxEnabled bool) error {
+		state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: "default"}, nil, nil)
+		state.SetFeature(features.MetaTxFeature, metaTxEnabled)
+		_, err := mw.ProcessTx(state, txBytes,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				require.Equal(t, origBytes, txBytes)
+				require.Equal(t, user, Origin(state.Context()))
+				require.Equal(t, relayer, Relayer(state.Context()))
+				return loomchain.TxHandlerResult{}, nil
+			}, false,
+		)
+		return err
+	}
+
+	require.NoError(t, processTx(metaTxBytes, true))
+	// meta txs are only accepted once the feature is enabled
+	require.Error(t, processTx(metaTxBytes, false))
+
+	// invalid relayer signature
+	invalidTx := *metaTx
+	invalidTx.RelayerSignature = ed25519.Sign(relayerPrivKey, origBytes)
+	txBytes, err := proto.Marshal(&invalidTx)
+	require.NoError(t, err)
+	require.Error(t, processTx(txBytes, true))
+
+	// meta txs can't be nested
+	nestedTx := &MetaTx{
+		Inner:            metaTxBytes,
+		RelayerSignature: ed25519.Sign(relayerPrivKey, metaTxBytes),
+		RelayerPublicKey: relayerPubKey,
+	}
+	txBytes, err = proto.Marshal(nestedTx)
+	require.NoError(t, err)
+	require.Error(t, processTx(txBytes, true))
+}
@@ -43,11 +43,38 @@ const (
 // Recovers the signer address from a signed tx.
 type originRecoveryFunc func(chainID string, tx SignedTx, allowedSigTypes []evmcompat.SignatureType) ([]byte, error)
 
+// Registered origin recovery funcs, indexed by tx type.
+var originRecoveryFuncs = map[SignedTxType]originRecoveryFunc{
+	LoomSignedTxType:     verifyEd25519,
+	EthereumSignedTxType: verifySolidity66Byte,
+	TronSignedTxType:     verifyTron,
+	BinanceSignedTxType:  verifyBinance,
+}
+
+// RegisterOriginRecoveryFunc makes it possible to support txs signed by additional signer families
+// without changing the middleware, chains can then opt into the new tx type via the auth config in
+// loom.yml. This function isn't thread safe, so it should only be called from an init() function.
+func RegisterOriginRecoveryFunc(
+	txType SignedTxType,
+	recoverOrigin func(chainID string, tx SignedTx, allowedSigTypes []evmcompat.SignatureType) ([]byte, error),
+) {
+	if recoverOrigin == nil {
+		panic("auth: nil origin recovery func")
+	}
+	if _, found := originRecoveryFuncs[txType]; found {
+		panic(fmt.Sprintf("auth: origin recovery func for tx type %s already registered", txType))
+	}
+	originRecoveryFuncs[txType] = recoverOrigin
+}
+
 // NewMultiChainSignatureTxMiddleware returns tx signing middleware that supports a set of chain
-// specific signing algos.
+// specific signing algos. Once the auth:session-keys feature is enabled a tx may also be signed by
+// a session key the message sender has authorized via the SessionKeys contract, in which case the
+// origin is still the message sender, but the tx must be within the scope of the session key.
 func NewMultiChainSignatureTxMiddleware(
 	chains map[string]ChainConfig,
 	createAddressMapperCtx func(state loomchain.State) (contractpb.StaticContext, error),
+	createSessionKeysCtx func(state loomchain.State) (contractpb.StaticContext, error),
 ) loomchain.TxMiddlewareFunc {
 	return loomchain.TxMiddlewareFunc(func(
 		state loomchain.State,
@@ -62,7 +89,9 @@ func NewMultiChainSignatureTxMiddleware(
 			return r, err
 		}
 
-		var nonceTx NonceTx
+		// NOTE: BoundNonceTx is a superset of NonceTx, using it here ensures the tx binding isn't
+		//       dropped when the NonceTx has to be re-encoded.
+		var nonceTx BoundNonceTx
 		if err := proto.Unmarshal(signedTx.Inner, &nonceTx); err != nil {
 			return r, errors.Wrap(err, "failed to unmarshal NonceTx")
 		}
@@ -93,23 +122,35 @@ func NewMultiChainSignatureTxMiddleware(
 			return r, fmt.Errorf("recovery function for Tx type %v not found", chain.TxType)
 		}
 
-		recoveredAddr, err := recoverOrigin(
-			state.Block().ChainID, signedTx, getAllowedSignatureTypes(state, msgSender.ChainID),
-		)
+		allowedSigTypes, err := getAllowedSignatureTypes(state, msgSender.ChainID, chain)
+		if err != nil {
+			return r, err
+		}
+
+		recoveredAddr, err := recoverOrigin(state.Block().ChainID, signedTx, allowedSigTypes)
 		if err != nil {
 			return r, errors.Wrapf(err, "failed to recover origin (tx type %v, chain ID %s)",
 				chain.TxType, msgSender.ChainID,
 			)
 		}
 
+		var sessionKey *loom.Address
 		if !bytes.Equal(recoveredAddr, msgSender.Local) {
-			return r, fmt.Errorf("message sender %s doesn't match origin %s",
-				hex.EncodeToString(msgSender.Local), hex.EncodeToString(recoveredAddr),
-			)
+			if !state.FeatureEnabled(features.SessionKeysFeature, false) {
+				return r, fmt.Errorf("message sender %s doesn't match origin %s",
+					hex.EncodeToString(msgSender.Local), hex.EncodeToString(recoveredAddr),
+				)
+			}
+			sessionKey = &loom.Address{ChainID: msgSender.ChainID, Local: recoveredAddr}
 		}
 
 		switch chain.AccountType {
 		case NativeAccountType: // pass through origin & message sender as is
+			if sessionKey != nil {
+				if err := checkSessionKey(state, msgSender, *sessionKey, &tx, &msg, createSessionKeysCtx); err != nil {
+					return r, err
+				}
+			}
 			ctx := context.WithValue(state.Context(), ContextKeyOrigin, msgSender)
 			return next(state.WithContext(ctx), signedTx.Inner, isCheckTx)
 
@@ -118,6 +159,11 @@ func NewMultiChainSignatureTxMiddleware(
 			if err != nil {
 				return r, err
 			}
+			if sessionKey != nil {
+				if err := checkSessionKey(state, origin, *sessionKey, &tx, &msg, createSessionKeysCtx); err != nil {
+					return r, err
+				}
+			}
 
 			msg.From = origin.MarshalPB()
 			msgTxBytes, err := proto.Marshal(&msg)
@@ -147,20 +193,11 @@ func NewMultiChainSignatureTxMiddleware(
 }
 
 func getOriginRecoveryFunc(state loomchain.State, txID types.TxID, txType SignedTxType) originRecoveryFunc {
-	switch txType {
-	case LoomSignedTxType:
-		return verifyEd25519
-	case EthereumSignedTxType:
-		if (txID == types.TxID_ETHEREUM) && state.FeatureEnabled(features.EthTxFeature, false) {
-			return VerifyWrappedEthTx
-		}
-		return verifySolidity66Byte
-	case TronSignedTxType:
-		return verifyTron
-	case BinanceSignedTxType:
-		return verifyBinance
+	if txType == EthereumSignedTxType && txID == types.TxID_ETHEREUM &&
+		state.FeatureEnabled(features.EthTxFeature, false) {
+		return VerifyWrappedEthTx
 	}
-	return nil
+	return originRecoveryFuncs[txType]
 }
 
 func getMappedAccountAddress(
@@ -206,17 +243,44 @@ func verifyEd25519(chainID string, tx SignedTx, _ []evmcompat.SignatureType) ([]
 	return loom.LocalAddressFromPublicKey(tx.PublicKey), nil
 }
 
-func getAllowedSignatureTypes(state loomchain.State, chainID string) []evmcompat.SignatureType {
+func getAllowedSignatureTypes(
+	state loomchain.State, chainID string, chain ChainConfig,
+) ([]evmcompat.SignatureType, error) {
 	if !state.FeatureEnabled(features.MultiChainSigTxMiddlewareVersion1_1, false) {
 		return []evmcompat.SignatureType{
 			evmcompat.SignatureType_EIP712,
 			evmcompat.SignatureType_GETH,
 			evmcompat.SignatureType_TREZOR,
 			evmcompat.SignatureType_TRON,
+		}, nil
+	}
+
+	if state.FeatureEnabled(features.AuthSigTypesFeature, false) {
+		if sigTypes := getOnChainSignatureTypes(state, chainID); len(sigTypes) > 0 {
+			return sigTypes, nil
 		}
 	}
+	if len(chain.SignatureTypes) > 0 {
+		return chain.AllowedSignatureTypes()
+	}
+	return getDefaultSignatureTypes(state, chainID), nil
+}
+
+// getOnChainSignatureTypes returns the signature types that have been enabled for the given chain
+// ID via the auth:sigtype:<chainID>:<sigType> feature flags.
+func getOnChainSignatureTypes(state loomchain.State, chainID string) []evmcompat.SignatureType {
+	var sigTypes []evmcompat.SignatureType
+	for _, name := range signatureTypeNames {
+		if state.FeatureEnabled(features.AuthSigTypeFeaturePrefix+chainID+":"+name, false) {
+			sigTypes = append(sigTypes, signatureTypesByName[name])
+		}
+	}
+	return sigTypes
+}
 
-	// TODO: chain <-> sig type associations should be in the loom.yml, not hard-coded here
+// getDefaultSignatureTypes returns the signature types allowed for chains that don't specify any
+// signature types in the loom.yml.
+func getDefaultSignatureTypes(state loomchain.State, chainID string) []evmcompat.SignatureType {
 	switch chainID {
 	case "tron":
 		if state.FeatureEnabled(features.AuthSigTxFeaturePrefix+"tron", false) {
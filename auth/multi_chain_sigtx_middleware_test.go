@@ -192,6 +192,7 @@ func TestEthAddressMappingVerification(t *testing.T) {
 	tmx := NewMultiChainSignatureTxMiddleware(
 		chains,
 		func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
 	)
 
 	// Normal loom transaction without address mapping
@@ -261,6 +262,7 @@ func TestBinanceAddressMappingVerification(t *testing.T) {
 	tmx := NewMultiChainSignatureTxMiddleware(
 		chains,
 		func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
 	)
 
 	// Normal loom transaction without address mapping
@@ -338,6 +340,7 @@ func TestChainIdVerification(t *testing.T) {
 	tmx := NewMultiChainSignatureTxMiddleware(
 		chains,
 		func(state loomchain.State) (contractpb.StaticContext, error) { return amCtx, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
 	)
 
 	// Normal loom transaction without address mapping
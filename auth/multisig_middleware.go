@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/builtin/plugins/multisig"
+	"github.com/pkg/errors"
+)
+
+// NewMultisigTxMiddleware returns tx signing middleware that verifies txs sent from multisig
+// accounts. The origin of the tx is set to the multisig account address once enough members of
+// the account have signed the tx.
+func NewMultisigTxMiddleware(
+	createMultisigCtx func(state loomchain.State) (contractpb.StaticContext, error),
+) loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (loomchain.TxHandlerResult, error) {
+		var r loomchain.TxHandlerResult
+
+		var tx multisig.MultiSignedTx
+		if err := proto.Unmarshal(txBytes, &tx); err != nil {
+			return r, errors.Wrap(err, "failed to unmarshal MultiSignedTx")
+		}
+
+		origin, err := recoverMultisigOrigin(state, &tx, createMultisigCtx)
+		if err != nil {
+			return r, errors.Wrap(err, "failed to recover multisig origin")
+		}
+
+		ctx := context.WithValue(state.Context(), ContextKeyOrigin, origin)
+		return next(state.WithContext(ctx), tx.Inner, isCheckTx)
+	})
+}
+
+// IsMultiSignedTx checks if the given tx bytes contain a MultiSignedTx rather than a SignedTx.
+func IsMultiSignedTx(txBytes []byte) bool {
+	var signedTx SignedTx
+	if err := proto.Unmarshal(txBytes, &signedTx); err != nil {
+		return false
+	}
+	if len(signedTx.Signature) > 0 || len(signedTx.PublicKey) > 0 {
+		return false
+	}
+	var tx multisig.MultiSignedTx
+	if err := proto.Unmarshal(txBytes, &tx); err != nil {
+		return false
+	}
+	return len(tx.Signatures) > 0
+}
+
+func recoverMultisigOrigin(
+	state loomchain.State,
+	tx *multisig.MultiSignedTx,
+	createMultisigCtx func(state loomchain.State) (contractpb.StaticContext, error),
+) (loom.Address, error) {
+	msgSender, err := getMessageSender(tx.Inner)
+	if err != nil {
+		return loom.Address{}, err
+	}
+	if msgSender.ChainID != state.Block().ChainID {
+		return loom.Address{}, fmt.Errorf("multisig account %s has wrong chain ID", msgSender.String())
+	}
+
+	ctx, err := createMultisigCtx(state)
+	if err != nil {
+		return loom.Address{}, errors.Wrap(err, "failed to create Multisig context")
+	}
+	account, err := multisig.GetAccount(ctx, msgSender)
+	if err != nil {
+		return loom.Address{}, err
+	}
+	if err := multisig.VerifySignatures(account, tx.Inner, tx.Signatures); err != nil {
+		return loom.Address{}, err
+	}
+	return msgSender, nil
+}
+
+// getMessageSender extracts the sender address from the MessageTx wrapped by the given NonceTx.
+func getMessageSender(nonceTxBytes []byte) (loom.Address, error) {
+	var nonceTx NonceTx
+	if err := proto.Unmarshal(nonceTxBytes, &nonceTx); err != nil {
+		return loom.Address{}, errors.Wrap(err, "failed to unmarshal NonceTx")
+	}
+
+	var tx types.Transaction
+	if err := proto.Unmarshal(nonceTx.Inner, &tx); err != nil {
+		return loom.Address{}, errors.Wrap(err, "failed to unmarshal Transaction")
+	}
+
+	var msg vm.MessageTx
+	if err := proto.Unmarshal(tx.Data, &msg); err != nil {
+		return loom.Address{}, errors.Wrap(err, "failed to unmarshal MessageTx")
+	}
+
+	if msg.From == nil {
+		return loom.Address{}, errors.New("malformed MessageTx, sender not specified")
+	}
+	return loom.UnmarshalAddressPB(msg.From), nil
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	goloomplugin "github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/builtin/plugins/multisig"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+)
+
+func mockMultisigNonceTx(t *testing.T, from loom.Address) []byte {
+	messageTx, err := proto.Marshal(&vm.MessageTx{From: from.MarshalPB(), To: from.MarshalPB()})
+	require.NoError(t, err)
+	tx, err := proto.Marshal(&types.Transaction{Id: uint32(types.TxID_CALL), Data: messageTx})
+	require.NoError(t, err)
+	nonceTx, err := proto.Marshal(&NonceTx{Sequence: 1, Inner: tx})
+	require.NoError(t, err)
+	return nonceTx
+}
+
+func TestMultisigTxMiddleware(t *testing.T) {
+	var pubKeys [][]byte
+	var privKeys []ed25519.PrivateKey
+	for i := 0; i < 3; i++ {
+		pubKey, privKey, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+		privKeys = append(privKeys, privKey)
+	}
+
+	caller := loom.MustParseAddress("default:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	fakeCtx := goloomplugin.CreateFakeContext(caller, caller).WithBlock(loom.BlockHeader{
+		ChainID: "default",
+		Time:    time.Now().Unix(),
+	})
+	multisigAddr := fakeCtx.CreateContract(multisig.Contract)
+	msCtx := contractpb.WrapPluginContext(fakeCtx.WithAddress(multisigAddr))
+	resp, err := (&multisig.Multisig{}).CreateAccount(msCtx, &multisig.CreateAccountRequest{
+		Threshold:  2,
+		PublicKeys: pubKeys,
+	})
+	require.NoError(t, err)
+	accountAddr := loom.UnmarshalAddressPB(resp.Address)
+
+	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: "default"}, nil, nil)
+	mw := NewChainConfigMiddleware(
+		&Config{Chains: map[string]ChainConfig{}},
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return msCtx, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+	)
+
+	nonceTx := mockMultisigNonceTx(t, accountAddr)
+	sign := func(keys ...int) []byte {
+		tx := &multisig.MultiSignedTx{Inner: nonceTx}
+		for _, i := range keys {
+			tx.Signatures = append(tx.Signatures, &multisig.Signature{
+				PublicKey: pubKeys[i],
+				Signature: ed25519.Sign(privKeys[i], nonceTx),
+			})
+		}
+		txBytes, err := proto.Marshal(tx)
+		require.NoError(t, err)
+		return txBytes
+	}
+	processTx := func(txBytes []byte) error {
+		_, err := mw.ProcessTx(state, txBytes,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				require.Equal(t, nonceTx, txBytes)
+				require.Equal(t, accountAddr, Origin(state.Context()))
+				return loomchain.TxHandlerResult{}, nil
+			}, false,
+		)
+		return err
+	}
+
+	require.True(t, IsMultiSignedTx(sign(0, 1)))
+	// multisig txs should be rejected until the feature is enabled
+	require.Error(t, processTx(sign(0, 1)))
+
+	state.SetFeature(features.MultisigAccountFeature, true)
+	require.NoError(t, processTx(sign(0, 1)))
+	require.NoError(t, processTx(sign(2, 0)))
+	require.Error(t, processTx(sign(1)))
+	require.Error(t, processTx(sign(1, 1)))
+
+	// signatures from members of one account can't be used to send txs from another address
+	nonceTx = mockMultisigNonceTx(t, caller)
+	require.Error(t, processTx(sign(0, 1)))
+}
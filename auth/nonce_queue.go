@@ -0,0 +1,337 @@
+package auth
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/log"
+	"github.com/pkg/errors"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// ErrTxQueued is returned by CheckTx when a tx with a future nonce is added to the nonce queue,
+	// the tx will be resubmitted to the mempool once the txs with the preceding nonces are accepted.
+	ErrTxQueued = errors.New("tx nonce is ahead of the expected nonce, tx queued until the gap is filled")
+)
+
+var (
+	nonceQueueSize         metrics.Gauge
+	nonceQueueQueuedCount  metrics.Counter
+	nonceQueueReleaseCount metrics.Counter
+	nonceQueueDropCount    metrics.Counter
+)
+
+func init() {
+	nonceQueueSize = kitprometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+		Namespace: "loomchain",
+		Subsystem: "mempool",
+		Name:      "nonce_queue_size",
+		Help:      "Number of txs waiting in the nonce queue.",
+	}, []string{})
+	nonceQueueQueuedCount = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "loomchain",
+		Subsystem: "mempool",
+		Name:      "nonce_queue_queued_count",
+		Help:      "Number of txs added to the nonce queue.",
+	}, []string{})
+	nonceQueueReleaseCount = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "loomchain",
+		Subsystem: "mempool",
+		Name:      "nonce_queue_release_count",
+		Help:      "Number of txs released from the nonce queue back into the mempool.",
+	}, []string{})
+	nonceQueueDropCount = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "loomchain",
+		Subsystem: "mempool",
+		Name:      "nonce_queue_drop_count",
+		Help:      "Number of txs dropped from the nonce queue.",
+	}, []string{"reason"})
+}
+
+type NonceQueueConfig struct {
+	// Enables the nonce queue
+	Enabled bool
+	// Maximum number of txs that can be queued across all accounts
+	MaxTxs int
+	// Maximum number of txs that can be queued for a single account
+	MaxTxsPerAccount int
+	// Maximum difference between the nonce of a queued tx and the expected nonce
+	MaxNonceGap uint64
+	// Number of seconds a tx can remain in the queue before it's dropped
+	MaxAge int64
+}
+
+func DefaultNonceQueueConfig() *NonceQueueConfig {
+	return &NonceQueueConfig{
+		Enabled:          false,
+		MaxTxs:           1024,
+		MaxTxsPerAccount: 16,
+		MaxNonceGap:      16,
+		MaxAge:           60,
+	}
+}
+
+// Clone returns a deep clone of the config.
+func (c *NonceQueueConfig) Clone() *NonceQueueConfig {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	return &clone
+}
+
+type contextKeyNonceCheck struct{}
+
+// nonceCheck is used by the NonceHandler to tell the NonceQueue about the nonce of the tx it checked.
+type nonceCheck struct {
+	origin   loom.Address
+	sequence uint64
+	// Nonce the NonceHandler expected to see, only set if the tx nonce was ahead of it.
+	expected uint64
+}
+
+type queuedTx struct {
+	txBytes  []byte
+	queuedAt time.Time
+}
+
+type accountNonces struct {
+	// txs waiting for the gap to be filled, indexed by nonce
+	queued map[uint64]queuedTx
+	// last nonce accepted into the mempool
+	lastAccepted   uint64
+	lastAcceptedAt time.Time
+}
+
+// NonceQueue holds txs whose nonces are ahead of the next nonce expected for the sender account,
+// and resubmits them to the mempool once the txs with the preceding nonces have been accepted. This
+// allows clients to send txs in parallel without having to worry about the order in which they
+// arrive at the node. The queue is node-local and only affects CheckTx.
+type NonceQueue struct {
+	cfg      *NonceQueueConfig
+	submitTx func(txBytes []byte) error
+
+	mutex      sync.Mutex
+	accounts   map[string]*accountNonces
+	size       int
+	lastPruned time.Time
+}
+
+// NewNonceQueue creates a new nonce queue, txs released from the queue will be passed to the given
+// function, which should add them to the mempool asynchronously.
+func NewNonceQueue(cfg *NonceQueueConfig, submitTx func(txBytes []byte) error) *NonceQueue {
+	return &NonceQueue{
+		cfg:      cfg,
+		submitTx: submitTx,
+		accounts: map[string]*accountNonces{},
+	}
+}
+
+// TxMiddleware returns middleware that queues txs rejected by the NonceHandler in CheckTx because
+// their nonces are too far ahead, and releases the next queued tx whenever a tx is accepted. This
+// middleware must run before any other middleware that may reject txs.
+func (q *NonceQueue) TxMiddleware() loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (loomchain.TxHandlerResult, error) {
+		if !isCheckTx {
+			return next(state, txBytes, isCheckTx)
+		}
+
+		check := &nonceCheck{}
+		ctx := context.WithValue(state.Context(), contextKeyNonceCheck{}, check)
+		r, err := next(state.WithContext(ctx), txBytes, isCheckTx)
+		if check.origin.IsEmpty() {
+			return r, err
+		}
+		if err != nil {
+			if check.expected != 0 && q.enqueue(check, txBytes) {
+				return r, ErrTxQueued
+			}
+			return r, err
+		}
+		q.release(check.origin, check.sequence)
+		return r, nil
+	})
+}
+
+func (q *NonceQueue) enqueue(check *nonceCheck, txBytes []byte) bool {
+	if check.sequence-check.expected > q.cfg.MaxNonceGap {
+		nonceQueueDropCount.With("reason", "gap").Add(1)
+		return false
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.prune(time.Now())
+	account := q.accounts[check.origin.String()]
+	if account == nil {
+		account = &accountNonces{queued: map[uint64]queuedTx{}}
+		q.accounts[check.origin.String()] = account
+	}
+	if _, found := account.queued[check.sequence]; !found {
+		if len(account.queued) >= q.cfg.MaxTxsPerAccount {
+			nonceQueueDropCount.With("reason", "full").Add(1)
+			return false
+		}
+		if q.size >= q.cfg.MaxTxs && !q.evict(account) {
+			nonceQueueDropCount.With("reason", "full").Add(1)
+			return false
+		}
+		q.size++
+	}
+	// a tx with the same nonce replaces the previously queued one
+	account.queued[check.sequence] = queuedTx{txBytes: txBytes, queuedAt: time.Now()}
+	nonceQueueQueuedCount.Add(1)
+	nonceQueueSize.Set(float64(q.size))
+	return true
+}
+
+// evict makes room for a tx from the given account when the queue is full by dropping the tx with
+// the highest nonce queued for the account with the most queued txs, that tx is the least likely to
+// be released any time soon. Returns false if the given account already has at least as many queued
+// txs as any other account, in which case nothing is evicted.
+func (q *NonceQueue) evict(incoming *accountNonces) bool {
+	var largest *accountNonces
+	for _, account := range q.accounts {
+		if largest == nil || len(account.queued) > len(largest.queued) {
+			largest = account
+		}
+	}
+	if largest == nil || len(largest.queued) <= len(incoming.queued)+1 {
+		return false
+	}
+	var maxSeq uint64
+	for seq := range largest.queued {
+		if seq > maxSeq {
+			maxSeq = seq
+		}
+	}
+	delete(largest.queued, maxSeq)
+	q.size--
+	nonceQueueDropCount.With("reason", "evicted").Add(1)
+	return true
+}
+
+// release resubmits the queued tx that follows the tx with the given nonce.
+func (q *NonceQueue) release(origin loom.Address, sequence uint64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	now := time.Now()
+	q.prune(now)
+	account := q.accounts[origin.String()]
+	if account == nil {
+		account = &accountNonces{queued: map[uint64]queuedTx{}}
+		q.accounts[origin.String()] = account
+	}
+	// Mempool rechecks run CheckTx again on txs with lower nonces that are still pending, which
+	// mustn't move the pending nonce backwards.
+	if sequence > account.lastAccepted {
+		account.lastAccepted = sequence
+	}
+	account.lastAcceptedAt = now
+
+	for seq := range account.queued {
+		if seq <= sequence {
+			delete(account.queued, seq)
+			q.size--
+			nonceQueueDropCount.With("reason", "stale").Add(1)
+		}
+	}
+	tx, found := account.queued[sequence+1]
+	if found {
+		delete(account.queued, sequence+1)
+		q.size--
+		nonceQueueReleaseCount.Add(1)
+		go func(txBytes []byte) {
+			if err := q.submitTx(txBytes); err != nil {
+				log.Error("Failed to resubmit tx from nonce queue", "origin", origin.String(), "err", err)
+			}
+		}(tx.txBytes)
+	}
+	nonceQueueSize.Set(float64(q.size))
+}
+
+// prune drops txs that have been in the queue for too long, and forgets accounts that haven't had
+// any txs accepted recently.
+func (q *NonceQueue) prune(now time.Time) {
+	// no point scanning the whole queue more than once a second
+	if now.Sub(q.lastPruned) < time.Second {
+		return
+	}
+	q.lastPruned = now
+	maxAge := time.Duration(q.cfg.MaxAge) * time.Second
+	for key, account := range q.accounts {
+		for seq, tx := range account.queued {
+			if now.Sub(tx.queuedAt) > maxAge {
+				delete(account.queued, seq)
+				q.size--
+				nonceQueueDropCount.With("reason", "age").Add(1)
+			}
+		}
+		if len(account.queued) == 0 && now.Sub(account.lastAcceptedAt) > maxAge {
+			delete(q.accounts, key)
+		}
+	}
+}
+
+// PendingNonce returns the nonce of the last tx sent by the given account that was accepted into
+// the mempool, or the given committed nonce if it's greater. Txs that are waiting in the queue are
+// not included since they may never make it into the mempool.
+func (q *NonceQueue) PendingNonce(addr loom.Address, committedNonce uint64) uint64 {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	account := q.accounts[addr.String()]
+	if account == nil || account.lastAccepted < committedNonce {
+		return committedNonce
+	}
+	if time.Since(account.lastAcceptedAt) > time.Duration(q.cfg.MaxAge)*time.Second {
+		return committedNonce
+	}
+	return account.lastAccepted
+}
+
+// QueuedNonces returns the nonces of the txs sent by the given account that are waiting in the
+// queue, in ascending order.
+func (q *NonceQueue) QueuedNonces(addr loom.Address) []uint64 {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	account := q.accounts[addr.String()]
+	if account == nil {
+		return nil
+	}
+	nonces := make([]uint64, 0, len(account.queued))
+	for seq := range account.queued {
+		nonces = append(nonces, seq)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	return nonces
+}
+
+// recordNonceCheck is called by the NonceHandler in CheckTx to let the NonceQueue know the nonce of
+// the tx being checked, and the nonce it expected to see if the tx nonce is ahead of it.
+func recordNonceCheck(ctx context.Context, origin loom.Address, sequence, expected uint64) {
+	check, _ := ctx.Value(contextKeyNonceCheck{}).(*nonceCheck)
+	if check == nil {
+		return
+	}
+	check.origin = origin
+	check.sequence = sequence
+	if sequence > expected {
+		check.expected = expected
+	}
+}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/config"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/store"
+)
+
+func TestNonceQueue(t *testing.T) {
+	origin := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	released := make(chan []byte, 10)
+	queue := NewNonceQueue(&NonceQueueConfig{
+		Enabled:          true,
+		MaxTxs:           4,
+		MaxTxsPerAccount: 2,
+		MaxNonceGap:      3,
+		MaxAge:           60,
+	}, func(txBytes []byte) error {
+		released <- txBytes
+		return nil
+	})
+
+	nonceHandler := NewNonceHandler()
+	kvStore := store.NewMemStore()
+	txHandler := loomchain.MiddlewareTxHandler(
+		[]loomchain.TxMiddleware{
+			queue.TxMiddleware(),
+			loomchain.TxMiddlewareFunc(func(
+				state loomchain.State, txBytes []byte, next loomchain.TxHandlerFunc, isCheckTx bool,
+			) (loomchain.TxHandlerResult, error) {
+				ctx := context.WithValue(state.Context(), ContextKeyOrigin, origin)
+				return next(state.WithContext(ctx), txBytes, isCheckTx)
+			}),
+			nonceHandler.TxMiddleware(kvStore),
+		},
+		loomchain.TxHandlerFunc(func(
+			state loomchain.State, txBytes []byte, isCheckTx bool,
+		) (loomchain.TxHandlerResult, error) {
+			return loomchain.TxHandlerResult{}, nil
+		}),
+		[]loomchain.PostCommitMiddleware{nonceHandler.PostCommitMiddleware()},
+	)
+	state := loomchain.NewStoreState(nil, kvStore, abci.Header{ChainID: "default", Height: 1}, nil, nil).
+		WithOnChainConfig(config.DefaultConfig())
+	nonceTx := func(seq uint64) []byte {
+		txBytes, err := proto.Marshal(&NonceTx{Inner: []byte{1, 2, 3}, Sequence: seq})
+		require.NoError(t, err)
+		return txBytes
+	}
+	checkTx := func(txBytes []byte) error {
+		_, err := txHandler.ProcessTx(state, txBytes, true)
+		return err
+	}
+
+	require.Equal(t, ErrTxQueued, checkTx(nonceTx(2)))
+	require.Equal(t, ErrTxQueued, checkTx(nonceTx(3)))
+	// queue is full
+	require.Error(t, checkTx(nonceTx(4)))
+	require.NotEqual(t, ErrTxQueued, checkTx(nonceTx(4)))
+	// gap is too big
+	require.Error(t, checkTx(nonceTx(5)))
+	require.NotEqual(t, ErrTxQueued, checkTx(nonceTx(5)))
+	require.Equal(t, []uint64{2, 3}, queue.QueuedNonces(origin))
+	require.Equal(t, uint64(0), queue.PendingNonce(origin, 0))
+
+	// filling the gap releases the queued txs one at a time
+	require.NoError(t, checkTx(nonceTx(1)))
+	require.Equal(t, uint64(1), queue.PendingNonce(origin, 0))
+	for seq := uint64(2); seq <= 3; seq++ {
+		select {
+		case txBytes := <-released:
+			require.Equal(t, nonceTx(seq), txBytes)
+			require.NoError(t, checkTx(txBytes))
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "queued tx wasn't released")
+		}
+	}
+	require.Len(t, queue.QueuedNonces(origin), 0)
+	require.Equal(t, uint64(3), queue.PendingNonce(origin, 0))
+	// rechecking a lower nonce tx that's still pending doesn't move the pending nonce backwards
+	queue.release(origin, 1)
+	require.Equal(t, uint64(3), queue.PendingNonce(origin, 0))
+	// the committed nonce takes precedence once it catches up
+	require.Equal(t, uint64(5), queue.PendingNonce(origin, 5))
+
+	// DeliverTx is never affected by the queue
+	_, err := txHandler.ProcessTx(state, nonceTx(3), false)
+	require.Error(t, err)
+	require.NotEqual(t, ErrTxQueued, err)
+}
+
+func TestNonceQueueEviction(t *testing.T) {
+	addr1 := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	addr2 := loom.MustParseAddress("default:0xfa4c7920accfd66b86f5fd0e69682a79f762d49e")
+	addr3 := loom.MustParseAddress("default:0xbb7a1f6e5a0b4b5b9b5d3c5f3a4c1e6d7c8b9a01")
+	queue := NewNonceQueue(&NonceQueueConfig{
+		Enabled:          true,
+		MaxTxs:           3,
+		MaxTxsPerAccount: 3,
+		MaxNonceGap:      10,
+		MaxAge:           60,
+	}, func(txBytes []byte) error { return nil })
+	enqueue := func(origin loom.Address, seq uint64) bool {
+		return queue.enqueue(&nonceCheck{origin: origin, sequence: seq, expected: 1}, []byte{byte(seq)})
+	}
+
+	require.True(t, enqueue(addr1, 2))
+	require.True(t, enqueue(addr1, 3))
+	require.True(t, enqueue(addr1, 4))
+	// the queue is full, the highest nonce queued by the largest account is evicted to make room
+	require.True(t, enqueue(addr2, 2))
+	require.Equal(t, []uint64{2, 3}, queue.QueuedNonces(addr1))
+	require.Equal(t, []uint64{2}, queue.QueuedNonces(addr2))
+	// an account can't evict txs from another account that has the same number of queued txs
+	require.True(t, enqueue(addr3, 2))
+	require.Equal(t, []uint64{2}, queue.QueuedNonces(addr1))
+	require.False(t, enqueue(addr3, 3))
+	require.Equal(t, []uint64{2}, queue.QueuedNonces(addr3))
+}
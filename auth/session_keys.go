@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	lp "github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/builtin/plugins/session_keys"
+	"github.com/pkg/errors"
+)
+
+// checkSessionKey verifies that the owner has authorized the session key, that the session key
+// hasn't expired yet, and that the tx is within the scope of the session key.
+func checkSessionKey(
+	state loomchain.State,
+	owner, sessionKey loom.Address,
+	tx *types.Transaction,
+	msg *vm.MessageTx,
+	createSessionKeysCtx func(state loomchain.State) (contractpb.StaticContext, error),
+) error {
+	ctx, err := createSessionKeysCtx(state)
+	if err != nil {
+		return errors.Wrap(err, "failed to create SessionKeys context")
+	}
+	key, err := session_keys.GetSessionKey(ctx, owner, sessionKey)
+	if err != nil {
+		return errors.Wrapf(err, "session key %s not authorized by %s", sessionKey.String(), owner.String())
+	}
+	if uint64(state.Block().Height) > key.ExpiresAt {
+		return fmt.Errorf("session key %s expired at block %d", sessionKey.String(), key.ExpiresAt)
+	}
+
+	if types.TxID(tx.Id) != types.TxID_CALL {
+		return fmt.Errorf("session keys can't be used to sign txs of type %v", tx.Id)
+	}
+	method, err := getCallTxMethod(msg.Data)
+	if err != nil {
+		return err
+	}
+	contractAddr := loom.UnmarshalAddressPB(msg.To)
+	if !session_keys.IsCallAllowed(key, contractAddr, method) {
+		return fmt.Errorf("session key %s is not allowed to call %s on %s",
+			sessionKey.String(), method, contractAddr.String(),
+		)
+	}
+	return nil
+}
+
+// getCallTxMethod returns the name of the Go contract method, or the hex encoded 4-byte selector of
+// the EVM contract method, called by the given CallTx.
+func getCallTxMethod(callTxBytes []byte) (string, error) {
+	var callTx vm.CallTx
+	if err := proto.Unmarshal(callTxBytes, &callTx); err != nil {
+		return "", errors.Wrap(err, "failed to unmarshal CallTx")
+	}
+
+	switch callTx.VmType {
+	case vm.VMType_PLUGIN:
+		var req lp.Request
+		if err := proto.Unmarshal(callTx.Input, &req); err != nil {
+			return "", errors.Wrap(err, "failed to unmarshal Request")
+		}
+		var methodCall lp.ContractMethodCall
+		if err := proto.Unmarshal(req.Body, &methodCall); err != nil {
+			return "", errors.Wrap(err, "failed to unmarshal ContractMethodCall")
+		}
+		return methodCall.Method, nil
+
+	case vm.VMType_EVM:
+		if len(callTx.Input) < 4 {
+			return "", nil
+		}
+		return "0x" + hex.EncodeToString(callTx.Input[:4]), nil
+	}
+	return "", fmt.Errorf("unsupported VM type %v", callTx.VmType)
+}
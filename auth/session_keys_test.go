@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	lauth "github.com/loomnetwork/go-loom/auth"
+	goloomplugin "github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/builtin/plugins/session_keys"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+)
+
+func mockSessionKeyTx(t *testing.T, privKey ed25519.PrivateKey, from, to loom.Address, input []byte) []byte {
+	callTx, err := proto.Marshal(&vm.CallTx{VmType: vm.VMType_EVM, Input: input})
+	require.NoError(t, err)
+	messageTx, err := proto.Marshal(&vm.MessageTx{From: from.MarshalPB(), To: to.MarshalPB(), Data: callTx})
+	require.NoError(t, err)
+	tx, err := proto.Marshal(&types.Transaction{Id: uint32(types.TxID_CALL), Data: messageTx})
+	require.NoError(t, err)
+	nonceTx, err := proto.Marshal(&NonceTx{Sequence: 1, Inner: tx})
+	require.NoError(t, err)
+	signedTx, err := proto.Marshal(lauth.SignTx(lauth.NewEd25519Signer(privKey), nonceTx))
+	require.NoError(t, err)
+	return signedTx
+}
+
+func TestSessionKeySignedTx(t *testing.T) {
+	owner := loom.MustParseAddress("default:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	target := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	sessionPubKey, sessionPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	sessionKey := loom.Address{ChainID: "default", Local: loom.LocalAddressFromPublicKey(sessionPubKey)}
+
+	fakeCtx := goloomplugin.CreateFakeContext(owner, owner).WithBlock(loom.BlockHeader{
+		ChainID: "default",
+		Height:  10,
+		Time:    time.Now().Unix(),
+	})
+	skAddr := fakeCtx.CreateContract(session_keys.Contract)
+	skCtx := contractpb.WrapPluginContext(fakeCtx.WithAddress(skAddr))
+	require.NoError(t, (&session_keys.SessionKeys{}).AuthorizeSessionKey(skCtx, &session_keys.AuthorizeSessionKeyRequest{
+		SessionKey: sessionKey.MarshalPB(),
+		Duration:   10,
+		Scopes: []*session_keys.Scope{
+			{Contract: target.MarshalPB(), Methods: []string{"0xa9059cbb"}},
+		},
+	}))
+
+	chains := map[string]ChainConfig{
+		"default": {TxType: LoomSignedTxType, AccountType: NativeAccountType},
+	}
+	mw := NewMultiChainSignatureTxMiddleware(
+		chains,
+		func(state loomchain.State) (contractpb.StaticContext, error) { return nil, nil },
+		func(state loomchain.State) (contractpb.StaticContext, error) { return skCtx, nil },
+	)
+	processTx := func(height int64, txBytes []byte) error {
+		state := loomchain.NewStoreState(
+			nil, store.NewMemStore(), abci.Header{ChainID: "default", Height: height}, nil, nil,
+		)
+		state.SetFeature(features.SessionKeysFeature, true)
+		_, err := mw.ProcessTx(state, txBytes,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				require.Equal(t, owner, Origin(state.Context()))
+				return loomchain.TxHandlerResult{}, nil
+			}, false,
+		)
+		return err
+	}
+
+	transfer := []byte{0xa9, 0x05, 0x9c, 0xbb, 1, 2, 3}
+	approve := []byte{0x09, 0x5e, 0xa7, 0xb3, 1, 2, 3}
+	require.NoError(t, processTx(15, mockSessionKeyTx(t, sessionPrivKey, owner, target, transfer)))
+	require.NoError(t, processTx(20, mockSessionKeyTx(t, sessionPrivKey, owner, target, transfer)))
+	// expired
+	require.Error(t, processTx(21, mockSessionKeyTx(t, sessionPrivKey, owner, target, transfer)))
+	// method out of scope
+	require.Error(t, processTx(15, mockSessionKeyTx(t, sessionPrivKey, owner, target, approve)))
+	// contract out of scope
+	require.Error(t, processTx(15, mockSessionKeyTx(t, sessionPrivKey, owner, owner, transfer)))
+	// session key isn't authorized to act on behalf of other accounts
+	require.Error(t, processTx(15, mockSessionKeyTx(t, sessionPrivKey, target, target, transfer)))
+
+	// session keys are only accepted once the feature is enabled
+	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: "default", Height: 15}, nil, nil)
+	_, err = mw.ProcessTx(state, mockSessionKeyTx(t, sessionPrivKey, owner, target, transfer),
+		func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+			return loomchain.TxHandlerResult{}, nil
+		}, false,
+	)
+	require.Error(t, err)
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/loomnetwork/go-loom/plugin"
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/builtin/plugins/pagination"
 	"github.com/loomnetwork/loomchain/features"
 	ssha "github.com/miguelmota/go-solidity-sha3"
 	"github.com/pkg/errors"
@@ -166,6 +167,41 @@ func (am *AddressMapper) ListMapping(ctx contract.StaticContext, req *ListMappin
 	return &listMappingResponse, nil
 }
 
+// ListMappingPage returns a page of the identity mappings, ordered by the lesser of the two
+// addresses in each mapping.
+func (am *AddressMapper) ListMappingPage(
+	ctx contract.StaticContext, req *ListMappingPageRequest,
+) (*ListMappingPageResponse, error) {
+	// Every mapping is stored under both of its addresses, only the entry stored under the lesser
+	// address is listed.
+	var mappings []*AddressMapping
+	var keys [][]byte
+	for _, m := range ctx.Range([]byte(AddressPrefix)) {
+		var mapping AddressMapping
+		if err := proto.Unmarshal(m.Value, &mapping); err != nil {
+			return nil, errors.Wrap(err, "unmarshal mapping")
+		}
+		if bytes.Compare(m.Key, loom.UnmarshalAddressPB(mapping.To).Bytes()) > 0 {
+			continue
+		}
+		mappings = append(mappings, &AddressMapping{
+			From: mapping.From,
+			To:   mapping.To,
+		})
+		keys = append(keys, m.Key)
+	}
+
+	indices, nextKey := pagination.Page(keys, req.StartKey, req.Limit)
+	resp := &ListMappingPageResponse{
+		Mappings: make([]*AddressMapping, 0, len(indices)),
+		NextKey:  nextKey,
+	}
+	for _, i := range indices {
+		resp.Mappings = append(resp.Mappings, mappings[i])
+	}
+	return resp, nil
+}
+
 func (am *AddressMapper) HasMapping(ctx contract.StaticContext, req *HasMappingRequest) (*HasMappingResponse, error) {
 	if req.From == nil {
 		return nil, ErrInvalidRequest
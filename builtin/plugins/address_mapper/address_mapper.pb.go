@@ -0,0 +1,143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/address_mapper/address_mapper.proto
+
+package address_mapper
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	address_mapper "github.com/loomnetwork/go-loom/builtin/types/address_mapper"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListMappingPageRequest struct {
+	StartKey             []byte   `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMappingPageRequest) Reset()         { *m = ListMappingPageRequest{} }
+func (m *ListMappingPageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMappingPageRequest) ProtoMessage()    {}
+func (*ListMappingPageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35674d6f0b37ee27, []int{0}
+}
+func (m *ListMappingPageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMappingPageRequest.Unmarshal(m, b)
+}
+func (m *ListMappingPageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMappingPageRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMappingPageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMappingPageRequest.Merge(m, src)
+}
+func (m *ListMappingPageRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMappingPageRequest.Size(m)
+}
+func (m *ListMappingPageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMappingPageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMappingPageRequest proto.InternalMessageInfo
+
+func (m *ListMappingPageRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ListMappingPageRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListMappingPageResponse struct {
+	Mappings             []*address_mapper.AddressMapperMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	NextKey              []byte                                 `protobuf:"bytes,2,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
+}
+
+func (m *ListMappingPageResponse) Reset()         { *m = ListMappingPageResponse{} }
+func (m *ListMappingPageResponse) String() string { return proto.CompactTextString(m) }
+func (*ListMappingPageResponse) ProtoMessage()    {}
+func (*ListMappingPageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35674d6f0b37ee27, []int{1}
+}
+func (m *ListMappingPageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMappingPageResponse.Unmarshal(m, b)
+}
+func (m *ListMappingPageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMappingPageResponse.Marshal(b, m, deterministic)
+}
+func (m *ListMappingPageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMappingPageResponse.Merge(m, src)
+}
+func (m *ListMappingPageResponse) XXX_Size() int {
+	return xxx_messageInfo_ListMappingPageResponse.Size(m)
+}
+func (m *ListMappingPageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMappingPageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMappingPageResponse proto.InternalMessageInfo
+
+func (m *ListMappingPageResponse) GetMappings() []*address_mapper.AddressMapperMapping {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func (m *ListMappingPageResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListMappingPageRequest)(nil), "loomchain.address_mapper.ListMappingPageRequest")
+	proto.RegisterType((*ListMappingPageResponse)(nil), "loomchain.address_mapper.ListMappingPageResponse")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/address_mapper/address_mapper.proto", fileDescriptor_35674d6f0b37ee27)
+}
+
+var fileDescriptor_35674d6f0b37ee27 = []byte{
+	// 249 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x8f, 0x4b, 0x4b, 0xc3, 0x40,
+	0x10, 0xc7, 0x49, 0x45, 0x8d, 0xeb, 0x03, 0x59, 0x44, 0xa3, 0x5e, 0x42, 0xf1, 0x90, 0x8b, 0x09,
+	0xe8, 0x17, 0x50, 0xaf, 0xb1, 0x50, 0x82, 0x27, 0x2f, 0x65, 0xd3, 0x0e, 0xdb, 0xa5, 0xd9, 0x87,
+	0x3b, 0x13, 0x35, 0xdf, 0x5e, 0xba, 0x91, 0x40, 0x2b, 0x42, 0x6f, 0xfb, 0x9b, 0xe5, 0xff, 0x62,
+	0x6f, 0x52, 0xd1, 0xb2, 0xad, 0xf3, 0xb9, 0xd5, 0x45, 0x63, 0xad, 0x36, 0x40, 0x5f, 0xd6, 0xaf,
+	0xc2, 0x7b, 0xbe, 0x14, 0xca, 0x14, 0x75, 0xab, 0x1a, 0x52, 0xa6, 0x70, 0x4d, 0x2b, 0x95, 0xc1,
+	0x42, 0x2c, 0x16, 0x1e, 0x10, 0x67, 0x5a, 0x38, 0x07, 0x7e, 0x0b, 0x73, 0xe7, 0x2d, 0x59, 0x9e,
+	0x0c, 0xf2, 0x7c, 0xf3, 0xff, 0x66, 0xfa, 0x4f, 0x9e, 0xb4, 0xf7, 0x6b, 0x1c, 0xd2, 0xa8, 0x73,
+	0xb0, 0x53, 0xd6, 0xb8, 0x64, 0x97, 0xaf, 0x0a, 0x69, 0x22, 0x9c, 0x53, 0x46, 0x4e, 0x85, 0x84,
+	0x0a, 0x3e, 0x5a, 0x40, 0xe2, 0xb7, 0xec, 0x08, 0x49, 0x78, 0x9a, 0xad, 0xa0, 0x4b, 0xa2, 0x34,
+	0xca, 0x4e, 0xaa, 0x38, 0x1c, 0x4a, 0xe8, 0xf8, 0x05, 0xdb, 0x6f, 0x94, 0x56, 0x94, 0x8c, 0xd2,
+	0x28, 0x3b, 0xad, 0x7a, 0x18, 0x7f, 0xb2, 0xab, 0x3f, 0x66, 0xe8, 0xac, 0x41, 0xe0, 0x4f, 0x2c,
+	0xd6, 0xfd, 0x19, 0x93, 0x28, 0xdd, 0xcb, 0x8e, 0x1f, 0xee, 0xb6, 0xc6, 0xe5, 0xcf, 0x3d, 0x4e,
+	0x02, 0xfd, 0x7a, 0x54, 0x83, 0x8a, 0x5f, 0xb3, 0xd8, 0xc0, 0x77, 0x5f, 0x67, 0x14, 0xea, 0x1c,
+	0xae, 0xb9, 0x84, 0xee, 0xe5, 0xfc, 0xfd, 0x6c, 0xd3, 0xab, 0x3e, 0x08, 0xeb, 0x1e, 0x7f, 0x06,
+	0x00, 0x0f, 0x1e, 0x3f, 0x52, 0xa1, 0x01, 0x00, 0x00,
+}
@@ -255,6 +255,52 @@ func (s *AddressMapperTestSuite) TestListMapping() {
 	s.Equal(1, len(resp.Mappings))
 }
 
+func (s *AddressMapperTestSuite) TestListMappingPage() {
+	r := s.Require()
+	fakeCtx := plugin.CreateFakeContext(s.validDAppAddr /*caller*/, loom.RootAddress("chain") /*contract*/)
+	ctx := contract.WrapPluginContext(fakeCtx)
+
+	amContract := &AddressMapper{}
+	r.NoError(amContract.Init(ctx, &InitRequest{}))
+
+	sig, err := SignIdentityMapping(s.validEthAddr, s.validDAppAddr, s.validEthKey, sigType)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(ctx, &AddIdentityMappingRequest{
+		From:      s.validEthAddr.MarshalPB(),
+		To:        s.validDAppAddr.MarshalPB(),
+		Signature: sig,
+	}))
+
+	ethKey2, err := crypto.GenerateKey()
+	r.NoError(err)
+	ethLocalAddr2, err := loom.LocalAddressFromHexString(crypto.PubkeyToAddress(ethKey2.PublicKey).Hex())
+	r.NoError(err)
+	ethAddr2 := loom.Address{ChainID: "eth", Local: ethLocalAddr2}
+	sig, err = SignIdentityMapping(ethAddr2, s.validDAppAddr2, ethKey2, sigType)
+	r.NoError(err)
+	r.NoError(amContract.AddIdentityMapping(
+		contract.WrapPluginContext(fakeCtx.WithSender(s.validDAppAddr2)),
+		&AddIdentityMappingRequest{
+			From:      ethAddr2.MarshalPB(),
+			To:        s.validDAppAddr2.MarshalPB(),
+			Signature: sig,
+		},
+	))
+
+	resp, err := amContract.ListMappingPage(ctx, &ListMappingPageRequest{Limit: 1})
+	r.NoError(err)
+	r.Equal(1, len(resp.Mappings))
+	r.NotNil(resp.NextKey)
+	first := resp.Mappings[0]
+
+	resp, err = amContract.ListMappingPage(ctx, &ListMappingPageRequest{StartKey: resp.NextKey, Limit: 1})
+	r.NoError(err)
+	r.Equal(1, len(resp.Mappings))
+	r.Nil(resp.NextKey)
+	r.NotEqual(first.From.String(), resp.Mappings[0].From.String())
+	r.NotEqual(first.From.String(), resp.Mappings[0].To.String())
+}
+
 // Same as the other test case but the from/to inverted when adding the mapping,
 // since the mapping is bi-directional the end result should be identical to the first test case.
 func (s *AddressMapperTestSuite) TestAddressMapperAddNewInvertedIdentityMapping() {
@@ -12,6 +12,7 @@ import (
 	plugintypes "github.com/loomnetwork/go-loom/plugin/types"
 	"github.com/loomnetwork/go-loom/util"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/registry"
 	"github.com/pkg/errors"
@@ -102,7 +103,8 @@ var (
 	setParamsPerm  = []byte("setp")
 	addFeaturePerm = []byte("addf")
 
-	paramsKey = []byte("params")
+	paramsKey        = []byte("params")
+	blockTxLimitsKey = []byte("btxlimits")
 )
 
 func featureKey(featureName string) []byte {
@@ -158,7 +160,7 @@ func (c *ChainConfig) SetParams(ctx contract.Context, req *SetParamsRequest) err
 		return ErrInvalidRequest
 	}
 
-	if ok, _ := ctx.HasPermission(setParamsPerm, []string{ownerRole}); !ok {
+	if !governance.HasPermission(ctx, setParamsPerm, []string{ownerRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -175,6 +177,43 @@ func (c *ChainConfig) GetParams(ctx contract.StaticContext, req *GetParamsReques
 	}, nil
 }
 
+// SetBlockTxLimits should be called by the contract owner to set the limits enforced by the block
+// tx limiter middleware, limits with zero fields disable the limiter.
+func (c *ChainConfig) SetBlockTxLimits(ctx contract.Context, req *SetBlockTxLimitsRequest) error {
+	if req.Limits == nil || (req.Limits.BlockRange == 0) != (req.Limits.MaxTxsPerRange == 0) {
+		return ErrInvalidRequest
+	}
+
+	if !governance.HasPermission(ctx, setParamsPerm, []string{ownerRole}) {
+		return ErrNotAuthorized
+	}
+
+	return ctx.Set(blockTxLimitsKey, req.Limits)
+}
+
+func (c *ChainConfig) GetBlockTxLimits(
+	ctx contract.StaticContext, req *GetBlockTxLimitsRequest,
+) (*GetBlockTxLimitsResponse, error) {
+	limits, err := GetBlockTxLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GetBlockTxLimitsResponse{Limits: limits}, nil
+}
+
+// GetBlockTxLimits is called by the block tx limiter middleware to look up the limits it should
+// enforce. Returns nil if the limits haven't been set.
+func GetBlockTxLimits(ctx contract.StaticContext) (*BlockTxLimits, error) {
+	var limits BlockTxLimits
+	if err := ctx.Get(blockTxLimitsKey, &limits); err != nil {
+		if err == contract.ErrNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to load block tx limits")
+	}
+	return &limits, nil
+}
+
 // FeatureEnabled checks if a specific feature is currently enabled on the chain, which means that
 // it has been enabled by a sufficient number of validators, and has been activated.
 func (c *ChainConfig) FeatureEnabled(
@@ -434,8 +473,7 @@ func (c *ChainConfig) SetSetting(ctx contract.Context, req *SetSettingRequest) e
 		return ErrFeatureNotEnabled
 	}
 
-	contractOwner, _ := ctx.HasPermission(addFeaturePerm, []string{ownerRole})
-	if !contractOwner {
+	if !governance.HasPermission(ctx, addFeaturePerm, []string{ownerRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -638,7 +676,7 @@ func addFeature(ctx contract.Context, name string, buildNumber uint64, autoEnabl
 		return ErrInvalidRequest
 	}
 
-	if ok, _ := ctx.HasPermission(addFeaturePerm, []string{ownerRole}); !ok {
+	if !governance.HasPermission(ctx, addFeaturePerm, []string{ownerRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -664,7 +702,7 @@ func removeFeature(ctx contract.Context, name string) error {
 	if name == "" {
 		return ErrInvalidRequest
 	}
-	if ok, _ := ctx.HasPermission(addFeaturePerm, []string{ownerRole}); !ok {
+	if !governance.HasPermission(ctx, addFeaturePerm, []string{ownerRole}) {
 		return ErrNotAuthorized
 	}
 	if found := ctx.Has(featureKey(name)); !found {
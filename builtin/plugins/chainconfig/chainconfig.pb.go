@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/chainconfig/chainconfig.proto
+
+package chainconfig
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type BlockTxLimits struct {
+	BlockRange           uint64   `protobuf:"varint,1,opt,name=block_range,json=blockRange,proto3" json:"block_range,omitempty"`
+	MaxTxsPerRange       uint64   `protobuf:"varint,2,opt,name=max_txs_per_range,json=maxTxsPerRange,proto3" json:"max_txs_per_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockTxLimits) Reset()         { *m = BlockTxLimits{} }
+func (m *BlockTxLimits) String() string { return proto.CompactTextString(m) }
+func (*BlockTxLimits) ProtoMessage()    {}
+func (*BlockTxLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c714fa40047f7987, []int{0}
+}
+func (m *BlockTxLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTxLimits.Unmarshal(m, b)
+}
+func (m *BlockTxLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTxLimits.Marshal(b, m, deterministic)
+}
+func (m *BlockTxLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTxLimits.Merge(m, src)
+}
+func (m *BlockTxLimits) XXX_Size() int {
+	return xxx_messageInfo_BlockTxLimits.Size(m)
+}
+func (m *BlockTxLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTxLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTxLimits proto.InternalMessageInfo
+
+func (m *BlockTxLimits) GetBlockRange() uint64 {
+	if m != nil {
+		return m.BlockRange
+	}
+	return 0
+}
+
+func (m *BlockTxLimits) GetMaxTxsPerRange() uint64 {
+	if m != nil {
+		return m.MaxTxsPerRange
+	}
+	return 0
+}
+
+type SetBlockTxLimitsRequest struct {
+	Limits               *BlockTxLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetBlockTxLimitsRequest) Reset()         { *m = SetBlockTxLimitsRequest{} }
+func (m *SetBlockTxLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBlockTxLimitsRequest) ProtoMessage()    {}
+func (*SetBlockTxLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c714fa40047f7987, []int{1}
+}
+func (m *SetBlockTxLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBlockTxLimitsRequest.Unmarshal(m, b)
+}
+func (m *SetBlockTxLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBlockTxLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBlockTxLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBlockTxLimitsRequest.Merge(m, src)
+}
+func (m *SetBlockTxLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBlockTxLimitsRequest.Size(m)
+}
+func (m *SetBlockTxLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBlockTxLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBlockTxLimitsRequest proto.InternalMessageInfo
+
+func (m *SetBlockTxLimitsRequest) GetLimits() *BlockTxLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type GetBlockTxLimitsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockTxLimitsRequest) Reset()         { *m = GetBlockTxLimitsRequest{} }
+func (m *GetBlockTxLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockTxLimitsRequest) ProtoMessage()    {}
+func (*GetBlockTxLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c714fa40047f7987, []int{2}
+}
+func (m *GetBlockTxLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockTxLimitsRequest.Unmarshal(m, b)
+}
+func (m *GetBlockTxLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockTxLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockTxLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockTxLimitsRequest.Merge(m, src)
+}
+func (m *GetBlockTxLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockTxLimitsRequest.Size(m)
+}
+func (m *GetBlockTxLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockTxLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockTxLimitsRequest proto.InternalMessageInfo
+
+type GetBlockTxLimitsResponse struct {
+	Limits               *BlockTxLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetBlockTxLimitsResponse) Reset()         { *m = GetBlockTxLimitsResponse{} }
+func (m *GetBlockTxLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockTxLimitsResponse) ProtoMessage()    {}
+func (*GetBlockTxLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c714fa40047f7987, []int{3}
+}
+func (m *GetBlockTxLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockTxLimitsResponse.Unmarshal(m, b)
+}
+func (m *GetBlockTxLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockTxLimitsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetBlockTxLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockTxLimitsResponse.Merge(m, src)
+}
+func (m *GetBlockTxLimitsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockTxLimitsResponse.Size(m)
+}
+func (m *GetBlockTxLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockTxLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockTxLimitsResponse proto.InternalMessageInfo
+
+func (m *GetBlockTxLimitsResponse) GetLimits() *BlockTxLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlockTxLimits)(nil), "loomchain.chainconfig.BlockTxLimits")
+	proto.RegisterType((*SetBlockTxLimitsRequest)(nil), "loomchain.chainconfig.SetBlockTxLimitsRequest")
+	proto.RegisterType((*GetBlockTxLimitsRequest)(nil), "loomchain.chainconfig.GetBlockTxLimitsRequest")
+	proto.RegisterType((*GetBlockTxLimitsResponse)(nil), "loomchain.chainconfig.GetBlockTxLimitsResponse")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/chainconfig/chainconfig.proto", fileDescriptor_c714fa40047f7987)
+}
+
+var fileDescriptor_c714fa40047f7987 = []byte{
+	// 235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x90, 0x41, 0x4b, 0xc3, 0x40,
+	0x10, 0x85, 0xa9, 0x48, 0x0f, 0x13, 0x2a, 0x18, 0x90, 0xd6, 0x93, 0x12, 0x3c, 0xe8, 0x65, 0x03,
+	0x7a, 0xf5, 0xd4, 0x8b, 0x17, 0x11, 0x89, 0x05, 0x45, 0x0f, 0x21, 0x09, 0x6b, 0x3a, 0x74, 0x77,
+	0x27, 0xee, 0xce, 0xe2, 0xfe, 0x7c, 0xe9, 0x50, 0x24, 0xc5, 0xde, 0xbc, 0x0d, 0xdf, 0x7c, 0x6f,
+	0xde, 0xb2, 0xf0, 0xd4, 0x23, 0xaf, 0x63, 0xab, 0x3a, 0xb2, 0xa5, 0x21, 0xb2, 0x4e, 0xf3, 0x37,
+	0xf9, 0x8d, 0xcc, 0xdd, 0xba, 0x41, 0x57, 0xb6, 0x11, 0x0d, 0xa3, 0x2b, 0x07, 0x13, 0x7b, 0x74,
+	0xa1, 0x14, 0xda, 0x91, 0xfb, 0xc4, 0x7e, 0x3c, 0xab, 0xc1, 0x13, 0x53, 0x7e, 0xf6, 0x1b, 0x54,
+	0xa3, 0x65, 0xf1, 0x01, 0xb3, 0xa5, 0xa1, 0x6e, 0xb3, 0x4a, 0x8f, 0x68, 0x91, 0x43, 0x7e, 0x01,
+	0x59, 0xbb, 0x05, 0xb5, 0x6f, 0x5c, 0xaf, 0x17, 0x93, 0xcb, 0xc9, 0xf5, 0x71, 0x05, 0x82, 0xaa,
+	0x2d, 0xc9, 0x6f, 0xe0, 0xd4, 0x36, 0xa9, 0xe6, 0x14, 0xea, 0x41, 0xfb, 0x9d, 0x76, 0x24, 0xda,
+	0x89, 0x6d, 0xd2, 0x2a, 0x85, 0x67, 0xed, 0x45, 0x2d, 0x5e, 0x61, 0xfe, 0xa2, 0x79, 0xef, 0x7e,
+	0xa5, 0xbf, 0xa2, 0x0e, 0x9c, 0xdf, 0xc3, 0xd4, 0x08, 0x90, 0x86, 0xec, 0xf6, 0x4a, 0x1d, 0x7c,
+	0x9f, 0xda, 0x0f, 0xef, 0x32, 0xc5, 0x39, 0xcc, 0x1f, 0x0e, 0x1f, 0x2e, 0xde, 0x60, 0xf1, 0x77,
+	0x15, 0x06, 0x72, 0x41, 0xff, 0xaf, 0x74, 0x39, 0x7b, 0xcf, 0x46, 0x52, 0x3b, 0x95, 0x7f, 0xbd,
+	0xfb, 0x19, 0x00, 0x76, 0xf3, 0x4c, 0x60, 0xa9, 0x01, 0x00, 0x00,
+}
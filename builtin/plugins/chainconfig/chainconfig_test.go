@@ -295,6 +295,26 @@ func (c *ChainConfigTestSuite) TestPermission() {
 		BuildNumber: 1000,
 	})
 	require.Equal(ErrNotAuthorized, err)
+
+	limits, err := GetBlockTxLimits(ctx)
+	require.NoError(err)
+	require.Nil(limits)
+	err = chainconfigContract.SetBlockTxLimits(contractpb.WrapPluginContext(pctx.WithSender(addr2)), &SetBlockTxLimitsRequest{
+		Limits: &BlockTxLimits{BlockRange: 10, MaxTxsPerRange: 2},
+	})
+	require.Equal(ErrNotAuthorized, err)
+	err = chainconfigContract.SetBlockTxLimits(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &SetBlockTxLimitsRequest{
+		Limits: &BlockTxLimits{BlockRange: 10},
+	})
+	require.Equal(ErrInvalidRequest, err)
+	err = chainconfigContract.SetBlockTxLimits(contractpb.WrapPluginContext(pctx.WithSender(addr1)), &SetBlockTxLimitsRequest{
+		Limits: &BlockTxLimits{BlockRange: 10, MaxTxsPerRange: 2},
+	})
+	require.NoError(err)
+	resp, err := chainconfigContract.GetBlockTxLimits(ctx, &GetBlockTxLimitsRequest{})
+	require.NoError(err)
+	require.Equal(uint64(10), resp.Limits.BlockRange)
+	require.Equal(uint64(2), resp.Limits.MaxTxsPerRange)
 }
 
 func (c *ChainConfigTestSuite) TestFeatureFlagEnabledFourValidators() {
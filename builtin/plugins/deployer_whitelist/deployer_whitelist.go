@@ -8,6 +8,7 @@ import (
 	"github.com/loomnetwork/go-loom/plugin"
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
 	"github.com/pkg/errors"
 )
 
@@ -156,7 +157,7 @@ func (dw *DeployerWhitelist) RemoveUserDeployer(ctx contract.Context, req *Remov
 
 // AddDeployer
 func (dw *DeployerWhitelist) AddDeployer(ctx contract.Context, req *AddDeployerRequest) error {
-	if ok, _ := ctx.HasPermission(modifyPerm, []string{ownerRole}); !ok {
+	if !governance.HasPermission(ctx, modifyPerm, []string{ownerRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -202,7 +203,7 @@ func (dw *DeployerWhitelist) RemoveDeployer(ctx contract.Context, req *RemoveDep
 		return ErrInvalidRequest
 	}
 
-	if ok, _ := ctx.HasPermission(modifyPerm, []string{ownerRole}); !ok {
+	if !governance.HasPermission(ctx, modifyPerm, []string{ownerRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -0,0 +1,152 @@
+package dposv3
+
+import (
+	"fmt"
+
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// Commission limits allow a candidate to commit to a maximum fee, and to a maximum fee increase per
+// fee change, so delegators can't be caught out by a sudden fee hike. Limits are declared when the
+// candidate registers (or later on by candidates that registered without them), and once declared
+// they can only be tightened. Limits outlive the registration they were declared with, so a
+// candidate can't shed them by unregistering and registering again. The limits are enforced by
+// ChangeFee once the DPOSVersion3_14 feature flag is enabled.
+
+var errCommissionLimitsLoosened = errors.New("Commission limits can only be tightened.")
+
+// Returns an error if changing a candidate's fee from fee to newFee would exceed the given limits.
+func checkCommissionLimits(limits *CommissionLimits, fee, newFee uint64) error {
+	if newFee > limits.MaxFee {
+		return fmt.Errorf("Candidate fee cannot be greater than the maximum fee of %d", limits.MaxFee)
+	}
+	if newFee > fee && newFee-fee > limits.MaxFeeChange {
+		return fmt.Errorf("Candidate fee cannot be raised by more than %d at a time", limits.MaxFeeChange)
+	}
+	return nil
+}
+
+func validateCommissionLimits(limits *CommissionLimits) error {
+	if limits == nil {
+		return errors.New("Commission limits not specified")
+	}
+	if err := validateFee(limits.MaxFee); err != nil {
+		return err
+	}
+	return validateFee(limits.MaxFeeChange)
+}
+
+// RegisterCandidateWithCommission registers a candidate along with the commission limits it
+// commits to.
+func (c *DPOS) RegisterCandidateWithCommission(
+	ctx contract.Context, req *RegisterCandidateWithCommissionRequest,
+) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_14, false) {
+		return errors.New("DPOS v3.14 is not enabled")
+	}
+	if req.Candidate == nil {
+		return logDposError(ctx, errors.New("RegisterCandidateWithCommission called with req.Candidate == nil"), req.String())
+	}
+	if err := validateCommissionLimits(req.Limits); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+	if err := checkCommissionLimits(req.Limits, req.Candidate.Fee, req.Candidate.Fee); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+
+	limits, err := loadCommissionLimits(ctx, ctx.Message().Sender)
+	if err != nil {
+		return err
+	}
+	if limits != nil && (req.Limits.MaxFee > limits.MaxFee || req.Limits.MaxFeeChange > limits.MaxFeeChange) {
+		return logDposError(ctx, errCommissionLimitsLoosened, req.String())
+	}
+
+	if err := c.RegisterCandidate(ctx, req.Candidate); err != nil {
+		return err
+	}
+	return saveCommissionLimits(ctx, ctx.Message().Sender, req.Limits)
+}
+
+// SetCommissionLimits declares the commission limits of a registered candidate, or tightens the
+// limits it has already declared.
+func (c *DPOS) SetCommissionLimits(ctx contract.Context, req *SetCommissionLimitsRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_14, false) {
+		return errors.New("DPOS v3.14 is not enabled")
+	}
+
+	candidateAddress := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 SetCommissionLimits", "candidate", candidateAddress, "request", req)
+
+	if err := validateCommissionLimits(req.Limits); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+
+	candidates, err := LoadCandidateList(ctx)
+	if err != nil {
+		return err
+	}
+	cand := candidates.Get(candidateAddress)
+	if cand == nil {
+		return errCandidateNotFound
+	}
+
+	// the limits must accommodate the current fee & any fee change that's already in progress
+	if err := checkCommissionLimits(req.Limits, cand.Fee, cand.Fee); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+	if err := checkCommissionLimits(req.Limits, cand.Fee, cand.NewFee); err != nil {
+		return logDposError(ctx, err, req.String())
+	}
+
+	limits, err := loadCommissionLimits(ctx, candidateAddress)
+	if err != nil {
+		return err
+	}
+	if limits != nil && (req.Limits.MaxFee > limits.MaxFee || req.Limits.MaxFeeChange > limits.MaxFeeChange) {
+		return logDposError(ctx, errCommissionLimitsLoosened, req.String())
+	}
+
+	return saveCommissionLimits(ctx, candidateAddress, req.Limits)
+}
+
+// GetCandidateFeeSchedule returns the current fee, pending fee change, and commission limits of a
+// candidate.
+func (c *DPOS) GetCandidateFeeSchedule(
+	ctx contract.StaticContext, req *GetCandidateFeeScheduleRequest,
+) (*GetCandidateFeeScheduleResponse, error) {
+	if req.Candidate == nil {
+		return nil, logStaticDposError(ctx, errors.New("GetCandidateFeeSchedule called with req.Candidate == nil"), req.String())
+	}
+
+	candidates, err := LoadCandidateList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cand := candidates.Get(loom.UnmarshalAddressPB(req.Candidate))
+	if cand == nil {
+		return nil, errCandidateNotFound
+	}
+
+	schedule, err := candidateFeeSchedule(ctx, cand)
+	if err != nil {
+		return nil, err
+	}
+	return &GetCandidateFeeScheduleResponse{FeeSchedule: schedule}, nil
+}
+
+func candidateFeeSchedule(ctx contract.StaticContext, cand *Candidate) (*CandidateFeeSchedule, error) {
+	limits, err := loadCommissionLimits(ctx, loom.UnmarshalAddressPB(cand.Address))
+	if err != nil {
+		return nil, err
+	}
+	return &CandidateFeeSchedule{
+		Candidate: cand.Address,
+		Fee:       cand.Fee,
+		NewFee:    cand.NewFee,
+		Limits:    limits,
+	}, nil
+}
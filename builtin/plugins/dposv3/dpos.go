@@ -1,6 +1,8 @@
 package dposv3
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"sort"
@@ -13,6 +15,7 @@ import (
 	"github.com/loomnetwork/go-loom/plugin"
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/pagination"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/pkg/errors"
 )
@@ -54,6 +57,8 @@ const (
 	DelegatorUnbondsEventTopic       = "dposv3:delegatorunbonds"
 	ReferrerRegistersEventTopic      = "dposv3:referrerregisters"
 	DelegatorClaimsRewardsEventTopic = "dposv3:delegatorclaimsrewards"
+	DelegatorCancelsUnbondEventTopic = "dposv3:delegatorcancelsunbond"
+	ValidatorKeyRotatedEventTopic    = "dposv3:validatorkeyrotated"
 )
 
 var (
@@ -88,8 +93,6 @@ type (
 	UnbondRequest                     = dtypes.UnbondRequest
 	UnbondAllRequest                  = dtypes.UnbondAllRequest
 	ConsolidateDelegationsRequest     = dtypes.ConsolidateDelegationsRequest
-	CheckAllDelegationsRequest        = dtypes.CheckAllDelegationsRequest
-	CheckAllDelegationsResponse       = dtypes.CheckAllDelegationsResponse
 	CheckDelegationRequest            = dtypes.CheckDelegationRequest
 	CheckDelegationResponse           = dtypes.CheckDelegationResponse
 	CheckRewardsRequest               = dtypes.CheckRewardsRequest
@@ -106,17 +109,9 @@ type (
 	SetMinCandidateFeeRequest         = dtypes.SetMinCandidateFeeRequest
 	UpdateCandidateInfoRequest        = dtypes.UpdateCandidateInfoRequest
 	UnregisterCandidateRequest        = dtypes.UnregisterCandidateRequest
-	ListCandidatesRequest             = dtypes.ListCandidatesRequest
-	ListCandidatesResponse            = dtypes.ListCandidatesResponse
 	ListValidatorsRequest             = dtypes.ListValidatorsRequest
 	ListValidatorsResponse            = dtypes.ListValidatorsResponse
-	ListDelegationsRequest            = dtypes.ListDelegationsRequest
-	ListDelegationsResponse           = dtypes.ListDelegationsResponse
-	ListAllDelegationsRequest         = dtypes.ListAllDelegationsRequest
-	ListAllDelegationsResponse        = dtypes.ListAllDelegationsResponse
 	Referrer                          = dtypes.Referrer
-	ListReferrersRequest              = dtypes.ListReferrersRequest
-	ListReferrersResponse             = dtypes.ListReferrersResponse
 	RegisterReferrerRequest           = dtypes.RegisterReferrerRequest
 	SetDowntimePeriodRequest          = dtypes.SetDowntimePeriodRequest
 	SetElectionCycleRequest           = dtypes.SetElectionCycleRequest
@@ -425,6 +420,10 @@ func (c *DPOS) Redelegate(ctx contract.Context, req *RedelegateRequest) error {
 		}
 	}
 
+	if err := dropDelegationUnbonding(ctx, priorDelegation); err != nil {
+		return err
+	}
+
 	// if req.Amount == nil, it is assumed caller wants to redelegate full delegation
 	if req.Amount == nil || priorDelegation.Amount.Value.Cmp(&req.Amount.Value) == 0 {
 		priorDelegation.UpdateAmount = priorDelegation.Amount
@@ -550,8 +549,8 @@ func consolidateDelegations(ctx contract.Context, validator, delegator *types.Ad
 	return delegation, consolidatedDelegations, unconsolidatedDelegationsCount, nil
 }
 
-/// Returns the total amount which will be available to the user's balance
-/// if they claim all rewards that are owed to them
+// / Returns the total amount which will be available to the user's balance
+// / if they claim all rewards that are owed to them
 func (c *DPOS) CheckRewardsFromAllValidators(ctx contract.StaticContext, req *CheckDelegatorRewardsRequest) (*CheckDelegatorRewardsResponse, error) {
 	if req.Delegator == nil {
 		return nil, logStaticDposError(ctx, errors.New("CheckRewardsFromAllValidators called with req.Delegator == nil"), req.String())
@@ -587,9 +586,9 @@ func (c *DPOS) CheckRewardsFromAllValidators(ctx contract.StaticContext, req *Ch
 	}, nil
 }
 
-/// ClaimRewardsFromAllValidators unbonds the full amount of the rewards delegation from all validators
-/// a delegator has delegated to, and returns the total amount which will be transferred to the
-/// delegator's account after the next election.
+// / ClaimRewardsFromAllValidators unbonds the full amount of the rewards delegation from all validators
+// / a delegator has delegated to, and returns the total amount which will be transferred to the
+// / delegator's account after the next election.
 func (c *DPOS) ClaimRewardsFromAllValidators(ctx contract.Context, req *ClaimDelegatorRewardsRequest) (*ClaimDelegatorRewardsResponse, error) {
 	if ctx.FeatureEnabled(features.DPOSVersion3_6, false) {
 		return c.claimRewardsFromAllValidators2(ctx, req)
@@ -627,7 +626,11 @@ func (c *DPOS) ClaimRewardsFromAllValidators(ctx contract.Context, req *ClaimDel
 			return nil, errors.Wrap(err, "failed to update delegation")
 		}
 
-		err = c.emitDelegatorUnbondsEvent(ctx, delegation)
+		if err := requeueActiveUnbonding(ctx, delegation); err != nil {
+			return nil, err
+		}
+
+		err = emitDelegatorUnbondsEvent(ctx, delegation)
 		if err != nil {
 			return nil, err
 		}
@@ -690,7 +693,11 @@ func (c *DPOS) claimRewardsFromAllValidators2(
 			return nil, errors.Wrap(err, "failed to update delegation")
 		}
 
-		if err := c.emitDelegatorUnbondsEvent(ctx, delegation); err != nil {
+		if err := requeueActiveUnbonding(ctx, delegation); err != nil {
+			return nil, err
+		}
+
+		if err := emitDelegatorUnbondsEvent(ctx, delegation); err != nil {
 			return nil, err
 		}
 
@@ -746,18 +753,35 @@ func (c *DPOS) Unbond(ctx contract.Context, req *UnbondRequest) error {
 		return logDposError(ctx, errDelegationLocked, req.String())
 	} else if delegation.State != BONDED {
 		return logDposError(ctx, errors.New("Existing delegation not in BONDED state."), req.String())
+	}
+
+	unbondingQueueEnabled := ctx.FeatureEnabled(features.DPOSVersion3_15, false)
+	if unbondingQueueEnabled {
+		pending, err := loadDelegationUnbonding(ctx, delegation)
+		if err != nil {
+			return err
+		}
+		if pending != nil {
+			return logDposError(ctx, errUnbondingPending, req.String())
+		}
+	}
+
+	delegation.State = UNBONDING
+	// if req.Amount == 0, the full amount is unbonded
+	if common.IsZero(req.Amount.Value) {
+		delegation.UpdateAmount = delegation.Amount
 	} else {
-		delegation.State = UNBONDING
-		// if req.Amount == 0, the full amount is unbonded
-		if common.IsZero(req.Amount.Value) {
-			delegation.UpdateAmount = delegation.Amount
-		} else {
-			delegation.UpdateAmount = req.Amount
+		delegation.UpdateAmount = req.Amount
+	}
+	SetDelegation(ctx, delegation)
+
+	if unbondingQueueEnabled {
+		if err := enqueueActiveUnbonding(ctx, delegation); err != nil {
+			return err
 		}
-		SetDelegation(ctx, delegation)
 	}
 
-	return c.emitDelegatorUnbondsEvent(ctx, delegation)
+	return emitDelegatorUnbondsEvent(ctx, delegation)
 }
 
 // UnbondAll unbonds the full amount on all currently bonded delegations.
@@ -771,14 +795,13 @@ func (c *DPOS) UnbondAll(ctx contract.Context, req *UnbondAllRequest) error {
 		return errors.New("validator address must be specified")
 	}
 
-	sender := ctx.Message().Sender
 	state, err := LoadState(ctx)
 	if err != nil {
 		return err
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return errOnlyOracle
 	}
 
@@ -813,7 +836,11 @@ func (c *DPOS) UnbondAll(ctx contract.Context, req *UnbondAllRequest) error {
 				return err
 			}
 
-			if err = c.emitDelegatorUnbondsEvent(ctx, delegation); err != nil {
+			if err := requeueActiveUnbonding(ctx, delegation); err != nil {
+				return err
+			}
+
+			if err = emitDelegatorUnbondsEvent(ctx, delegation); err != nil {
 				return err
 			}
 		}
@@ -848,34 +875,86 @@ func (c *DPOS) CheckAllDelegations(ctx contract.StaticContext, req *CheckAllDele
 		return nil, logStaticDposError(ctx, errors.New("CheckAllDelegations called with req.DelegatorAddress == nil"), req.String())
 	}
 
-	delegations, err := loadDelegationList(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	totalDelegationAmount := common.BigZero()
-	totalWeightedDelegationAmount := common.BigZero()
 	var delegatorDelegations []*Delegation
-	for _, d := range delegations {
-		if loom.UnmarshalAddressPB(d.Delegator).Compare(loom.UnmarshalAddressPB(req.DelegatorAddress)) != 0 {
-			continue
+	var nextKey []byte
+	if req.Limit > 0 {
+		var err error
+		delegatorDelegations, nextKey, err = loadDelegationRangePage(
+			ctx, delegatorDelegationsKey(*req.DelegatorAddress), req.StartKey, req.Limit,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		delegations, err := loadDelegationList(ctx)
+		if err != nil {
+			return nil, err
 		}
 
-		delegation, err := GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+		for _, d := range delegations {
+			if loom.UnmarshalAddressPB(d.Delegator).Compare(loom.UnmarshalAddressPB(req.DelegatorAddress)) != 0 {
+				continue
+			}
 
-		if err == contract.ErrNotFound {
-			continue
-		} else if err != nil {
-			return nil, err
+			delegation, err := GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+
+			if err == contract.ErrNotFound {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			delegatorDelegations = append(delegatorDelegations, delegation)
 		}
+	}
 
+	totalDelegationAmount := common.BigZero()
+	totalWeightedDelegationAmount := common.BigZero()
+	for _, delegation := range delegatorDelegations {
 		totalDelegationAmount.Add(totalDelegationAmount, &delegation.Amount.Value)
 		weightedAmount := calculateWeightedDelegationAmount(*delegation)
 		totalWeightedDelegationAmount.Add(totalWeightedDelegationAmount, &weightedAmount)
-		delegatorDelegations = append(delegatorDelegations, delegation)
 	}
 
-	return &CheckAllDelegationsResponse{Amount: &types.BigUInt{Value: *totalDelegationAmount}, WeightedAmount: &types.BigUInt{Value: *totalWeightedDelegationAmount}, Delegations: delegatorDelegations}, nil
+	return &CheckAllDelegationsResponse{
+		Amount:         &types.BigUInt{Value: *totalDelegationAmount},
+		WeightedAmount: &types.BigUInt{Value: *totalWeightedDelegationAmount},
+		Delegations:    delegatorDelegations,
+		NextKey:        nextKey,
+	}, nil
+}
+
+// Loads a page of the delegations in the range of a validator or delegator (see
+// validatorDelegationsKey & delegatorDelegationsKey), only the delegations in the page are loaded.
+func loadDelegationRangePage(
+	ctx contract.StaticContext, rangeKey []byte, startKey []byte, limit uint32,
+) ([]*Delegation, []byte, error) {
+	if !delegationIndicesBuilt(ctx) {
+		return nil, nil, errors.New("delegations can't be paged until the delegation indices are built")
+	}
+
+	entries := ctx.Range(rangeKey)
+	keys := make([][]byte, len(entries))
+	for i, entry := range entries {
+		keys[i] = entry.Key
+	}
+	indices, nextKey := pagination.Page(keys, startKey, limit)
+
+	page := make([]*Delegation, 0, len(indices))
+	for _, i := range indices {
+		var d DelegationIndex
+		if err := proto.Unmarshal(entries[i].Value, &d); err != nil {
+			return nil, nil, errors.Wrap(err, "unmarshal delegation index")
+		}
+		delegation, err := GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+		if err == contract.ErrNotFound {
+			continue
+		} else if err != nil {
+			return nil, nil, err
+		}
+		page = append(page, delegation)
+	}
+	return page, nextKey, nil
 }
 
 // **************************
@@ -892,7 +971,7 @@ func (c *DPOS) WhitelistCandidate(ctx contract.Context, req *WhitelistCandidateR
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -938,7 +1017,7 @@ func (c *DPOS) RemoveWhitelistedCandidate(ctx contract.Context, req *RemoveWhite
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -964,7 +1043,7 @@ func (c *DPOS) ChangeWhitelistInfo(ctx contract.Context, req *ChangeWhitelistInf
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -1013,6 +1092,19 @@ func (c *DPOS) RegisterCandidate(ctx contract.Context, req *RegisterCandidateReq
 		return logDposError(ctx, err, req.String())
 	}
 
+	// commission limits declared during a previous registration still apply
+	if ctx.FeatureEnabled(features.DPOSVersion3_14, false) {
+		limits, err := loadCommissionLimits(ctx, candidateAddress)
+		if err != nil {
+			return err
+		}
+		if limits != nil {
+			if err := checkCommissionLimits(limits, req.Fee, req.Fee); err != nil {
+				return logDposError(ctx, err, req.String())
+			}
+		}
+	}
+
 	// validate the maximum referral fee candidate is willing to accept
 	if err = validateFee(req.MaxReferralPercentage); err != nil {
 		return logDposError(ctx, err, req.String())
@@ -1102,7 +1194,7 @@ func (c *DPOS) Unjail(ctx contract.Context, req *UnjailRequest) error {
 		if err != nil {
 			return err
 		}
-		if state.Params.OracleAddress == nil || ctx.Message().Sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+		if !isOracle(ctx, state) {
 			return errors.New("Only the oracle can unjail other validators")
 		}
 		candidateAddress = loom.UnmarshalAddressPB(req.Validator)
@@ -1149,6 +1241,18 @@ func (c *DPOS) ChangeFee(ctx contract.Context, req *ChangeCandidateFeeRequest) e
 		return logDposError(ctx, err, req.String())
 	}
 
+	if ctx.FeatureEnabled(features.DPOSVersion3_14, false) {
+		limits, err := loadCommissionLimits(ctx, candidateAddress)
+		if err != nil {
+			return err
+		}
+		if limits != nil {
+			if err := checkCommissionLimits(limits, cand.Fee, req.Fee); err != nil {
+				return logDposError(ctx, err, req.String())
+			}
+		}
+	}
+
 	cand.NewFee = req.Fee
 	cand.State = ABOUT_TO_CHANGE_FEE
 
@@ -1156,7 +1260,7 @@ func (c *DPOS) ChangeFee(ctx contract.Context, req *ChangeCandidateFeeRequest) e
 		return err
 	}
 
-	return c.emitCandidateFeeChangeEvent(ctx, candidateAddress.MarshalPB(), req.Fee)
+	return c.emitCandidateFeeChangeEvent(ctx, cand)
 }
 
 func (c *DPOS) UpdateCandidateInfo(ctx contract.Context, req *UpdateCandidateInfoRequest) error {
@@ -1204,8 +1308,7 @@ func (c *DPOS) UnregisterCandidate(ctx contract.Context, req *UnregisterCandidat
 			return err
 		}
 
-		if state.Params.OracleAddress == nil ||
-			ctx.Message().Sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+		if !isOracle(ctx, state) {
 			return errOnlyOracle
 		}
 
@@ -1257,6 +1360,9 @@ func (c *DPOS) UnregisterCandidate(ctx contract.Context, req *UnregisterCandidat
 				if err := SetDelegation(ctx, delegation); err != nil {
 					return err
 				}
+				if err := requeueActiveUnbonding(ctx, delegation); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -1269,7 +1375,7 @@ func (c *DPOS) UnregisterCandidate(ctx contract.Context, req *UnregisterCandidat
 			return err
 		}
 
-		err = slashValidatorDelegations(ctx, DefaultNoCache, statistic, candidateAddress)
+		err = slashValidatorDelegations(ctx, DefaultNoCache, statistic, candidateAddress, nil)
 		// NOTE: we ignore the error if DPOSVersion3_4 is not enabled to retain backwards compatibility
 		if ctx.FeatureEnabled(features.DPOSVersion3_4, false) {
 			if err != nil {
@@ -1290,7 +1396,23 @@ func (c *DPOS) ListCandidates(ctx contract.StaticContext, req *ListCandidatesReq
 		return nil, logStaticDposError(ctx, err, req.String())
 	}
 
+	var nextKey []byte
+	if req.Limit > 0 {
+		keys := make([][]byte, len(candidates))
+		for i, candidate := range candidates {
+			keys[i] = candidate.Address.Local
+		}
+		var indices []int
+		indices, nextKey = pagination.Page(keys, req.StartKey, req.Limit)
+		page := make(CandidateList, len(indices))
+		for i, j := range indices {
+			page[i] = candidates[j]
+		}
+		candidates = page
+	}
+
 	candidateStatistics := make([]*CandidateStatistic, 0)
+	feeSchedules := make([]*CandidateFeeSchedule, 0, len(candidates))
 	for _, candidate := range candidates {
 		statistic, err := GetStatistic(ctx, loom.UnmarshalAddressPB(candidate.Address))
 		if err != nil && err != contract.ErrNotFound {
@@ -1301,10 +1423,18 @@ func (c *DPOS) ListCandidates(ctx contract.StaticContext, req *ListCandidatesReq
 			Candidate: candidate,
 			Statistic: statistic,
 		})
+
+		feeSchedule, err := candidateFeeSchedule(ctx, candidate)
+		if err != nil {
+			return nil, err
+		}
+		feeSchedules = append(feeSchedules, feeSchedule)
 	}
 
 	return &ListCandidatesResponse{
-		Candidates: candidateStatistics,
+		Candidates:   candidateStatistics,
+		FeeSchedules: feeSchedules,
+		NextKey:      nextKey,
 	}, nil
 }
 
@@ -1326,12 +1456,37 @@ func Elect(ctx contract.Context) error {
 		return nil
 	}
 
+	// The delegations created before the delegation indices were enabled are indexed in the first
+	// election after they're enabled.
+	if ctx.FeatureEnabled(features.DPOSVersion3_17, false) {
+		if err := buildDelegationIndices(ctx, cachedDelegations); err != nil {
+			return err
+		}
+	}
+
 	delegationResults, err := rewardAndSlash(ctx, cachedDelegations, state)
 	if err != nil {
 		return err
 	}
 	ctx.Logger().Debug("DPOSv3 Elect", "delegationResults", len(delegationResults))
 
+	// The unbondings that completed in this election have been released by rewardAndSlash, the
+	// ones that complete in the next election are moved into the UNBONDING state.
+	if ctx.FeatureEnabled(features.DPOSVersion3_15, false) {
+		if err := advanceUnbondingQueue(ctx, state); err != nil {
+			return err
+		}
+	}
+
+	// Key rotations are applied once the rewards for the last election period have been distributed
+	// to the delegations of the old addresses, and before the new validator set is picked so that
+	// the new keys replace the old ones in the same validator set update.
+	if ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+		if err := applyValidatorKeyRotations(ctx, cachedDelegations, delegationResults); err != nil {
+			return err
+		}
+	}
+
 	validatorCount := int(state.Params.ValidatorCount)
 	if len(delegationResults) < validatorCount {
 		validatorCount = len(delegationResults)
@@ -1510,12 +1665,25 @@ func (c *DPOS) ListDelegations(ctx contract.StaticContext, req *ListDelegationsR
 		return nil, logStaticDposError(ctx, errors.New("ListDelegations called with req.Candidate == nil"), req.String())
 	}
 
+	if req.Limit > 0 {
+		page, nextKey, err := loadDelegationRangePage(
+			ctx, validatorDelegationsKey(*req.Candidate), req.StartKey, req.Limit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &ListDelegationsResponse{
+			Delegations:     page,
+			DelegationTotal: &types.BigUInt{Value: *delegationsTotal(page)},
+			NextKey:         nextKey,
+		}, nil
+	}
+
 	delegations, err := loadDelegationList(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	total := common.BigZero()
 	candidateDelegations := make([]*Delegation, 0)
 	for _, d := range delegations {
 		if loom.UnmarshalAddressPB(d.Validator).Compare(loom.UnmarshalAddressPB(req.Candidate)) != 0 {
@@ -1530,15 +1698,22 @@ func (c *DPOS) ListDelegations(ctx contract.StaticContext, req *ListDelegationsR
 		}
 
 		candidateDelegations = append(candidateDelegations, delegation)
-		total = total.Add(total, &delegation.Amount.Value)
 	}
 
 	return &ListDelegationsResponse{
 		Delegations:     candidateDelegations,
-		DelegationTotal: &types.BigUInt{Value: *total},
+		DelegationTotal: &types.BigUInt{Value: *delegationsTotal(candidateDelegations)},
 	}, nil
 }
 
+func delegationsTotal(delegations []*Delegation) *common.BigUInt {
+	total := common.BigZero()
+	for _, delegation := range delegations {
+		total.Add(total, &delegation.Amount.Value)
+	}
+	return total
+}
+
 func (c *DPOS) ListAllDelegations(ctx contract.StaticContext, req *ListAllDelegationsRequest) (*ListAllDelegationsResponse, error) {
 	ctx.Logger().Debug("DPOSv3 ListAllDelegations", "request", req)
 
@@ -1547,6 +1722,10 @@ func (c *DPOS) ListAllDelegations(ctx contract.StaticContext, req *ListAllDelega
 		return nil, err
 	}
 
+	if req.Limit > 0 {
+		return listAllDelegationsPage(ctx, candidates, req.StartKey, req.Limit)
+	}
+
 	responses := make([]*ListDelegationsResponse, 0)
 	for _, candidate := range candidates {
 		response, err := c.ListDelegations(ctx, &ListDelegationsRequest{Candidate: candidate.Address})
@@ -1561,8 +1740,87 @@ func (c *DPOS) ListAllDelegations(ctx contract.StaticContext, req *ListAllDelega
 	}, nil
 }
 
+// Returns a page of the delegations of the given candidates. The key of a delegation in the page
+// is the local address of its validator followed by its key in the range of the validator, so the
+// page may span the ranges of multiple validators.
+func listAllDelegationsPage(
+	ctx contract.StaticContext, candidates CandidateList, startKey []byte, limit uint32,
+) (*ListAllDelegationsResponse, error) {
+	const validatorKeySize = 20
+	if len(startKey) > 0 && len(startKey) < validatorKeySize {
+		return nil, errors.New("invalid start key")
+	}
+
+	sorted := make(CandidateList, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address.Local, sorted[j].Address.Local) < 0
+	})
+
+	remaining := pagination.PageSize(limit)
+	responses := make([]*ListDelegationsResponse, 0)
+	for i, candidate := range sorted {
+		var validatorStartKey []byte
+		if len(startKey) > 0 {
+			cmp := bytes.Compare(candidate.Address.Local, startKey[:validatorKeySize])
+			if cmp < 0 {
+				continue
+			} else if cmp == 0 {
+				validatorStartKey = startKey[validatorKeySize:]
+			}
+		}
+
+		page, nextKey, err := loadDelegationRangePage(
+			ctx, validatorDelegationsKey(*candidate.Address), validatorStartKey, uint32(remaining),
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(page) > 0 {
+			responses = append(responses, &ListDelegationsResponse{
+				Delegations:     page,
+				DelegationTotal: &types.BigUInt{Value: *delegationsTotal(page)},
+			})
+		}
+
+		if nextKey != nil {
+			return &ListAllDelegationsResponse{
+				ListResponses: responses,
+				NextKey:       append(append([]byte{}, candidate.Address.Local...), nextKey...),
+			}, nil
+		}
+		remaining -= len(page)
+		if remaining <= 0 && i+1 < len(sorted) {
+			return &ListAllDelegationsResponse{
+				ListResponses: responses,
+				NextKey:       append([]byte{}, sorted[i+1].Address.Local...),
+			}, nil
+		}
+	}
+
+	return &ListAllDelegationsResponse{
+		ListResponses: responses,
+	}, nil
+}
+
 func (c *DPOS) ListReferrers(ctx contract.StaticContext, req *ListReferrersRequest) (*ListReferrersResponse, error) {
 	referrerRange := ctx.Range([]byte(referrerPrefix))
+
+	var nextKey []byte
+	if req.Limit > 0 {
+		keys := make([][]byte, len(referrerRange))
+		for i, referrer := range referrerRange {
+			keys[i] = referrer.Key
+		}
+		var indices []int
+		indices, nextKey = pagination.Page(keys, req.StartKey, req.Limit)
+		page := make(plugin.RangeData, len(indices))
+		for i, j := range indices {
+			page[i] = referrerRange[j]
+		}
+		referrerRange = page
+	}
+
 	referrers := make([]*Referrer, 0, len(referrerRange))
 	for _, referrer := range referrerRange {
 		var addr types.Address
@@ -1576,6 +1834,7 @@ func (c *DPOS) ListReferrers(ctx contract.StaticContext, req *ListReferrersReque
 	}
 	return &ListReferrersResponse{
 		Referrers: referrers,
+		NextKey:   nextKey,
 	}, nil
 }
 
@@ -1588,8 +1847,7 @@ func (c *DPOS) EnableValidatorJailing(ctx contract.Context, req *EnableValidator
 	if err != nil {
 		return err
 	}
-	sender := ctx.Message().Sender
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return errOnlyOracle
 	}
 	if state.Params.JailOfflineValidators == req.JailOfflineValidators {
@@ -1609,8 +1867,7 @@ func (c *DPOS) IgnoreUnbondLocktime(ctx contract.Context, req *IgnoreUnbondLockt
 	if err != nil {
 		return err
 	}
-	sender := ctx.Message().Sender
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return errOnlyOracle
 	}
 	if state.Params.IgnoreUnbondLocktime == req.Ignore {
@@ -1782,6 +2039,48 @@ func SlashDoubleSign(ctx contract.Context, statistic *ValidatorStatistic) error
 	return slash(ctx, statistic, state.Params.ByzantineSlashingPercentage.Value)
 }
 
+// SlashDoubleSignEvidence slashes & jails the validator that committed the double-signing offence
+// identified by the given evidence hash. The slash is recorded against the validator's statistic
+// and applied to the validator's & delegators' stake in the next election. Evidence that has
+// already been processed is ignored, so it's safe to call this function multiple times with the
+// same evidence.
+func SlashDoubleSignEvidence(ctx contract.Context, evidenceHash []byte, validatorAddr loom.Address) error {
+	if len(evidenceHash) == 0 {
+		return errors.New("missing evidence hash")
+	}
+	if ctx.Has(doubleSignEvidenceKey(evidenceHash)) {
+		return nil
+	}
+
+	statistic, err := GetStatistic(ctx, validatorAddr)
+	if err != nil {
+		return logDposError(ctx, err, "SlashDoubleSignEvidence attempted to process invalid validator address")
+	}
+
+	ctx.Logger().Info(
+		"DPOSv3 SlashDoubleSignEvidence",
+		"validator", validatorAddr.String(),
+		"evidence", hex.EncodeToString(evidenceHash),
+	)
+
+	if err := SlashDoubleSign(ctx, statistic); err != nil {
+		return err
+	}
+
+	if !statistic.Jailed {
+		statistic.Jailed = true
+		if err := emitJailEvent(ctx, statistic.Address); err != nil {
+			return err
+		}
+	}
+
+	if err := SetStatistic(ctx, statistic); err != nil {
+		return err
+	}
+
+	return ctx.Set(doubleSignEvidenceKey(evidenceHash), statistic.Address)
+}
+
 func slash(ctx contract.Context, statistic *ValidatorStatistic, slashPercentage loom.BigUInt) error {
 	updatedAmount := common.BigZero()
 	updatedAmount.Add(&statistic.SlashPercentage.Value, &slashPercentage)
@@ -1831,6 +2130,7 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 	formerValidatorTotals := make(map[string]loom.BigUInt)
 	delegatorRewards := make(map[string]*loom.BigUInt)
 	distributedRewards := common.BigZero()
+	ledger := newElectionLedger(ctx)
 
 	delegations, err := cachedDelegations.loadDelegationList(ctx)
 	if err != nil {
@@ -1853,6 +2153,7 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 			delegatorRewards[validatorKey] = common.BigZero()
 			formerValidatorTotals[validatorKey] = *common.BigZero()
 		} else {
+			ledger.recordValidator(candidateAddress, statistic)
 			// If a validator is jailed, don't calculate and distribute rewards
 			if ctx.FeatureEnabled(features.DPOSVersion3_3, false) {
 				if statistic.Jailed {
@@ -1875,6 +2176,7 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 				delegatorsShare := common.BigZero()
 				delegatorsShare.Sub(&distributionTotal, &validatorShare)
 				delegatorRewards[validatorKey] = delegatorsShare
+				referrerFees := common.BigZero()
 
 				// Distribute rewards to referrers
 				for _, d := range delegations {
@@ -1903,14 +2205,26 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 						// referrer fees are delegater to limbo validator
 						distributedRewards.Add(distributedRewards, &referrerReward)
 						cachedDelegations.IncreaseRewardDelegation(ctx, LimboValidatorAddress(ctx).MarshalPB(), referrerAddress, referrerReward)
+						ledger.recordReward(
+							referrerAddress, LimboValidatorAddress(ctx).MarshalPB(), REWARD_DELEGATION_INDEX,
+							delegation.Amount.Value, referrerReward,
+						)
 
 						// any referrer bonus amount is subtracted from the validatorShare
 						validatorShare.Sub(&validatorShare, &referrerReward)
+						referrerFees.Add(referrerFees, &referrerReward)
 					}
 				}
 
 				distributedRewards.Add(distributedRewards, &validatorShare)
 				cachedDelegations.IncreaseRewardDelegation(ctx, candidate.Address, candidate.Address, validatorShare)
+				ledger.recordValidatorRewards(
+					candidateAddress, distributionTotal, validatorShare, *referrerFees, *delegatorsShare,
+				)
+				ledger.recordReward(
+					candidate.Address, candidate.Address, REWARD_DELEGATION_INDEX,
+					statistic.DelegationTotal.Value, validatorShare,
+				)
 
 				// If a validator has some non-zero WhitelistAmount,
 				// calculate the validator's reward based on whitelist amount
@@ -1920,6 +2234,10 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 					// increase a delegator's distribution
 					distributedRewards.Add(distributedRewards, &whitelistDistribution)
 					cachedDelegations.IncreaseRewardDelegation(ctx, candidate.Address, candidate.Address, whitelistDistribution)
+					ledger.recordReward(
+						candidate.Address, candidate.Address, REWARD_DELEGATION_INDEX,
+						statistic.WhitelistAmount.Value, whitelistDistribution,
+					)
 				}
 
 				// Keeping track of cumulative distributed rewards by adding
@@ -1937,7 +2255,7 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 					state.TotalRewardDistribution.Value.Add(&state.TotalRewardDistribution.Value, &distributionTotal)
 				}
 			} else {
-				if err := slashValidatorDelegations(ctx, cachedDelegations, statistic, candidateAddress); err != nil {
+				if err := slashValidatorDelegations(ctx, cachedDelegations, statistic, candidateAddress, ledger); err != nil {
 					return nil, err
 				}
 				if err := SetStatistic(ctx, statistic); err != nil {
@@ -1949,7 +2267,7 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 		}
 	}
 
-	newDelegationTotals, err := distributeDelegatorRewards(ctx, cachedDelegations, formerValidatorTotals, delegatorRewards, distributedRewards)
+	newDelegationTotals, err := distributeDelegatorRewards(ctx, cachedDelegations, formerValidatorTotals, delegatorRewards, distributedRewards, ledger)
 	if err != nil {
 		return nil, err
 	}
@@ -1958,6 +2276,10 @@ func rewardAndSlash(ctx contract.Context, cachedDelegations *CachedDposStorage,
 		state.TotalRewardDistribution.Value.Add(&state.TotalRewardDistribution.Value, distributedRewards)
 	}
 
+	if err := ledger.save(ctx, newDelegationTotals, distributedRewards); err != nil {
+		return nil, err
+	}
+
 	delegationResults := make([]*DelegationResult, 0, len(newDelegationTotals))
 	for validator := range newDelegationTotals {
 		delegationResults = append(delegationResults, &DelegationResult{
@@ -1998,7 +2320,7 @@ func calculateRewards(delegationTotal loom.BigUInt, params *Params, totalValidat
 
 func slashValidatorDelegations(
 	ctx contract.Context, cachedDelegations *CachedDposStorage, statistic *ValidatorStatistic,
-	validatorAddress loom.Address,
+	validatorAddress loom.Address, ledger *electionLedger,
 ) error {
 	if common.IsZero(statistic.SlashPercentage.Value) {
 		return nil
@@ -2027,6 +2349,7 @@ func slashValidatorDelegations(
 			if err := cachedDelegations.SetDelegation(ctx, delegation); err != nil {
 				return err
 			}
+			ledger.recordSlash(validatorAddress, statistic.SlashPercentage, toSlash)
 			if err := emitSlashDelegationEvent(
 				ctx, delegation.Delegator, delegation.Validator, delegation.Index, delegation.Amount,
 				&types.BigUInt{Value: toSlash}, statistic.SlashPercentage,
@@ -2045,6 +2368,7 @@ func slashValidatorDelegations(
 		updatedAmount := common.BigZero()
 		updatedAmount.Sub(&statistic.WhitelistAmount.Value, &toSlash)
 		statistic.WhitelistAmount = &types.BigUInt{Value: *updatedAmount}
+		ledger.recordSlash(validatorAddress, statistic.SlashPercentage, toSlash)
 		if err := emitSlashWhitelistAmountEvent(
 			ctx, validatorAddress.MarshalPB(), beforeSlashedWhitelistAmount,
 			&types.BigUInt{Value: toSlash}, statistic.SlashPercentage,
@@ -2063,7 +2387,7 @@ func slashValidatorDelegations(
 // the delegators, 2) finalize the bonding process for any delegations received
 // during the last election period (delegate & unbond calls) and 3) calculate
 // the new delegation totals.
-func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedDposStorage, formerValidatorTotals map[string]loom.BigUInt, delegatorRewards map[string]*loom.BigUInt, distributedRewards *loom.BigUInt) (map[string]*loom.BigUInt, error) {
+func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedDposStorage, formerValidatorTotals map[string]loom.BigUInt, delegatorRewards map[string]*loom.BigUInt, distributedRewards *loom.BigUInt, ledger *electionLedger) (map[string]*loom.BigUInt, error) {
 	newDelegationTotals := make(map[string]*loom.BigUInt)
 
 	candidates, err := LoadCandidateList(ctx)
@@ -2116,6 +2440,10 @@ func distributeDelegatorRewards(ctx contract.Context, cachedDelegations *CachedD
 				// increase a delegator's distribution
 				distributedRewards.Add(distributedRewards, &delegatorDistribution)
 				cachedDelegations.IncreaseRewardDelegation(ctx, delegation.Validator, delegation.Delegator, delegatorDistribution)
+				ledger.recordReward(
+					delegation.Delegator, delegation.Validator, delegation.Index,
+					delegation.Amount.Value, delegatorDistribution,
+				)
 
 				// If the reward delegation is updated by the
 				// IncreaseRewardDelegation command, we must be sure to use this
@@ -2299,7 +2627,7 @@ func (c *DPOS) RegisterReferrer(ctx contract.Context, req *RegisterReferrerReque
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2396,7 +2724,7 @@ func (c *DPOS) SetElectionCycle(ctx contract.Context, req *SetElectionCycleReque
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2410,14 +2738,13 @@ func (c *DPOS) SetDowntimePeriod(ctx contract.Context, req *SetDowntimePeriodReq
 		return errors.New("DPOS v3.2 is not enabled")
 	}
 
-	sender := ctx.Message().Sender
 	state, err := LoadState(ctx)
 	if err != nil {
 		return err
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2436,7 +2763,7 @@ func (c *DPOS) SetMaxYearlyReward(ctx contract.Context, req *SetMaxYearlyRewardR
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2455,7 +2782,7 @@ func (c *DPOS) SetRegistrationRequirement(ctx contract.Context, req *SetRegistra
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2474,7 +2801,7 @@ func (c *DPOS) SetValidatorCount(ctx contract.Context, req *SetValidatorCountReq
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2493,7 +2820,7 @@ func (c *DPOS) SetOracleAddress(ctx contract.Context, req *SetOracleAddressReque
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2518,7 +2845,7 @@ func (c *DPOS) SetSlashingPercentages(ctx contract.Context, req *SetSlashingPerc
 	}
 
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2549,7 +2876,7 @@ func (c *DPOS) SetMaxDowntimePercentage(ctx contract.Context, req *SetMaxDowntim
 		return err
 	}
 
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2573,7 +2900,7 @@ func (c *DPOS) SetMinCandidateFee(ctx contract.Context, req *SetMinCandidateFeeR
 
 	//TODO: this will be replaced with voting system next week
 	// ensure that function is only executed when called by oracle
-	if state.Params.OracleAddress == nil || sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) != 0 {
+	if !isOracle(ctx, state) {
 		return logDposError(ctx, errOnlyOracle, req.String())
 	}
 
@@ -2701,11 +3028,23 @@ func (c *DPOS) emitCandidateUnregistersEvent(ctx contract.Context, candidate *ty
 	return nil
 }
 
-func (c *DPOS) emitCandidateFeeChangeEvent(ctx contract.Context, candidate *types.Address, fee uint64) error {
-	marshalled, err := proto.Marshal(&DposCandidateFeeChangeEvent{
-		Address: candidate,
-		NewFee:  fee,
-	})
+func (c *DPOS) emitCandidateFeeChangeEvent(ctx contract.Context, candidate *Candidate) error {
+	var event proto.Message = &DposCandidateFeeChangeEvent{
+		Address: candidate.Address,
+		NewFee:  candidate.NewFee,
+	}
+	if ctx.FeatureEnabled(features.DPOSVersion3_14, false) {
+		schedule, err := candidateFeeSchedule(ctx, candidate)
+		if err != nil {
+			return err
+		}
+		event = &CandidateFeeChangeEvent{
+			Address:     candidate.Address,
+			NewFee:      candidate.NewFee,
+			FeeSchedule: schedule,
+		}
+	}
+	marshalled, err := proto.Marshal(event)
 	if err != nil {
 		return err
 	}
@@ -2768,7 +3107,7 @@ func (c *DPOS) emitDelegatorConsolidatesEvent(
 	return nil
 }
 
-func (c *DPOS) emitDelegatorUnbondsEvent(ctx contract.Context, delegation *Delegation) error {
+func emitDelegatorUnbondsEvent(ctx contract.Context, delegation *Delegation) error {
 	marshalled, err := proto.Marshal(&DposDelegatorUnbondsEvent{
 		Delegation: delegation,
 	})
@@ -357,6 +357,138 @@ func TestChangeFee(t *testing.T) {
 	assert.Equal(t, newFee, candidates[0].Candidate.NewFee)
 }
 
+func TestCommissionLimits(t *testing.T) {
+	oraclePubKey, _ := hex.DecodeString(validatorPubKeyHex2)
+	oracleAddr := loom.Address{
+		Local: loom.LocalAddressFromPublicKey(oraclePubKey),
+	}
+	pubKey, _ := hex.DecodeString(validatorPubKeyHex1)
+	addr := loom.Address{
+		ChainID: chainID,
+		Local:   loom.LocalAddressFromPublicKey(pubKey),
+	}
+	pubKey2, _ := hex.DecodeString(validatorPubKeyHex3)
+	addr2 := loom.Address{
+		ChainID: chainID,
+		Local:   loom.LocalAddressFromPublicKey(pubKey2),
+	}
+	pctx := createCtx()
+
+	coinContract := &coin.Coin{}
+	_ = pctx.CreateContract(contractpb.MakePluginContract(coinContract))
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount: 21,
+		OracleAddress:  oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(dpos.Address).WithSender(sender))
+	}
+	feeSchedule := func(candidate loom.Address) *CandidateFeeSchedule {
+		resp, err := dpos.Contract.GetCandidateFeeSchedule(
+			dposCtx(candidate), &GetCandidateFeeScheduleRequest{Candidate: candidate.MarshalPB()},
+		)
+		require.NoError(t, err)
+		return resp.FeeSchedule
+	}
+
+	amount := big.NewInt(1000000000000)
+	require.NoError(t, dpos.WhitelistCandidate(pctx.WithSender(oracleAddr), addr, amount, 0))
+	require.NoError(t, dpos.WhitelistCandidate(pctx.WithSender(oracleAddr), addr2, amount, 0))
+
+	registerReq := &RegisterCandidateWithCommissionRequest{
+		Candidate: &RegisterCandidateRequest{PubKey: pubKey, Fee: 100},
+		Limits:    &CommissionLimits{MaxFee: 2000, MaxFeeChange: 500},
+	}
+	require.Error(t, dpos.Contract.RegisterCandidateWithCommission(dposCtx(addr), registerReq))
+
+	pctx.SetFeature(features.DPOSVersion3_14, true)
+	// the fee can't exceed the max fee from the start
+	registerReq.Candidate.Fee = 3000
+	require.Error(t, dpos.Contract.RegisterCandidateWithCommission(dposCtx(addr), registerReq))
+	registerReq.Candidate.Fee = 100
+	require.NoError(t, dpos.Contract.RegisterCandidateWithCommission(dposCtx(addr), registerReq))
+
+	// raises are capped by the max fee & the max fee change
+	require.Error(t, dpos.ChangeFee(pctx.WithSender(addr), 2500))
+	require.Error(t, dpos.ChangeFee(pctx.WithSender(addr), 1000))
+	require.NoError(t, dpos.ChangeFee(pctx.WithSender(addr), 600))
+	schedule := feeSchedule(addr)
+	require.Equal(t, uint64(100), schedule.Fee)
+	require.Equal(t, uint64(600), schedule.NewFee)
+	require.Equal(t, uint64(2000), schedule.Limits.MaxFee)
+	require.Equal(t, uint64(500), schedule.Limits.MaxFeeChange)
+
+	require.NoError(t, elect(pctx, dpos.Address))
+	require.NoError(t, elect(pctx, dpos.Address))
+	require.Equal(t, uint64(600), feeSchedule(addr).Fee)
+
+	// limits can be tightened, but never loosened or set below the current fee
+	err = dpos.Contract.SetCommissionLimits(dposCtx(addr), &SetCommissionLimitsRequest{
+		Limits: &CommissionLimits{MaxFee: 3000, MaxFeeChange: 500},
+	})
+	require.Equal(t, errCommissionLimitsLoosened, err)
+	err = dpos.Contract.SetCommissionLimits(dposCtx(addr), &SetCommissionLimitsRequest{
+		Limits: &CommissionLimits{MaxFee: 500, MaxFeeChange: 200},
+	})
+	require.Error(t, err)
+	err = dpos.Contract.SetCommissionLimits(dposCtx(addr), &SetCommissionLimitsRequest{
+		Limits: &CommissionLimits{MaxFee: 1000, MaxFeeChange: 200},
+	})
+	require.NoError(t, err)
+	require.Error(t, dpos.ChangeFee(pctx.WithSender(addr), 1000))
+	// fee cuts aren't limited
+	require.NoError(t, dpos.ChangeFee(pctx.WithSender(addr), 100))
+
+	// candidates that registered without limits can declare them later
+	candidateFee := uint64(100)
+	err = dpos.RegisterCandidate(pctx.WithSender(addr2), pubKey2, nil, &candidateFee, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Nil(t, feeSchedule(addr2).Limits)
+	err = dpos.Contract.SetCommissionLimits(dposCtx(addr2), &SetCommissionLimitsRequest{
+		Limits: &CommissionLimits{MaxFee: 50, MaxFeeChange: 50},
+	})
+	require.Error(t, err)
+	err = dpos.Contract.SetCommissionLimits(dposCtx(addr2), &SetCommissionLimitsRequest{
+		Limits: &CommissionLimits{MaxFee: 1000, MaxFeeChange: 100},
+	})
+	require.NoError(t, err)
+	require.Error(t, dpos.ChangeFee(pctx.WithSender(addr2), 5000))
+
+	candidatesPage, err := dpos.Contract.ListCandidates(dposCtx(addr), &ListCandidatesRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, candidatesPage.FeeSchedules, 2)
+	for i, schedule := range candidatesPage.FeeSchedules {
+		require.Equal(t, candidatesPage.Candidates[i].Candidate.Address.String(), schedule.Candidate.String())
+		require.NotNil(t, schedule.Limits)
+	}
+	candidates, err := dpos.Contract.ListCandidates(dposCtx(addr), &ListCandidatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, candidates.FeeSchedules, 2)
+	for i, schedule := range candidates.FeeSchedules {
+		require.Equal(t, candidates.Candidates[i].Candidate.Address.String(), schedule.Candidate.String())
+	}
+
+	// limits outlive the registration, re-registering can't loosen them
+	require.NoError(t, dpos.UnregisterCandidate(pctx.WithSender(addr2)))
+	require.NoError(t, elect(pctx, dpos.Address))
+	err = dpos.Contract.RegisterCandidateWithCommission(dposCtx(addr2), &RegisterCandidateWithCommissionRequest{
+		Candidate: &RegisterCandidateRequest{PubKey: pubKey2, Fee: 100},
+		Limits:    &CommissionLimits{MaxFee: 5000, MaxFeeChange: 100},
+	})
+	require.Equal(t, errCommissionLimitsLoosened, err)
+	candidateFee = 2000
+	err = dpos.RegisterCandidate(pctx.WithSender(addr2), pubKey2, nil, &candidateFee, nil, nil, nil, nil)
+	require.Error(t, err)
+	err = dpos.Contract.RegisterCandidateWithCommission(dposCtx(addr2), &RegisterCandidateWithCommissionRequest{
+		Candidate: &RegisterCandidateRequest{PubKey: pubKey2, Fee: 100},
+		Limits:    &CommissionLimits{MaxFee: 800, MaxFeeChange: 100},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(800), feeSchedule(addr2).Limits.MaxFee)
+}
+
 func TestDelegate(t *testing.T) {
 	pctx := createCtx()
 	limboValidatorAddress := LimboValidatorAddress(contractpb.WrapPluginStaticContext(pctx))
@@ -4057,6 +4189,88 @@ func TestEnableValidatorJailing(t *testing.T) {
 	require.False(t, statistic.Jailed)
 }
 
+func TestDoubleSignSlashing(t *testing.T) {
+	pctx := createCtx()
+
+	// Deploy the coin contract (DPOS Init() will attempt to resolve it)
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(addr1, 1000000000000000000),
+			makeAccount(delegatorAddress1, 100000000),
+		},
+	})
+
+	registrationFee := &types.BigUInt{Value: *loom.NewBigUIntFromInt(100)}
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:          1,
+		RegistrationRequirement: registrationFee,
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+	dposCtx.SetFeature(features.DPOSVersion3_1, true)
+	dposCtx.SetFeature(features.DPOSVersion3_11, true)
+
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(addr1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  registrationFee,
+	})
+	require.Nil(t, err)
+
+	err = dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	delegationAmount := big.NewInt(100)
+
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+	})
+	require.Nil(t, err)
+
+	err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &addr1, delegationAmount, nil, nil)
+	require.Nil(t, err)
+
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	evidenceHash := []byte("evidence-1")
+	require.Error(t, SlashDoubleSignEvidence(contractpb.WrapPluginContext(dposCtx), nil, addr1))
+	require.Error(t, SlashDoubleSignEvidence(contractpb.WrapPluginContext(dposCtx), evidenceHash, addr2))
+	require.NoError(t, SlashDoubleSignEvidence(contractpb.WrapPluginContext(dposCtx), evidenceHash, addr1))
+
+	statistic, err := GetStatistic(contractpb.WrapPluginContext(dposCtx), addr1)
+	require.Nil(t, err)
+	require.True(t, statistic.Jailed)
+	require.True(t, statistic.SlashPercentage.Value.Cmp(&doubleSignSlashPercentage) == 0)
+
+	// the same evidence shouldn't be processed twice
+	require.NoError(t, SlashDoubleSignEvidence(contractpb.WrapPluginContext(dposCtx), evidenceHash, addr1))
+	statistic, err = GetStatistic(contractpb.WrapPluginContext(dposCtx), addr1)
+	require.Nil(t, err)
+	require.True(t, statistic.SlashPercentage.Value.Cmp(&doubleSignSlashPercentage) == 0)
+
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	// verify that slashingPercentage is reset to zero after election
+	statistic, err = GetStatistic(contractpb.WrapPluginContext(dposCtx), addr1)
+	require.Nil(t, err)
+	require.True(t, statistic.SlashPercentage.Value.Cmp(common.BigZero()) == 0)
+
+	// verify that 5% of self-delegation was removed via slashing
+	_, delegatedAmount, _, err := dpos.CheckDelegation(pctx, &addr1, &addr1)
+	require.Nil(t, err)
+	expectedSlashedDelegation := CalculateFraction(*loom.NewBigUIntFromInt(9500), registrationFee.Value)
+	assert.True(t, delegatedAmount.Cmp(expectedSlashedDelegation.Int) == 0)
+
+	// verify that 5% of third-party delegation was removed via slashing
+	_, delegatedAmount, _, err = dpos.CheckDelegation(pctx, &addr1, &delegatorAddress1)
+	require.Nil(t, err)
+	expectedSlashedDelegation = CalculateFraction(*loom.NewBigUIntFromInt(9500), loom.BigUInt{delegationAmount})
+	assert.True(t, delegatedAmount.Cmp(expectedSlashedDelegation.Int) == 0)
+}
+
 // UTILITIES
 
 func makeAccount(owner loom.Address, bal uint64) *coin.InitialAccount {
@@ -4066,6 +4280,218 @@ func makeAccount(owner loom.Address, bal uint64) *coin.InitialAccount {
 	}
 }
 
+// Rewards are credited to the BONDED rewards delegation of each delegator, so they count towards
+// the delegation totals, and earn further rewards, from the next election onwards.
+func TestRewardsCompound(t *testing.T) {
+	pctx := createCtx()
+	coinAddr := pctx.CreateContract(coin.Contract)
+
+	coinContract := &coin.Coin{}
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 100000000),
+			makeAccount(delegatorAddress2, 100000000),
+			makeAccount(addr1, 100000000),
+		},
+	})
+
+	cycleLengthSeconds := int64(100)
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:      10,
+		ElectionCycleLength: cycleLengthSeconds,
+		CoinContractAddress: coinAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+
+	// transfer coins to reward fund
+	amount := big.NewInt(10)
+	amount.Exp(amount, big.NewInt(19), nil)
+	coinContract.Transfer(contractpb.WrapPluginContext(coinCtx), &coin.TransferRequest{
+		To:     dpos.Address.MarshalPB(),
+		Amount: &types.BigUInt{Value: common.BigUInt{amount}},
+	})
+
+	registrationFee := &types.BigUInt{Value: *scientificNotation(defaultRegistrationRequirement, tokenDecimals)}
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(addr1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  registrationFee,
+	})
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	delegationAmount := loom.BigUInt{big.NewInt(1e18)}
+	for _, delegator := range []loom.Address{delegatorAddress1, delegatorAddress2} {
+		err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegator)), &coin.ApproveRequest{
+			Spender: dpos.Address.MarshalPB(),
+			Amount:  &types.BigUInt{Value: delegationAmount},
+		})
+		require.Nil(t, err)
+		err = dpos.Delegate(pctx.WithSender(delegator), &addr1, delegationAmount.Int, nil, nil)
+		require.Nil(t, err)
+	}
+
+	for i := 0; i < 10; i++ {
+		pctx.SetTime(pctx.Now().Add(time.Duration(cycleLengthSeconds) * time.Second))
+		require.NoError(t, elect(pctx, dpos.Address))
+	}
+
+	total := common.BigZero()
+	total.Add(total, &delegationAmount)
+	total.Add(total, &delegationAmount)
+	for _, delegator := range []loom.Address{delegatorAddress1, delegatorAddress2} {
+		delegation, err := dpos.CheckRewardDelegation(pctx.WithSender(delegator), &addr1)
+		require.Nil(t, err)
+		require.Equal(t, BONDED, delegation.State)
+		require.True(t, delegation.Amount.Value.Sign() > 0)
+		total.Add(total, &delegation.Amount.Value)
+	}
+
+	// the rewards delegations are included in the delegation total of the next election
+	pctx.SetTime(pctx.Now().Add(time.Duration(cycleLengthSeconds) * time.Second))
+	require.NoError(t, elect(pctx, dpos.Address))
+	validators, err := dpos.ListValidators(pctx)
+	require.Nil(t, err)
+	require.Len(t, validators, 1)
+	require.True(t, validators[0].DelegationTotal.Value.Cmp(total) >= 0)
+}
+
+func TestListPages(t *testing.T) {
+	pctx := createCtx()
+	coinAddr := pctx.CreateContract(coin.Contract)
+
+	coinContract := &coin.Coin{}
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 100000000),
+			makeAccount(addr1, 100000000),
+			makeAccount(addr2, 100000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:      10,
+		CoinContractAddress: coinAddr.MarshalPB(),
+		OracleAddress:       addr1.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := contractpb.WrapPluginStaticContext(pctx.WithAddress(dpos.Address))
+
+	registrationFee := &types.BigUInt{Value: *scientificNotation(defaultRegistrationRequirement, tokenDecimals)}
+	for i, candidate := range []loom.Address{addr1, addr2} {
+		err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(candidate)), &coin.ApproveRequest{
+			Spender: dpos.Address.MarshalPB(),
+			Amount:  registrationFee,
+		})
+		require.Nil(t, err)
+		err = dpos.RegisterCandidate(pctx.WithSender(candidate), [][]byte{pubKey1, pubKey2}[i], nil, nil, nil, nil, nil, nil)
+		require.Nil(t, err)
+	}
+
+	delegationAmount := big.NewInt(1e18)
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUIntFromInt(5e18)},
+	})
+	require.Nil(t, err)
+	// the delegations to addr1 are made before the delegation indices are enabled, so they're only
+	// indexed once the indices are built in the next election
+	for _, validator := range []loom.Address{addr1, addr1, addr1} {
+		err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &validator, delegationAmount, nil, nil)
+		require.Nil(t, err)
+	}
+	pctx.SetFeature(features.DPOSVersion3_17, true)
+	err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &addr2, delegationAmount, nil, nil)
+	require.Nil(t, err)
+
+	_, err = dpos.Contract.ListDelegations(
+		dposCtx, &ListDelegationsRequest{Candidate: addr1.MarshalPB(), Limit: 3},
+	)
+	require.Error(t, err, "delegations can't be paged before the indices are built")
+	// bond the delegations & build the delegation indices
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	candidatesPage, err := dpos.Contract.ListCandidates(dposCtx, &ListCandidatesRequest{Limit: 1})
+	require.Nil(t, err)
+	require.Len(t, candidatesPage.Candidates, 1)
+	require.NotNil(t, candidatesPage.NextKey)
+	firstCandidate := candidatesPage.Candidates[0].Candidate.Address
+	candidatesPage, err = dpos.Contract.ListCandidates(
+		dposCtx, &ListCandidatesRequest{StartKey: candidatesPage.NextKey, Limit: 1},
+	)
+	require.Nil(t, err)
+	require.Len(t, candidatesPage.Candidates, 1)
+	require.Nil(t, candidatesPage.NextKey)
+	require.NotEqual(t, firstCandidate.String(), candidatesPage.Candidates[0].Candidate.Address.String())
+
+	// each candidate has a self-delegation from registration, so addr1 has four delegations
+	delegationsPage, err := dpos.Contract.ListDelegations(
+		dposCtx, &ListDelegationsRequest{Candidate: addr1.MarshalPB(), Limit: 3},
+	)
+	require.Nil(t, err)
+	require.Len(t, delegationsPage.Delegations, 3)
+	require.NotNil(t, delegationsPage.NextKey)
+	candidateDelegations := delegationsPage.Delegations
+	delegationsPage, err = dpos.Contract.ListDelegations(
+		dposCtx, &ListDelegationsRequest{Candidate: addr1.MarshalPB(), StartKey: delegationsPage.NextKey, Limit: 3},
+	)
+	require.Nil(t, err)
+	require.Len(t, delegationsPage.Delegations, 1)
+	require.Nil(t, delegationsPage.NextKey)
+	candidateDelegations = append(candidateDelegations, delegationsPage.Delegations...)
+	var indices []uint64
+	for _, delegation := range candidateDelegations {
+		if loom.UnmarshalAddressPB(delegation.Delegator).Compare(delegatorAddress1) == 0 {
+			indices = append(indices, delegation.Index)
+		}
+	}
+	require.Equal(t, []uint64{1, 2, 3}, indices)
+
+	var delegations []*Delegation
+	allDelegationsReq := &ListAllDelegationsRequest{Limit: 4}
+	for {
+		allDelegationsPage, err := dpos.Contract.ListAllDelegations(dposCtx, allDelegationsReq)
+		require.Nil(t, err)
+		for _, response := range allDelegationsPage.ListResponses {
+			delegations = append(delegations, response.Delegations...)
+		}
+		if allDelegationsPage.NextKey == nil {
+			break
+		}
+		allDelegationsReq.StartKey = allDelegationsPage.NextKey
+	}
+	require.Len(t, delegations, 6)
+
+	checkDelegationsPage, err := dpos.Contract.CheckAllDelegations(
+		dposCtx, &CheckAllDelegationsRequest{DelegatorAddress: delegatorAddress1.MarshalPB(), Limit: 3},
+	)
+	require.Nil(t, err)
+	require.Len(t, checkDelegationsPage.Delegations, 3)
+	require.Equal(t, "3000000000000000000", checkDelegationsPage.Amount.Value.String())
+	require.NotNil(t, checkDelegationsPage.NextKey)
+	checkDelegationsPage, err = dpos.Contract.CheckAllDelegations(
+		dposCtx, &CheckAllDelegationsRequest{
+			DelegatorAddress: delegatorAddress1.MarshalPB(),
+			StartKey:         checkDelegationsPage.NextKey,
+			Limit:            3,
+		},
+	)
+	require.Nil(t, err)
+	require.Len(t, checkDelegationsPage.Delegations, 1)
+	require.Nil(t, checkDelegationsPage.NextKey)
+
+	require.Nil(t, dpos.RegisterReferrer(pctx.WithSender(addr1), delegatorAddress1, "ref1"))
+	require.Nil(t, dpos.RegisterReferrer(pctx.WithSender(addr1), addr2, "ref2"))
+	referrersPage, err := dpos.Contract.ListReferrers(dposCtx, &ListReferrersRequest{Limit: 1})
+	require.Nil(t, err)
+	require.Len(t, referrersPage.Referrers, 1)
+	require.Equal(t, "ref1", referrersPage.Referrers[0].Name)
+	require.Equal(t, []byte("ref2"), referrersPage.NextKey)
+}
+
 func elect(pctx *plugin.FakeContext, dposAddress loom.Address) error {
 	return Elect(contractpb.WrapPluginContext(pctx.WithAddress(dposAddress)))
 }
@@ -0,0 +1,2431 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/dposv3/dposv3.proto
+
+package dposv3
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	dposv3 "github.com/loomnetwork/go-loom/builtin/types/dposv3"
+	types "github.com/loomnetwork/go-loom/types"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ValidatorElectionRecord struct {
+	Validator            *types.Address `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	DelegationTotal      *types.BigUInt `protobuf:"bytes,2,opt,name=delegation_total,json=delegationTotal,proto3" json:"delegation_total,omitempty"`
+	NewDelegationTotal   *types.BigUInt `protobuf:"bytes,3,opt,name=new_delegation_total,json=newDelegationTotal,proto3" json:"new_delegation_total,omitempty"`
+	WhitelistAmount      *types.BigUInt `protobuf:"bytes,4,opt,name=whitelist_amount,json=whitelistAmount,proto3" json:"whitelist_amount,omitempty"`
+	TotalRewards         *types.BigUInt `protobuf:"bytes,5,opt,name=total_rewards,json=totalRewards,proto3" json:"total_rewards,omitempty"`
+	Fee                  *types.BigUInt `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	ReferrerFees         *types.BigUInt `protobuf:"bytes,7,opt,name=referrer_fees,json=referrerFees,proto3" json:"referrer_fees,omitempty"`
+	DelegatorRewards     *types.BigUInt `protobuf:"bytes,8,opt,name=delegator_rewards,json=delegatorRewards,proto3" json:"delegator_rewards,omitempty"`
+	SlashPercentage      *types.BigUInt `protobuf:"bytes,9,opt,name=slash_percentage,json=slashPercentage,proto3" json:"slash_percentage,omitempty"`
+	SlashedAmount        *types.BigUInt `protobuf:"bytes,10,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+	Jailed               bool           `protobuf:"varint,11,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidatorElectionRecord) Reset()         { *m = ValidatorElectionRecord{} }
+func (m *ValidatorElectionRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorElectionRecord) ProtoMessage()    {}
+func (*ValidatorElectionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{0}
+}
+func (m *ValidatorElectionRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorElectionRecord.Unmarshal(m, b)
+}
+func (m *ValidatorElectionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorElectionRecord.Marshal(b, m, deterministic)
+}
+func (m *ValidatorElectionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorElectionRecord.Merge(m, src)
+}
+func (m *ValidatorElectionRecord) XXX_Size() int {
+	return xxx_messageInfo_ValidatorElectionRecord.Size(m)
+}
+func (m *ValidatorElectionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorElectionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorElectionRecord proto.InternalMessageInfo
+
+func (m *ValidatorElectionRecord) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetDelegationTotal() *types.BigUInt {
+	if m != nil {
+		return m.DelegationTotal
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetNewDelegationTotal() *types.BigUInt {
+	if m != nil {
+		return m.NewDelegationTotal
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetWhitelistAmount() *types.BigUInt {
+	if m != nil {
+		return m.WhitelistAmount
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetTotalRewards() *types.BigUInt {
+	if m != nil {
+		return m.TotalRewards
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetFee() *types.BigUInt {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetReferrerFees() *types.BigUInt {
+	if m != nil {
+		return m.ReferrerFees
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetDelegatorRewards() *types.BigUInt {
+	if m != nil {
+		return m.DelegatorRewards
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetSlashPercentage() *types.BigUInt {
+	if m != nil {
+		return m.SlashPercentage
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetSlashedAmount() *types.BigUInt {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return nil
+}
+
+func (m *ValidatorElectionRecord) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+type ElectionRecord struct {
+	Id                   uint64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ElectionTime         int64                      `protobuf:"varint,2,opt,name=election_time,json=electionTime,proto3" json:"election_time,omitempty"`
+	BlockHeight          int64                      `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Validators           []*ValidatorElectionRecord `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators,omitempty"`
+	TotalDistributed     *types.BigUInt             `protobuf:"bytes,5,opt,name=total_distributed,json=totalDistributed,proto3" json:"total_distributed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ElectionRecord) Reset()         { *m = ElectionRecord{} }
+func (m *ElectionRecord) String() string { return proto.CompactTextString(m) }
+func (*ElectionRecord) ProtoMessage()    {}
+func (*ElectionRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{1}
+}
+func (m *ElectionRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ElectionRecord.Unmarshal(m, b)
+}
+func (m *ElectionRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ElectionRecord.Marshal(b, m, deterministic)
+}
+func (m *ElectionRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ElectionRecord.Merge(m, src)
+}
+func (m *ElectionRecord) XXX_Size() int {
+	return xxx_messageInfo_ElectionRecord.Size(m)
+}
+func (m *ElectionRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ElectionRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ElectionRecord proto.InternalMessageInfo
+
+func (m *ElectionRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ElectionRecord) GetElectionTime() int64 {
+	if m != nil {
+		return m.ElectionTime
+	}
+	return 0
+}
+
+func (m *ElectionRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ElectionRecord) GetValidators() []*ValidatorElectionRecord {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *ElectionRecord) GetTotalDistributed() *types.BigUInt {
+	if m != nil {
+		return m.TotalDistributed
+	}
+	return nil
+}
+
+type DelegationReward struct {
+	Validator            *types.Address `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Index                uint64         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	DelegationAmount     *types.BigUInt `protobuf:"bytes,3,opt,name=delegation_amount,json=delegationAmount,proto3" json:"delegation_amount,omitempty"`
+	Reward               *types.BigUInt `protobuf:"bytes,4,opt,name=reward,proto3" json:"reward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DelegationReward) Reset()         { *m = DelegationReward{} }
+func (m *DelegationReward) String() string { return proto.CompactTextString(m) }
+func (*DelegationReward) ProtoMessage()    {}
+func (*DelegationReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{2}
+}
+func (m *DelegationReward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegationReward.Unmarshal(m, b)
+}
+func (m *DelegationReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegationReward.Marshal(b, m, deterministic)
+}
+func (m *DelegationReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationReward.Merge(m, src)
+}
+func (m *DelegationReward) XXX_Size() int {
+	return xxx_messageInfo_DelegationReward.Size(m)
+}
+func (m *DelegationReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationReward proto.InternalMessageInfo
+
+func (m *DelegationReward) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *DelegationReward) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DelegationReward) GetDelegationAmount() *types.BigUInt {
+	if m != nil {
+		return m.DelegationAmount
+	}
+	return nil
+}
+
+func (m *DelegationReward) GetReward() *types.BigUInt {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
+}
+
+type DelegatorRewardRecord struct {
+	Id                   uint64              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ElectionId           uint64              `protobuf:"varint,2,opt,name=election_id,json=electionId,proto3" json:"election_id,omitempty"`
+	ElectionTime         int64               `protobuf:"varint,3,opt,name=election_time,json=electionTime,proto3" json:"election_time,omitempty"`
+	Rewards              []*DelegationReward `protobuf:"bytes,4,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Total                *types.BigUInt      `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DelegatorRewardRecord) Reset()         { *m = DelegatorRewardRecord{} }
+func (m *DelegatorRewardRecord) String() string { return proto.CompactTextString(m) }
+func (*DelegatorRewardRecord) ProtoMessage()    {}
+func (*DelegatorRewardRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{3}
+}
+func (m *DelegatorRewardRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegatorRewardRecord.Unmarshal(m, b)
+}
+func (m *DelegatorRewardRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegatorRewardRecord.Marshal(b, m, deterministic)
+}
+func (m *DelegatorRewardRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorRewardRecord.Merge(m, src)
+}
+func (m *DelegatorRewardRecord) XXX_Size() int {
+	return xxx_messageInfo_DelegatorRewardRecord.Size(m)
+}
+func (m *DelegatorRewardRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorRewardRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorRewardRecord proto.InternalMessageInfo
+
+func (m *DelegatorRewardRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *DelegatorRewardRecord) GetElectionId() uint64 {
+	if m != nil {
+		return m.ElectionId
+	}
+	return 0
+}
+
+func (m *DelegatorRewardRecord) GetElectionTime() int64 {
+	if m != nil {
+		return m.ElectionTime
+	}
+	return 0
+}
+
+func (m *DelegatorRewardRecord) GetRewards() []*DelegationReward {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *DelegatorRewardRecord) GetTotal() *types.BigUInt {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+type HistoryCounter struct {
+	LastId               uint64   `protobuf:"varint,1,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoryCounter) Reset()         { *m = HistoryCounter{} }
+func (m *HistoryCounter) String() string { return proto.CompactTextString(m) }
+func (*HistoryCounter) ProtoMessage()    {}
+func (*HistoryCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{4}
+}
+func (m *HistoryCounter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoryCounter.Unmarshal(m, b)
+}
+func (m *HistoryCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoryCounter.Marshal(b, m, deterministic)
+}
+func (m *HistoryCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryCounter.Merge(m, src)
+}
+func (m *HistoryCounter) XXX_Size() int {
+	return xxx_messageInfo_HistoryCounter.Size(m)
+}
+func (m *HistoryCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryCounter proto.InternalMessageInfo
+
+func (m *HistoryCounter) GetLastId() uint64 {
+	if m != nil {
+		return m.LastId
+	}
+	return 0
+}
+
+type GetElectionHistoryRequest struct {
+	StartId              uint64   `protobuf:"varint,1,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetElectionHistoryRequest) Reset()         { *m = GetElectionHistoryRequest{} }
+func (m *GetElectionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetElectionHistoryRequest) ProtoMessage()    {}
+func (*GetElectionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{5}
+}
+func (m *GetElectionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetElectionHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetElectionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetElectionHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetElectionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetElectionHistoryRequest.Merge(m, src)
+}
+func (m *GetElectionHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetElectionHistoryRequest.Size(m)
+}
+func (m *GetElectionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetElectionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetElectionHistoryRequest proto.InternalMessageInfo
+
+func (m *GetElectionHistoryRequest) GetStartId() uint64 {
+	if m != nil {
+		return m.StartId
+	}
+	return 0
+}
+
+func (m *GetElectionHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetElectionHistoryResponse struct {
+	Records              []*ElectionRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextId               uint64            `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetElectionHistoryResponse) Reset()         { *m = GetElectionHistoryResponse{} }
+func (m *GetElectionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetElectionHistoryResponse) ProtoMessage()    {}
+func (*GetElectionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{6}
+}
+func (m *GetElectionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetElectionHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetElectionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetElectionHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetElectionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetElectionHistoryResponse.Merge(m, src)
+}
+func (m *GetElectionHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetElectionHistoryResponse.Size(m)
+}
+func (m *GetElectionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetElectionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetElectionHistoryResponse proto.InternalMessageInfo
+
+func (m *GetElectionHistoryResponse) GetRecords() []*ElectionRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *GetElectionHistoryResponse) GetNextId() uint64 {
+	if m != nil {
+		return m.NextId
+	}
+	return 0
+}
+
+type GetDelegatorRewardHistoryRequest struct {
+	Delegator            *types.Address `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	StartId              uint64         `protobuf:"varint,2,opt,name=start_id,json=startId,proto3" json:"start_id,omitempty"`
+	Limit                uint32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetDelegatorRewardHistoryRequest) Reset()         { *m = GetDelegatorRewardHistoryRequest{} }
+func (m *GetDelegatorRewardHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDelegatorRewardHistoryRequest) ProtoMessage()    {}
+func (*GetDelegatorRewardHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{7}
+}
+func (m *GetDelegatorRewardHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDelegatorRewardHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetDelegatorRewardHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDelegatorRewardHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDelegatorRewardHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDelegatorRewardHistoryRequest.Merge(m, src)
+}
+func (m *GetDelegatorRewardHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDelegatorRewardHistoryRequest.Size(m)
+}
+func (m *GetDelegatorRewardHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDelegatorRewardHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDelegatorRewardHistoryRequest proto.InternalMessageInfo
+
+func (m *GetDelegatorRewardHistoryRequest) GetDelegator() *types.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *GetDelegatorRewardHistoryRequest) GetStartId() uint64 {
+	if m != nil {
+		return m.StartId
+	}
+	return 0
+}
+
+func (m *GetDelegatorRewardHistoryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetDelegatorRewardHistoryResponse struct {
+	Records              []*DelegatorRewardRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	NextId               uint64                   `protobuf:"varint,2,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetDelegatorRewardHistoryResponse) Reset()         { *m = GetDelegatorRewardHistoryResponse{} }
+func (m *GetDelegatorRewardHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDelegatorRewardHistoryResponse) ProtoMessage()    {}
+func (*GetDelegatorRewardHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{8}
+}
+func (m *GetDelegatorRewardHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDelegatorRewardHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetDelegatorRewardHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDelegatorRewardHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDelegatorRewardHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDelegatorRewardHistoryResponse.Merge(m, src)
+}
+func (m *GetDelegatorRewardHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDelegatorRewardHistoryResponse.Size(m)
+}
+func (m *GetDelegatorRewardHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDelegatorRewardHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDelegatorRewardHistoryResponse proto.InternalMessageInfo
+
+func (m *GetDelegatorRewardHistoryResponse) GetRecords() []*DelegatorRewardRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *GetDelegatorRewardHistoryResponse) GetNextId() uint64 {
+	if m != nil {
+		return m.NextId
+	}
+	return 0
+}
+
+type ListCandidatesRequest struct {
+	StartKey             []byte   `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCandidatesRequest) Reset()         { *m = ListCandidatesRequest{} }
+func (m *ListCandidatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCandidatesRequest) ProtoMessage()    {}
+func (*ListCandidatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{9}
+}
+func (m *ListCandidatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCandidatesRequest.Unmarshal(m, b)
+}
+func (m *ListCandidatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCandidatesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCandidatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCandidatesRequest.Merge(m, src)
+}
+func (m *ListCandidatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCandidatesRequest.Size(m)
+}
+func (m *ListCandidatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCandidatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCandidatesRequest proto.InternalMessageInfo
+
+func (m *ListCandidatesRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ListCandidatesRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListCandidatesResponse struct {
+	Candidates           []*dposv3.CandidateStatistic `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	FeeSchedules         []*CandidateFeeSchedule      `protobuf:"bytes,2,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules,omitempty"`
+	NextKey              []byte                       `protobuf:"bytes,3,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ListCandidatesResponse) Reset()         { *m = ListCandidatesResponse{} }
+func (m *ListCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCandidatesResponse) ProtoMessage()    {}
+func (*ListCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{10}
+}
+func (m *ListCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCandidatesResponse.Unmarshal(m, b)
+}
+func (m *ListCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCandidatesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCandidatesResponse.Merge(m, src)
+}
+func (m *ListCandidatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCandidatesResponse.Size(m)
+}
+func (m *ListCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCandidatesResponse proto.InternalMessageInfo
+
+func (m *ListCandidatesResponse) GetCandidates() []*dposv3.CandidateStatistic {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *ListCandidatesResponse) GetFeeSchedules() []*CandidateFeeSchedule {
+	if m != nil {
+		return m.FeeSchedules
+	}
+	return nil
+}
+
+func (m *ListCandidatesResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+type ListDelegationsRequest struct {
+	Candidate            *types.Address `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	StartKey             []byte         `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	Limit                uint32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListDelegationsRequest) Reset()         { *m = ListDelegationsRequest{} }
+func (m *ListDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDelegationsRequest) ProtoMessage()    {}
+func (*ListDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{11}
+}
+func (m *ListDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDelegationsRequest.Unmarshal(m, b)
+}
+func (m *ListDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDelegationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDelegationsRequest.Merge(m, src)
+}
+func (m *ListDelegationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDelegationsRequest.Size(m)
+}
+func (m *ListDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDelegationsRequest proto.InternalMessageInfo
+
+func (m *ListDelegationsRequest) GetCandidate() *types.Address {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *ListDelegationsRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ListDelegationsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListDelegationsResponse struct {
+	DelegationTotal      *types.BigUInt       `protobuf:"bytes,1,opt,name=delegation_total,json=delegationTotal,proto3" json:"delegation_total,omitempty"`
+	Delegations          []*dposv3.Delegation `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	NextKey              []byte               `protobuf:"bytes,3,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListDelegationsResponse) Reset()         { *m = ListDelegationsResponse{} }
+func (m *ListDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDelegationsResponse) ProtoMessage()    {}
+func (*ListDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{12}
+}
+func (m *ListDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDelegationsResponse.Unmarshal(m, b)
+}
+func (m *ListDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDelegationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDelegationsResponse.Merge(m, src)
+}
+func (m *ListDelegationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDelegationsResponse.Size(m)
+}
+func (m *ListDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDelegationsResponse proto.InternalMessageInfo
+
+func (m *ListDelegationsResponse) GetDelegationTotal() *types.BigUInt {
+	if m != nil {
+		return m.DelegationTotal
+	}
+	return nil
+}
+
+func (m *ListDelegationsResponse) GetDelegations() []*dposv3.Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *ListDelegationsResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+type ListAllDelegationsRequest struct {
+	StartKey             []byte   `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAllDelegationsRequest) Reset()         { *m = ListAllDelegationsRequest{} }
+func (m *ListAllDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAllDelegationsRequest) ProtoMessage()    {}
+func (*ListAllDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{13}
+}
+func (m *ListAllDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAllDelegationsRequest.Unmarshal(m, b)
+}
+func (m *ListAllDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAllDelegationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAllDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAllDelegationsRequest.Merge(m, src)
+}
+func (m *ListAllDelegationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAllDelegationsRequest.Size(m)
+}
+func (m *ListAllDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAllDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAllDelegationsRequest proto.InternalMessageInfo
+
+func (m *ListAllDelegationsRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ListAllDelegationsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListAllDelegationsResponse struct {
+	ListResponses        []*ListDelegationsResponse `protobuf:"bytes,1,rep,name=list_responses,json=listResponses,proto3" json:"list_responses,omitempty"`
+	NextKey              []byte                     `protobuf:"bytes,2,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ListAllDelegationsResponse) Reset()         { *m = ListAllDelegationsResponse{} }
+func (m *ListAllDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAllDelegationsResponse) ProtoMessage()    {}
+func (*ListAllDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{14}
+}
+func (m *ListAllDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAllDelegationsResponse.Unmarshal(m, b)
+}
+func (m *ListAllDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAllDelegationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAllDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAllDelegationsResponse.Merge(m, src)
+}
+func (m *ListAllDelegationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAllDelegationsResponse.Size(m)
+}
+func (m *ListAllDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAllDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAllDelegationsResponse proto.InternalMessageInfo
+
+func (m *ListAllDelegationsResponse) GetListResponses() []*ListDelegationsResponse {
+	if m != nil {
+		return m.ListResponses
+	}
+	return nil
+}
+
+func (m *ListAllDelegationsResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+type ListReferrersRequest struct {
+	StartKey             []byte   `protobuf:"bytes,1,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	Limit                uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReferrersRequest) Reset()         { *m = ListReferrersRequest{} }
+func (m *ListReferrersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReferrersRequest) ProtoMessage()    {}
+func (*ListReferrersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{15}
+}
+func (m *ListReferrersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReferrersRequest.Unmarshal(m, b)
+}
+func (m *ListReferrersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReferrersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListReferrersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReferrersRequest.Merge(m, src)
+}
+func (m *ListReferrersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReferrersRequest.Size(m)
+}
+func (m *ListReferrersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReferrersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReferrersRequest proto.InternalMessageInfo
+
+func (m *ListReferrersRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *ListReferrersRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListReferrersResponse struct {
+	Referrers            []*dposv3.Referrer `protobuf:"bytes,1,rep,name=referrers,proto3" json:"referrers,omitempty"`
+	NextKey              []byte             `protobuf:"bytes,2,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListReferrersResponse) Reset()         { *m = ListReferrersResponse{} }
+func (m *ListReferrersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListReferrersResponse) ProtoMessage()    {}
+func (*ListReferrersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{16}
+}
+func (m *ListReferrersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReferrersResponse.Unmarshal(m, b)
+}
+func (m *ListReferrersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReferrersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListReferrersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReferrersResponse.Merge(m, src)
+}
+func (m *ListReferrersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListReferrersResponse.Size(m)
+}
+func (m *ListReferrersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReferrersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReferrersResponse proto.InternalMessageInfo
+
+func (m *ListReferrersResponse) GetReferrers() []*dposv3.Referrer {
+	if m != nil {
+		return m.Referrers
+	}
+	return nil
+}
+
+func (m *ListReferrersResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+type CheckAllDelegationsRequest struct {
+	DelegatorAddress     *types.Address `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	StartKey             []byte         `protobuf:"bytes,2,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+	Limit                uint32         `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CheckAllDelegationsRequest) Reset()         { *m = CheckAllDelegationsRequest{} }
+func (m *CheckAllDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckAllDelegationsRequest) ProtoMessage()    {}
+func (*CheckAllDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{17}
+}
+func (m *CheckAllDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckAllDelegationsRequest.Unmarshal(m, b)
+}
+func (m *CheckAllDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckAllDelegationsRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckAllDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAllDelegationsRequest.Merge(m, src)
+}
+func (m *CheckAllDelegationsRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckAllDelegationsRequest.Size(m)
+}
+func (m *CheckAllDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAllDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckAllDelegationsRequest proto.InternalMessageInfo
+
+func (m *CheckAllDelegationsRequest) GetDelegatorAddress() *types.Address {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return nil
+}
+
+func (m *CheckAllDelegationsRequest) GetStartKey() []byte {
+	if m != nil {
+		return m.StartKey
+	}
+	return nil
+}
+
+func (m *CheckAllDelegationsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CheckAllDelegationsResponse struct {
+	Amount               *types.BigUInt       `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	WeightedAmount       *types.BigUInt       `protobuf:"bytes,2,opt,name=weighted_amount,json=weightedAmount,proto3" json:"weighted_amount,omitempty"`
+	Delegations          []*dposv3.Delegation `protobuf:"bytes,3,rep,name=delegations,proto3" json:"delegations,omitempty"`
+	NextKey              []byte               `protobuf:"bytes,4,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CheckAllDelegationsResponse) Reset()         { *m = CheckAllDelegationsResponse{} }
+func (m *CheckAllDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckAllDelegationsResponse) ProtoMessage()    {}
+func (*CheckAllDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{18}
+}
+func (m *CheckAllDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckAllDelegationsResponse.Unmarshal(m, b)
+}
+func (m *CheckAllDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckAllDelegationsResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckAllDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckAllDelegationsResponse.Merge(m, src)
+}
+func (m *CheckAllDelegationsResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckAllDelegationsResponse.Size(m)
+}
+func (m *CheckAllDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckAllDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckAllDelegationsResponse proto.InternalMessageInfo
+
+func (m *CheckAllDelegationsResponse) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *CheckAllDelegationsResponse) GetWeightedAmount() *types.BigUInt {
+	if m != nil {
+		return m.WeightedAmount
+	}
+	return nil
+}
+
+func (m *CheckAllDelegationsResponse) GetDelegations() []*dposv3.Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *CheckAllDelegationsResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+type DelegationIndicesStatus struct {
+	Built                bool     `protobuf:"varint,1,opt,name=built,proto3" json:"built,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegationIndicesStatus) Reset()         { *m = DelegationIndicesStatus{} }
+func (m *DelegationIndicesStatus) String() string { return proto.CompactTextString(m) }
+func (*DelegationIndicesStatus) ProtoMessage()    {}
+func (*DelegationIndicesStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{19}
+}
+func (m *DelegationIndicesStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegationIndicesStatus.Unmarshal(m, b)
+}
+func (m *DelegationIndicesStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegationIndicesStatus.Marshal(b, m, deterministic)
+}
+func (m *DelegationIndicesStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationIndicesStatus.Merge(m, src)
+}
+func (m *DelegationIndicesStatus) XXX_Size() int {
+	return xxx_messageInfo_DelegationIndicesStatus.Size(m)
+}
+func (m *DelegationIndicesStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationIndicesStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationIndicesStatus proto.InternalMessageInfo
+
+func (m *DelegationIndicesStatus) GetBuilt() bool {
+	if m != nil {
+		return m.Built
+	}
+	return false
+}
+
+type CommissionLimits struct {
+	MaxFee               uint64   `protobuf:"varint,1,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+	MaxFeeChange         uint64   `protobuf:"varint,2,opt,name=max_fee_change,json=maxFeeChange,proto3" json:"max_fee_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CommissionLimits) Reset()         { *m = CommissionLimits{} }
+func (m *CommissionLimits) String() string { return proto.CompactTextString(m) }
+func (*CommissionLimits) ProtoMessage()    {}
+func (*CommissionLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{20}
+}
+func (m *CommissionLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommissionLimits.Unmarshal(m, b)
+}
+func (m *CommissionLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommissionLimits.Marshal(b, m, deterministic)
+}
+func (m *CommissionLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionLimits.Merge(m, src)
+}
+func (m *CommissionLimits) XXX_Size() int {
+	return xxx_messageInfo_CommissionLimits.Size(m)
+}
+func (m *CommissionLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionLimits proto.InternalMessageInfo
+
+func (m *CommissionLimits) GetMaxFee() uint64 {
+	if m != nil {
+		return m.MaxFee
+	}
+	return 0
+}
+
+func (m *CommissionLimits) GetMaxFeeChange() uint64 {
+	if m != nil {
+		return m.MaxFeeChange
+	}
+	return 0
+}
+
+type CandidateFeeSchedule struct {
+	Candidate            *types.Address    `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Fee                  uint64            `protobuf:"varint,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	NewFee               uint64            `protobuf:"varint,3,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+	Limits               *CommissionLimits `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CandidateFeeSchedule) Reset()         { *m = CandidateFeeSchedule{} }
+func (m *CandidateFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*CandidateFeeSchedule) ProtoMessage()    {}
+func (*CandidateFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{21}
+}
+func (m *CandidateFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateFeeSchedule.Unmarshal(m, b)
+}
+func (m *CandidateFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateFeeSchedule.Marshal(b, m, deterministic)
+}
+func (m *CandidateFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateFeeSchedule.Merge(m, src)
+}
+func (m *CandidateFeeSchedule) XXX_Size() int {
+	return xxx_messageInfo_CandidateFeeSchedule.Size(m)
+}
+func (m *CandidateFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateFeeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateFeeSchedule proto.InternalMessageInfo
+
+func (m *CandidateFeeSchedule) GetCandidate() *types.Address {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *CandidateFeeSchedule) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *CandidateFeeSchedule) GetNewFee() uint64 {
+	if m != nil {
+		return m.NewFee
+	}
+	return 0
+}
+
+func (m *CandidateFeeSchedule) GetLimits() *CommissionLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type RegisterCandidateWithCommissionRequest struct {
+	Candidate            *dposv3.RegisterCandidateRequest `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Limits               *CommissionLimits                `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *RegisterCandidateWithCommissionRequest) Reset() {
+	*m = RegisterCandidateWithCommissionRequest{}
+}
+func (m *RegisterCandidateWithCommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterCandidateWithCommissionRequest) ProtoMessage()    {}
+func (*RegisterCandidateWithCommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{22}
+}
+func (m *RegisterCandidateWithCommissionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterCandidateWithCommissionRequest.Unmarshal(m, b)
+}
+func (m *RegisterCandidateWithCommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterCandidateWithCommissionRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterCandidateWithCommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCandidateWithCommissionRequest.Merge(m, src)
+}
+func (m *RegisterCandidateWithCommissionRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterCandidateWithCommissionRequest.Size(m)
+}
+func (m *RegisterCandidateWithCommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCandidateWithCommissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterCandidateWithCommissionRequest proto.InternalMessageInfo
+
+func (m *RegisterCandidateWithCommissionRequest) GetCandidate() *dposv3.RegisterCandidateRequest {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *RegisterCandidateWithCommissionRequest) GetLimits() *CommissionLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type SetCommissionLimitsRequest struct {
+	Limits               *CommissionLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetCommissionLimitsRequest) Reset()         { *m = SetCommissionLimitsRequest{} }
+func (m *SetCommissionLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetCommissionLimitsRequest) ProtoMessage()    {}
+func (*SetCommissionLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{23}
+}
+func (m *SetCommissionLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetCommissionLimitsRequest.Unmarshal(m, b)
+}
+func (m *SetCommissionLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetCommissionLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetCommissionLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCommissionLimitsRequest.Merge(m, src)
+}
+func (m *SetCommissionLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetCommissionLimitsRequest.Size(m)
+}
+func (m *SetCommissionLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCommissionLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCommissionLimitsRequest proto.InternalMessageInfo
+
+func (m *SetCommissionLimitsRequest) GetLimits() *CommissionLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+type GetCandidateFeeScheduleRequest struct {
+	Candidate            *types.Address `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetCandidateFeeScheduleRequest) Reset()         { *m = GetCandidateFeeScheduleRequest{} }
+func (m *GetCandidateFeeScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCandidateFeeScheduleRequest) ProtoMessage()    {}
+func (*GetCandidateFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{24}
+}
+func (m *GetCandidateFeeScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandidateFeeScheduleRequest.Unmarshal(m, b)
+}
+func (m *GetCandidateFeeScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandidateFeeScheduleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCandidateFeeScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandidateFeeScheduleRequest.Merge(m, src)
+}
+func (m *GetCandidateFeeScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCandidateFeeScheduleRequest.Size(m)
+}
+func (m *GetCandidateFeeScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandidateFeeScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCandidateFeeScheduleRequest proto.InternalMessageInfo
+
+func (m *GetCandidateFeeScheduleRequest) GetCandidate() *types.Address {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+type GetCandidateFeeScheduleResponse struct {
+	FeeSchedule          *CandidateFeeSchedule `protobuf:"bytes,1,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetCandidateFeeScheduleResponse) Reset()         { *m = GetCandidateFeeScheduleResponse{} }
+func (m *GetCandidateFeeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCandidateFeeScheduleResponse) ProtoMessage()    {}
+func (*GetCandidateFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{25}
+}
+func (m *GetCandidateFeeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCandidateFeeScheduleResponse.Unmarshal(m, b)
+}
+func (m *GetCandidateFeeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCandidateFeeScheduleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCandidateFeeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCandidateFeeScheduleResponse.Merge(m, src)
+}
+func (m *GetCandidateFeeScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCandidateFeeScheduleResponse.Size(m)
+}
+func (m *GetCandidateFeeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCandidateFeeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCandidateFeeScheduleResponse proto.InternalMessageInfo
+
+func (m *GetCandidateFeeScheduleResponse) GetFeeSchedule() *CandidateFeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return nil
+}
+
+type CandidateFeeChangeEvent struct {
+	Address              *types.Address        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NewFee               uint64                `protobuf:"varint,2,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+	FeeSchedule          *CandidateFeeSchedule `protobuf:"bytes,3,opt,name=fee_schedule,json=feeSchedule,proto3" json:"fee_schedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CandidateFeeChangeEvent) Reset()         { *m = CandidateFeeChangeEvent{} }
+func (m *CandidateFeeChangeEvent) String() string { return proto.CompactTextString(m) }
+func (*CandidateFeeChangeEvent) ProtoMessage()    {}
+func (*CandidateFeeChangeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{26}
+}
+func (m *CandidateFeeChangeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandidateFeeChangeEvent.Unmarshal(m, b)
+}
+func (m *CandidateFeeChangeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandidateFeeChangeEvent.Marshal(b, m, deterministic)
+}
+func (m *CandidateFeeChangeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandidateFeeChangeEvent.Merge(m, src)
+}
+func (m *CandidateFeeChangeEvent) XXX_Size() int {
+	return xxx_messageInfo_CandidateFeeChangeEvent.Size(m)
+}
+func (m *CandidateFeeChangeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandidateFeeChangeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandidateFeeChangeEvent proto.InternalMessageInfo
+
+func (m *CandidateFeeChangeEvent) GetAddress() *types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *CandidateFeeChangeEvent) GetNewFee() uint64 {
+	if m != nil {
+		return m.NewFee
+	}
+	return 0
+}
+
+func (m *CandidateFeeChangeEvent) GetFeeSchedule() *CandidateFeeSchedule {
+	if m != nil {
+		return m.FeeSchedule
+	}
+	return nil
+}
+
+type UnbondingEntry struct {
+	Delegator            *types.Address `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Validator            *types.Address `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Index                uint64         `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CompletionElection   uint64         `protobuf:"varint,5,opt,name=completion_election,json=completionElection,proto3" json:"completion_election,omitempty"`
+	RequestedTime        int64          `protobuf:"varint,6,opt,name=requested_time,json=requestedTime,proto3" json:"requested_time,omitempty"`
+	MaturityTime         int64          `protobuf:"varint,7,opt,name=maturity_time,json=maturityTime,proto3" json:"maturity_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{27}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbondingEntry.Unmarshal(m, b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return xxx_messageInfo_UnbondingEntry.Size(m)
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+func (m *UnbondingEntry) GetDelegator() *types.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *UnbondingEntry) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *UnbondingEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *UnbondingEntry) GetCompletionElection() uint64 {
+	if m != nil {
+		return m.CompletionElection
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetRequestedTime() int64 {
+	if m != nil {
+		return m.RequestedTime
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetMaturityTime() int64 {
+	if m != nil {
+		return m.MaturityTime
+	}
+	return 0
+}
+
+type ScheduleUnbondRequest struct {
+	ValidatorAddress     *types.Address `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Index                uint64         `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Elections            uint64         `protobuf:"varint,4,opt,name=elections,proto3" json:"elections,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ScheduleUnbondRequest) Reset()         { *m = ScheduleUnbondRequest{} }
+func (m *ScheduleUnbondRequest) String() string { return proto.CompactTextString(m) }
+func (*ScheduleUnbondRequest) ProtoMessage()    {}
+func (*ScheduleUnbondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{28}
+}
+func (m *ScheduleUnbondRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScheduleUnbondRequest.Unmarshal(m, b)
+}
+func (m *ScheduleUnbondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScheduleUnbondRequest.Marshal(b, m, deterministic)
+}
+func (m *ScheduleUnbondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleUnbondRequest.Merge(m, src)
+}
+func (m *ScheduleUnbondRequest) XXX_Size() int {
+	return xxx_messageInfo_ScheduleUnbondRequest.Size(m)
+}
+func (m *ScheduleUnbondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleUnbondRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleUnbondRequest proto.InternalMessageInfo
+
+func (m *ScheduleUnbondRequest) GetValidatorAddress() *types.Address {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *ScheduleUnbondRequest) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ScheduleUnbondRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ScheduleUnbondRequest) GetElections() uint64 {
+	if m != nil {
+		return m.Elections
+	}
+	return 0
+}
+
+type CancelUnbondRequest struct {
+	ValidatorAddress     *types.Address `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Index                uint64         `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CancelUnbondRequest) Reset()         { *m = CancelUnbondRequest{} }
+func (m *CancelUnbondRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelUnbondRequest) ProtoMessage()    {}
+func (*CancelUnbondRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{29}
+}
+func (m *CancelUnbondRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelUnbondRequest.Unmarshal(m, b)
+}
+func (m *CancelUnbondRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelUnbondRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelUnbondRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelUnbondRequest.Merge(m, src)
+}
+func (m *CancelUnbondRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelUnbondRequest.Size(m)
+}
+func (m *CancelUnbondRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelUnbondRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelUnbondRequest proto.InternalMessageInfo
+
+func (m *CancelUnbondRequest) GetValidatorAddress() *types.Address {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *CancelUnbondRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ListUnbondingsRequest struct {
+	Delegator            *types.Address `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListUnbondingsRequest) Reset()         { *m = ListUnbondingsRequest{} }
+func (m *ListUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUnbondingsRequest) ProtoMessage()    {}
+func (*ListUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{30}
+}
+func (m *ListUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUnbondingsRequest.Unmarshal(m, b)
+}
+func (m *ListUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUnbondingsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUnbondingsRequest.Merge(m, src)
+}
+func (m *ListUnbondingsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUnbondingsRequest.Size(m)
+}
+func (m *ListUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUnbondingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUnbondingsRequest proto.InternalMessageInfo
+
+func (m *ListUnbondingsRequest) GetDelegator() *types.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+type ListUnbondingsResponse struct {
+	Unbondings           []*UnbondingEntry `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings,omitempty"`
+	LastElection         uint64            `protobuf:"varint,2,opt,name=last_election,json=lastElection,proto3" json:"last_election,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListUnbondingsResponse) Reset()         { *m = ListUnbondingsResponse{} }
+func (m *ListUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUnbondingsResponse) ProtoMessage()    {}
+func (*ListUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{31}
+}
+func (m *ListUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUnbondingsResponse.Unmarshal(m, b)
+}
+func (m *ListUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUnbondingsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUnbondingsResponse.Merge(m, src)
+}
+func (m *ListUnbondingsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUnbondingsResponse.Size(m)
+}
+func (m *ListUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUnbondingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUnbondingsResponse proto.InternalMessageInfo
+
+func (m *ListUnbondingsResponse) GetUnbondings() []*UnbondingEntry {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+func (m *ListUnbondingsResponse) GetLastElection() uint64 {
+	if m != nil {
+		return m.LastElection
+	}
+	return 0
+}
+
+type GetUnbondingQueueRequest struct {
+	Election             uint64   `protobuf:"varint,1,opt,name=election,proto3" json:"election,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUnbondingQueueRequest) Reset()         { *m = GetUnbondingQueueRequest{} }
+func (m *GetUnbondingQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUnbondingQueueRequest) ProtoMessage()    {}
+func (*GetUnbondingQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{32}
+}
+func (m *GetUnbondingQueueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUnbondingQueueRequest.Unmarshal(m, b)
+}
+func (m *GetUnbondingQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUnbondingQueueRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUnbondingQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUnbondingQueueRequest.Merge(m, src)
+}
+func (m *GetUnbondingQueueRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUnbondingQueueRequest.Size(m)
+}
+func (m *GetUnbondingQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUnbondingQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUnbondingQueueRequest proto.InternalMessageInfo
+
+func (m *GetUnbondingQueueRequest) GetElection() uint64 {
+	if m != nil {
+		return m.Election
+	}
+	return 0
+}
+
+type GetUnbondingQueueResponse struct {
+	Election             uint64            `protobuf:"varint,1,opt,name=election,proto3" json:"election,omitempty"`
+	Unbondings           []*UnbondingEntry `protobuf:"bytes,2,rep,name=unbondings,proto3" json:"unbondings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetUnbondingQueueResponse) Reset()         { *m = GetUnbondingQueueResponse{} }
+func (m *GetUnbondingQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUnbondingQueueResponse) ProtoMessage()    {}
+func (*GetUnbondingQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{33}
+}
+func (m *GetUnbondingQueueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUnbondingQueueResponse.Unmarshal(m, b)
+}
+func (m *GetUnbondingQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUnbondingQueueResponse.Marshal(b, m, deterministic)
+}
+func (m *GetUnbondingQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUnbondingQueueResponse.Merge(m, src)
+}
+func (m *GetUnbondingQueueResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUnbondingQueueResponse.Size(m)
+}
+func (m *GetUnbondingQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUnbondingQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUnbondingQueueResponse proto.InternalMessageInfo
+
+func (m *GetUnbondingQueueResponse) GetElection() uint64 {
+	if m != nil {
+		return m.Election
+	}
+	return 0
+}
+
+func (m *GetUnbondingQueueResponse) GetUnbondings() []*UnbondingEntry {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+type DposDelegatorCancelsUnbondEvent struct {
+	Delegator            *types.Address `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Validator            *types.Address `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Index                uint64         `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DposDelegatorCancelsUnbondEvent) Reset()         { *m = DposDelegatorCancelsUnbondEvent{} }
+func (m *DposDelegatorCancelsUnbondEvent) String() string { return proto.CompactTextString(m) }
+func (*DposDelegatorCancelsUnbondEvent) ProtoMessage()    {}
+func (*DposDelegatorCancelsUnbondEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{34}
+}
+func (m *DposDelegatorCancelsUnbondEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposDelegatorCancelsUnbondEvent.Unmarshal(m, b)
+}
+func (m *DposDelegatorCancelsUnbondEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposDelegatorCancelsUnbondEvent.Marshal(b, m, deterministic)
+}
+func (m *DposDelegatorCancelsUnbondEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposDelegatorCancelsUnbondEvent.Merge(m, src)
+}
+func (m *DposDelegatorCancelsUnbondEvent) XXX_Size() int {
+	return xxx_messageInfo_DposDelegatorCancelsUnbondEvent.Size(m)
+}
+func (m *DposDelegatorCancelsUnbondEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposDelegatorCancelsUnbondEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposDelegatorCancelsUnbondEvent proto.InternalMessageInfo
+
+func (m *DposDelegatorCancelsUnbondEvent) GetDelegator() *types.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *DposDelegatorCancelsUnbondEvent) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *DposDelegatorCancelsUnbondEvent) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DposDelegatorCancelsUnbondEvent) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type ValidatorKeyRotation struct {
+	Candidate            *types.Address `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	NewPubKey            []byte         `protobuf:"bytes,2,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	RequestedTime        int64          `protobuf:"varint,3,opt,name=requested_time,json=requestedTime,proto3" json:"requested_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidatorKeyRotation) Reset()         { *m = ValidatorKeyRotation{} }
+func (m *ValidatorKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorKeyRotation) ProtoMessage()    {}
+func (*ValidatorKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{35}
+}
+func (m *ValidatorKeyRotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorKeyRotation.Unmarshal(m, b)
+}
+func (m *ValidatorKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorKeyRotation.Marshal(b, m, deterministic)
+}
+func (m *ValidatorKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorKeyRotation.Merge(m, src)
+}
+func (m *ValidatorKeyRotation) XXX_Size() int {
+	return xxx_messageInfo_ValidatorKeyRotation.Size(m)
+}
+func (m *ValidatorKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorKeyRotation proto.InternalMessageInfo
+
+func (m *ValidatorKeyRotation) GetCandidate() *types.Address {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *ValidatorKeyRotation) GetNewPubKey() []byte {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *ValidatorKeyRotation) GetRequestedTime() int64 {
+	if m != nil {
+		return m.RequestedTime
+	}
+	return 0
+}
+
+type RotateValidatorKeyRequest struct {
+	NewPubKey            []byte   `protobuf:"bytes,1,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateValidatorKeyRequest) Reset()         { *m = RotateValidatorKeyRequest{} }
+func (m *RotateValidatorKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateValidatorKeyRequest) ProtoMessage()    {}
+func (*RotateValidatorKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{36}
+}
+func (m *RotateValidatorKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateValidatorKeyRequest.Unmarshal(m, b)
+}
+func (m *RotateValidatorKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateValidatorKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RotateValidatorKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateValidatorKeyRequest.Merge(m, src)
+}
+func (m *RotateValidatorKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateValidatorKeyRequest.Size(m)
+}
+func (m *RotateValidatorKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateValidatorKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateValidatorKeyRequest proto.InternalMessageInfo
+
+func (m *RotateValidatorKeyRequest) GetNewPubKey() []byte {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *RotateValidatorKeyRequest) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type ValidatorKeyRotationNonce struct {
+	Nonce                uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorKeyRotationNonce) Reset()         { *m = ValidatorKeyRotationNonce{} }
+func (m *ValidatorKeyRotationNonce) String() string { return proto.CompactTextString(m) }
+func (*ValidatorKeyRotationNonce) ProtoMessage()    {}
+func (*ValidatorKeyRotationNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{37}
+}
+func (m *ValidatorKeyRotationNonce) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorKeyRotationNonce.Unmarshal(m, b)
+}
+func (m *ValidatorKeyRotationNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorKeyRotationNonce.Marshal(b, m, deterministic)
+}
+func (m *ValidatorKeyRotationNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorKeyRotationNonce.Merge(m, src)
+}
+func (m *ValidatorKeyRotationNonce) XXX_Size() int {
+	return xxx_messageInfo_ValidatorKeyRotationNonce.Size(m)
+}
+func (m *ValidatorKeyRotationNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorKeyRotationNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorKeyRotationNonce proto.InternalMessageInfo
+
+func (m *ValidatorKeyRotationNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type GetValidatorKeyRotationRequest struct {
+	Candidate            *types.Address `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetValidatorKeyRotationRequest) Reset()         { *m = GetValidatorKeyRotationRequest{} }
+func (m *GetValidatorKeyRotationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorKeyRotationRequest) ProtoMessage()    {}
+func (*GetValidatorKeyRotationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{38}
+}
+func (m *GetValidatorKeyRotationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetValidatorKeyRotationRequest.Unmarshal(m, b)
+}
+func (m *GetValidatorKeyRotationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetValidatorKeyRotationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetValidatorKeyRotationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorKeyRotationRequest.Merge(m, src)
+}
+func (m *GetValidatorKeyRotationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetValidatorKeyRotationRequest.Size(m)
+}
+func (m *GetValidatorKeyRotationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorKeyRotationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorKeyRotationRequest proto.InternalMessageInfo
+
+func (m *GetValidatorKeyRotationRequest) GetCandidate() *types.Address {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+type GetValidatorKeyRotationResponse struct {
+	Rotation             *ValidatorKeyRotation `protobuf:"bytes,1,opt,name=rotation,proto3" json:"rotation,omitempty"`
+	Nonce                uint64                `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetValidatorKeyRotationResponse) Reset()         { *m = GetValidatorKeyRotationResponse{} }
+func (m *GetValidatorKeyRotationResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorKeyRotationResponse) ProtoMessage()    {}
+func (*GetValidatorKeyRotationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{39}
+}
+func (m *GetValidatorKeyRotationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetValidatorKeyRotationResponse.Unmarshal(m, b)
+}
+func (m *GetValidatorKeyRotationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetValidatorKeyRotationResponse.Marshal(b, m, deterministic)
+}
+func (m *GetValidatorKeyRotationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorKeyRotationResponse.Merge(m, src)
+}
+func (m *GetValidatorKeyRotationResponse) XXX_Size() int {
+	return xxx_messageInfo_GetValidatorKeyRotationResponse.Size(m)
+}
+func (m *GetValidatorKeyRotationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorKeyRotationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorKeyRotationResponse proto.InternalMessageInfo
+
+func (m *GetValidatorKeyRotationResponse) GetRotation() *ValidatorKeyRotation {
+	if m != nil {
+		return m.Rotation
+	}
+	return nil
+}
+
+func (m *GetValidatorKeyRotationResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type RetiredValidatorKey struct {
+	PubKey               []byte         `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	Candidate            *types.Address `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	NewPubKey            []byte         `protobuf:"bytes,3,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	BlockHeight          int64          `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RetiredValidatorKey) Reset()         { *m = RetiredValidatorKey{} }
+func (m *RetiredValidatorKey) String() string { return proto.CompactTextString(m) }
+func (*RetiredValidatorKey) ProtoMessage()    {}
+func (*RetiredValidatorKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{40}
+}
+func (m *RetiredValidatorKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetiredValidatorKey.Unmarshal(m, b)
+}
+func (m *RetiredValidatorKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetiredValidatorKey.Marshal(b, m, deterministic)
+}
+func (m *RetiredValidatorKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetiredValidatorKey.Merge(m, src)
+}
+func (m *RetiredValidatorKey) XXX_Size() int {
+	return xxx_messageInfo_RetiredValidatorKey.Size(m)
+}
+func (m *RetiredValidatorKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetiredValidatorKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetiredValidatorKey proto.InternalMessageInfo
+
+func (m *RetiredValidatorKey) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *RetiredValidatorKey) GetCandidate() *types.Address {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *RetiredValidatorKey) GetNewPubKey() []byte {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *RetiredValidatorKey) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type MigrateValidatorAddressRequest struct {
+	OldAddress           *types.Address `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	NewAddress           *types.Address `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MigrateValidatorAddressRequest) Reset()         { *m = MigrateValidatorAddressRequest{} }
+func (m *MigrateValidatorAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateValidatorAddressRequest) ProtoMessage()    {}
+func (*MigrateValidatorAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{41}
+}
+func (m *MigrateValidatorAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateValidatorAddressRequest.Unmarshal(m, b)
+}
+func (m *MigrateValidatorAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateValidatorAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateValidatorAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateValidatorAddressRequest.Merge(m, src)
+}
+func (m *MigrateValidatorAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateValidatorAddressRequest.Size(m)
+}
+func (m *MigrateValidatorAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateValidatorAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateValidatorAddressRequest proto.InternalMessageInfo
+
+func (m *MigrateValidatorAddressRequest) GetOldAddress() *types.Address {
+	if m != nil {
+		return m.OldAddress
+	}
+	return nil
+}
+
+func (m *MigrateValidatorAddressRequest) GetNewAddress() *types.Address {
+	if m != nil {
+		return m.NewAddress
+	}
+	return nil
+}
+
+type DposValidatorKeyRotatedEvent struct {
+	OldAddress           *types.Address `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	NewAddress           *types.Address `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	OldPubKey            []byte         `protobuf:"bytes,3,opt,name=old_pub_key,json=oldPubKey,proto3" json:"old_pub_key,omitempty"`
+	NewPubKey            []byte         `protobuf:"bytes,4,opt,name=new_pub_key,json=newPubKey,proto3" json:"new_pub_key,omitempty"`
+	BlockHeight          int64          `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DposValidatorKeyRotatedEvent) Reset()         { *m = DposValidatorKeyRotatedEvent{} }
+func (m *DposValidatorKeyRotatedEvent) String() string { return proto.CompactTextString(m) }
+func (*DposValidatorKeyRotatedEvent) ProtoMessage()    {}
+func (*DposValidatorKeyRotatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_307407628c7e326a, []int{42}
+}
+func (m *DposValidatorKeyRotatedEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DposValidatorKeyRotatedEvent.Unmarshal(m, b)
+}
+func (m *DposValidatorKeyRotatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DposValidatorKeyRotatedEvent.Marshal(b, m, deterministic)
+}
+func (m *DposValidatorKeyRotatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DposValidatorKeyRotatedEvent.Merge(m, src)
+}
+func (m *DposValidatorKeyRotatedEvent) XXX_Size() int {
+	return xxx_messageInfo_DposValidatorKeyRotatedEvent.Size(m)
+}
+func (m *DposValidatorKeyRotatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DposValidatorKeyRotatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DposValidatorKeyRotatedEvent proto.InternalMessageInfo
+
+func (m *DposValidatorKeyRotatedEvent) GetOldAddress() *types.Address {
+	if m != nil {
+		return m.OldAddress
+	}
+	return nil
+}
+
+func (m *DposValidatorKeyRotatedEvent) GetNewAddress() *types.Address {
+	if m != nil {
+		return m.NewAddress
+	}
+	return nil
+}
+
+func (m *DposValidatorKeyRotatedEvent) GetOldPubKey() []byte {
+	if m != nil {
+		return m.OldPubKey
+	}
+	return nil
+}
+
+func (m *DposValidatorKeyRotatedEvent) GetNewPubKey() []byte {
+	if m != nil {
+		return m.NewPubKey
+	}
+	return nil
+}
+
+func (m *DposValidatorKeyRotatedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ValidatorElectionRecord)(nil), "loomchain.dposv3.ValidatorElectionRecord")
+	proto.RegisterType((*ElectionRecord)(nil), "loomchain.dposv3.ElectionRecord")
+	proto.RegisterType((*DelegationReward)(nil), "loomchain.dposv3.DelegationReward")
+	proto.RegisterType((*DelegatorRewardRecord)(nil), "loomchain.dposv3.DelegatorRewardRecord")
+	proto.RegisterType((*HistoryCounter)(nil), "loomchain.dposv3.HistoryCounter")
+	proto.RegisterType((*GetElectionHistoryRequest)(nil), "loomchain.dposv3.GetElectionHistoryRequest")
+	proto.RegisterType((*GetElectionHistoryResponse)(nil), "loomchain.dposv3.GetElectionHistoryResponse")
+	proto.RegisterType((*GetDelegatorRewardHistoryRequest)(nil), "loomchain.dposv3.GetDelegatorRewardHistoryRequest")
+	proto.RegisterType((*GetDelegatorRewardHistoryResponse)(nil), "loomchain.dposv3.GetDelegatorRewardHistoryResponse")
+	proto.RegisterType((*ListCandidatesRequest)(nil), "loomchain.dposv3.ListCandidatesRequest")
+	proto.RegisterType((*ListCandidatesResponse)(nil), "loomchain.dposv3.ListCandidatesResponse")
+	proto.RegisterType((*ListDelegationsRequest)(nil), "loomchain.dposv3.ListDelegationsRequest")
+	proto.RegisterType((*ListDelegationsResponse)(nil), "loomchain.dposv3.ListDelegationsResponse")
+	proto.RegisterType((*ListAllDelegationsRequest)(nil), "loomchain.dposv3.ListAllDelegationsRequest")
+	proto.RegisterType((*ListAllDelegationsResponse)(nil), "loomchain.dposv3.ListAllDelegationsResponse")
+	proto.RegisterType((*ListReferrersRequest)(nil), "loomchain.dposv3.ListReferrersRequest")
+	proto.RegisterType((*ListReferrersResponse)(nil), "loomchain.dposv3.ListReferrersResponse")
+	proto.RegisterType((*CheckAllDelegationsRequest)(nil), "loomchain.dposv3.CheckAllDelegationsRequest")
+	proto.RegisterType((*CheckAllDelegationsResponse)(nil), "loomchain.dposv3.CheckAllDelegationsResponse")
+	proto.RegisterType((*DelegationIndicesStatus)(nil), "loomchain.dposv3.DelegationIndicesStatus")
+	proto.RegisterType((*CommissionLimits)(nil), "loomchain.dposv3.CommissionLimits")
+	proto.RegisterType((*CandidateFeeSchedule)(nil), "loomchain.dposv3.CandidateFeeSchedule")
+	proto.RegisterType((*RegisterCandidateWithCommissionRequest)(nil), "loomchain.dposv3.RegisterCandidateWithCommissionRequest")
+	proto.RegisterType((*SetCommissionLimitsRequest)(nil), "loomchain.dposv3.SetCommissionLimitsRequest")
+	proto.RegisterType((*GetCandidateFeeScheduleRequest)(nil), "loomchain.dposv3.GetCandidateFeeScheduleRequest")
+	proto.RegisterType((*GetCandidateFeeScheduleResponse)(nil), "loomchain.dposv3.GetCandidateFeeScheduleResponse")
+	proto.RegisterType((*CandidateFeeChangeEvent)(nil), "loomchain.dposv3.CandidateFeeChangeEvent")
+	proto.RegisterType((*UnbondingEntry)(nil), "loomchain.dposv3.UnbondingEntry")
+	proto.RegisterType((*ScheduleUnbondRequest)(nil), "loomchain.dposv3.ScheduleUnbondRequest")
+	proto.RegisterType((*CancelUnbondRequest)(nil), "loomchain.dposv3.CancelUnbondRequest")
+	proto.RegisterType((*ListUnbondingsRequest)(nil), "loomchain.dposv3.ListUnbondingsRequest")
+	proto.RegisterType((*ListUnbondingsResponse)(nil), "loomchain.dposv3.ListUnbondingsResponse")
+	proto.RegisterType((*GetUnbondingQueueRequest)(nil), "loomchain.dposv3.GetUnbondingQueueRequest")
+	proto.RegisterType((*GetUnbondingQueueResponse)(nil), "loomchain.dposv3.GetUnbondingQueueResponse")
+	proto.RegisterType((*DposDelegatorCancelsUnbondEvent)(nil), "loomchain.dposv3.DposDelegatorCancelsUnbondEvent")
+	proto.RegisterType((*ValidatorKeyRotation)(nil), "loomchain.dposv3.ValidatorKeyRotation")
+	proto.RegisterType((*RotateValidatorKeyRequest)(nil), "loomchain.dposv3.RotateValidatorKeyRequest")
+	proto.RegisterType((*ValidatorKeyRotationNonce)(nil), "loomchain.dposv3.ValidatorKeyRotationNonce")
+	proto.RegisterType((*GetValidatorKeyRotationRequest)(nil), "loomchain.dposv3.GetValidatorKeyRotationRequest")
+	proto.RegisterType((*GetValidatorKeyRotationResponse)(nil), "loomchain.dposv3.GetValidatorKeyRotationResponse")
+	proto.RegisterType((*RetiredValidatorKey)(nil), "loomchain.dposv3.RetiredValidatorKey")
+	proto.RegisterType((*MigrateValidatorAddressRequest)(nil), "loomchain.dposv3.MigrateValidatorAddressRequest")
+	proto.RegisterType((*DposValidatorKeyRotatedEvent)(nil), "loomchain.dposv3.DposValidatorKeyRotatedEvent")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/dposv3/dposv3.proto", fileDescriptor_307407628c7e326a)
+}
+
+var fileDescriptor_307407628c7e326a = []byte{
+	// 1757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x92, 0x1b, 0x49,
+	0x11, 0x8e, 0x96, 0x64, 0x8d, 0x94, 0x1a, 0x69, 0x45, 0x7b, 0xec, 0xd1, 0x68, 0x1d, 0x63, 0x6d,
+	0x2f, 0x18, 0xfb, 0xb0, 0x23, 0x76, 0x07, 0x38, 0x38, 0x08, 0x58, 0x7b, 0xc6, 0x3f, 0xc2, 0x66,
+	0xc3, 0xdb, 0xde, 0xe5, 0xef, 0xa2, 0x68, 0xa9, 0x73, 0xa4, 0xc2, 0xad, 0x6e, 0x6d, 0x57, 0xc9,
+	0xb2, 0x62, 0x0f, 0x3e, 0x41, 0x04, 0x0f, 0x00, 0x11, 0x9c, 0x08, 0x22, 0xe0, 0xc0, 0x3b, 0x70,
+	0xe3, 0xc8, 0x03, 0xf0, 0x0e, 0x3c, 0x05, 0x51, 0x7f, 0xdd, 0xad, 0xea, 0x6e, 0x5b, 0x63, 0x20,
+	0x82, 0xcb, 0x4c, 0x57, 0x56, 0x66, 0x56, 0x7e, 0x99, 0x59, 0x99, 0x59, 0x82, 0xf3, 0x19, 0x61,
+	0xf3, 0xd5, 0xe4, 0x64, 0x1a, 0x2d, 0x86, 0x41, 0x14, 0x2d, 0x42, 0x64, 0xeb, 0x28, 0x7e, 0x21,
+	0xbe, 0xa7, 0x73, 0x8f, 0x84, 0xc3, 0xc9, 0x8a, 0x04, 0x8c, 0x84, 0xc3, 0x65, 0xb0, 0x9a, 0x91,
+	0x90, 0x0e, 0xfd, 0x65, 0x44, 0x5f, 0x9e, 0xaa, 0x7f, 0x27, 0xcb, 0x38, 0x62, 0x91, 0xdd, 0x4d,
+	0xd8, 0x4f, 0x24, 0xbd, 0xff, 0x9d, 0x12, 0xbd, 0xb3, 0xe8, 0x23, 0xbe, 0x1c, 0xb2, 0xcd, 0x12,
+	0xa9, 0xfc, 0x2b, 0x75, 0xf4, 0x3f, 0x7d, 0x8b, 0x84, 0xb6, 0x43, 0x4a, 0x16, 0x58, 0xe1, 0xfc,
+	0xbe, 0x06, 0x87, 0x3f, 0xf5, 0x02, 0xe2, 0x7b, 0x2c, 0x8a, 0x1f, 0x04, 0x38, 0x65, 0x24, 0x0a,
+	0x5d, 0x9c, 0x46, 0xb1, 0x6f, 0xdf, 0x82, 0xe6, 0x4b, 0xbd, 0xd5, 0xb3, 0x06, 0xd6, 0xed, 0xd6,
+	0x27, 0x8d, 0x93, 0x7b, 0xbe, 0x1f, 0x23, 0xa5, 0x6e, 0xba, 0x65, 0x9f, 0x42, 0xd7, 0xc7, 0x00,
+	0x67, 0x1e, 0x97, 0x1d, 0xb3, 0x88, 0x79, 0x41, 0xaf, 0xa2, 0xd8, 0xef, 0x93, 0xd9, 0x97, 0xa3,
+	0x90, 0xb9, 0xef, 0xa5, 0x1c, 0x5f, 0x70, 0x06, 0xfb, 0x2e, 0x1c, 0x84, 0xb8, 0x1e, 0xe7, 0x04,
+	0xab, 0x86, 0xa0, 0x1d, 0xe2, 0xfa, 0xdc, 0x90, 0x3d, 0x85, 0xee, 0x7a, 0x4e, 0x18, 0x06, 0x84,
+	0xb2, 0xb1, 0xb7, 0x88, 0x56, 0x21, 0xeb, 0xd5, 0xcc, 0x03, 0x13, 0x8e, 0x7b, 0x82, 0xc1, 0xfe,
+	0x08, 0xda, 0xe2, 0x84, 0x71, 0x8c, 0x6b, 0x2f, 0xf6, 0x69, 0xef, 0x8a, 0x21, 0xb1, 0x2f, 0xb6,
+	0x5d, 0xb9, 0x6b, 0xf7, 0xa1, 0x7a, 0x81, 0xd8, 0xab, 0x1b, 0x4c, 0x9c, 0xc8, 0x55, 0xc5, 0x78,
+	0x81, 0x71, 0x8c, 0xf1, 0xf8, 0x02, 0x91, 0xf6, 0xf6, 0x4c, 0x55, 0x7a, 0xfb, 0x21, 0x22, 0xb5,
+	0xbf, 0x07, 0xdf, 0x50, 0x30, 0xa3, 0x38, 0x39, 0xbd, 0x61, 0x88, 0x74, 0x13, 0x16, 0x6d, 0xc1,
+	0x29, 0x74, 0x69, 0xe0, 0xd1, 0xf9, 0x78, 0x89, 0xf1, 0x14, 0x43, 0xe6, 0xcd, 0xb0, 0xd7, 0x34,
+	0x51, 0x0a, 0x8e, 0x67, 0x09, 0x83, 0x3d, 0x84, 0x8e, 0x20, 0xa1, 0xaf, 0x1d, 0x03, 0x86, 0x48,
+	0x5b, 0xed, 0x2b, 0xb7, 0x5c, 0x87, 0xfa, 0xaf, 0x3c, 0x12, 0xa0, 0xdf, 0x6b, 0x0d, 0xac, 0xdb,
+	0x0d, 0x57, 0xad, 0x9c, 0x7f, 0x59, 0xd0, 0x31, 0xf2, 0xa1, 0x03, 0x15, 0xe2, 0x8b, 0x44, 0xa8,
+	0xb9, 0x15, 0xe2, 0xdb, 0x1f, 0x42, 0x1b, 0x15, 0xc7, 0x98, 0x91, 0x05, 0x8a, 0xa0, 0x57, 0xdd,
+	0x7d, 0x4d, 0xfc, 0x82, 0x2c, 0xd0, 0xfe, 0x00, 0xf6, 0x27, 0x41, 0x34, 0x7d, 0x31, 0x9e, 0x23,
+	0x99, 0xcd, 0x99, 0x88, 0x6f, 0xd5, 0x6d, 0x09, 0xda, 0x63, 0x41, 0xb2, 0x47, 0x00, 0x49, 0x32,
+	0xd1, 0x5e, 0x6d, 0x50, 0xbd, 0xdd, 0xfa, 0xe4, 0xce, 0x89, 0x79, 0x3d, 0x4e, 0x4a, 0xd2, 0xd4,
+	0xcd, 0x08, 0x73, 0x57, 0xcb, 0x20, 0xfb, 0x84, 0xb2, 0x98, 0x4c, 0x56, 0x0c, 0xfd, 0x5c, 0xa0,
+	0xbb, 0x82, 0xe5, 0x3c, 0xe5, 0x70, 0xfe, 0x6a, 0x41, 0x37, 0x4d, 0x32, 0x19, 0x80, 0x9d, 0xd3,
+	0xff, 0x00, 0xae, 0x90, 0xd0, 0xc7, 0x57, 0x02, 0x7e, 0xcd, 0x95, 0x8b, 0x4c, 0xd0, 0xb9, 0x7b,
+	0x54, 0x2c, 0xaa, 0x25, 0x41, 0x27, 0x51, 0xa8, 0xc2, 0x31, 0x80, 0xba, 0xcc, 0x90, 0x5c, 0x42,
+	0x2b, 0xba, 0xf3, 0x0f, 0x0b, 0xae, 0x9d, 0x6f, 0xe7, 0x4a, 0x49, 0x7c, 0x6e, 0x42, 0x2b, 0x89,
+	0x0f, 0xf1, 0x95, 0x79, 0xa0, 0x49, 0xa3, 0x82, 0x00, 0x56, 0x0b, 0x02, 0xf8, 0x03, 0xd8, 0xd3,
+	0x39, 0x2b, 0x43, 0xe3, 0xe4, 0x43, 0x63, 0xfa, 0xce, 0xd5, 0x22, 0xf6, 0x31, 0x5c, 0x91, 0xf7,
+	0xda, 0x0c, 0x82, 0x24, 0x3b, 0x77, 0xa0, 0xf3, 0x98, 0x50, 0x16, 0xc5, 0x9b, 0x33, 0x8e, 0x1f,
+	0x63, 0xfb, 0x10, 0xf6, 0x02, 0x8f, 0xb2, 0x71, 0x02, 0xa5, 0xce, 0x97, 0x23, 0xdf, 0x79, 0x0a,
+	0x47, 0x8f, 0x90, 0xe9, 0xe0, 0x2b, 0x29, 0x17, 0xbf, 0x5a, 0x21, 0x65, 0xf6, 0x11, 0x34, 0x28,
+	0xf3, 0xe2, 0x8c, 0xd8, 0x9e, 0x58, 0x8f, 0x7c, 0x1e, 0x9f, 0x80, 0x2c, 0x08, 0x13, 0x0e, 0x68,
+	0xbb, 0x72, 0xe1, 0x7c, 0x05, 0xfd, 0x22, 0x6d, 0x74, 0x19, 0x85, 0x14, 0xed, 0xbb, 0x1c, 0x34,
+	0x77, 0x2a, 0xed, 0x59, 0x02, 0xf4, 0x20, 0x0f, 0xda, 0x48, 0x43, 0x2d, 0xc0, 0x01, 0x84, 0xf8,
+	0x8a, 0xa5, 0x2e, 0xaf, 0xf3, 0xe5, 0xc8, 0x77, 0xbe, 0x86, 0xc1, 0x23, 0x64, 0x46, 0xec, 0x0c,
+	0x1c, 0xb7, 0xa0, 0x99, 0x14, 0x82, 0x7c, 0xd2, 0x25, 0x5b, 0x5b, 0x78, 0x2b, 0x25, 0x78, 0xab,
+	0x59, 0xbc, 0xaf, 0xe1, 0x83, 0x37, 0x1c, 0xae, 0x60, 0xdf, 0x33, 0x61, 0x7f, 0xbb, 0x34, 0xd6,
+	0xdb, 0xb9, 0xb7, 0x03, 0xfa, 0x1f, 0xc3, 0xb5, 0xa7, 0x84, 0xb2, 0x33, 0x2f, 0xf4, 0xf9, 0xc5,
+	0x41, 0xaa, 0x21, 0xbf, 0x0f, 0x4d, 0x09, 0xe5, 0x05, 0x6e, 0x04, 0xe4, 0x7d, 0x57, 0x62, 0x7b,
+	0x82, 0x9b, 0x92, 0xe0, 0xfd, 0xcd, 0x82, 0xeb, 0xa6, 0xb2, 0x24, 0x72, 0x30, 0x4d, 0xa8, 0x0a,
+	0x45, 0x5f, 0xdb, 0x9e, 0xf0, 0x3f, 0x67, 0x1e, 0x23, 0x94, 0x91, 0xa9, 0x9b, 0xe1, 0xb6, 0x9f,
+	0x40, 0xfb, 0x02, 0x71, 0x4c, 0xa7, 0x73, 0xf4, 0x57, 0x01, 0xd2, 0x5e, 0x45, 0x88, 0xdf, 0xca,
+	0x3b, 0x21, 0x51, 0xf4, 0x10, 0xf1, 0xb9, 0x62, 0x77, 0xf7, 0x2f, 0xd2, 0x05, 0xe5, 0x11, 0x12,
+	0x8e, 0xe0, 0xa8, 0xaa, 0x02, 0x95, 0x70, 0xcc, 0x13, 0xdc, 0x38, 0x54, 0x5a, 0x9f, 0xde, 0x1a,
+	0x9a, 0x09, 0x7f, 0x62, 0x4f, 0x3e, 0xfc, 0xc9, 0xd6, 0xb6, 0xcf, 0x2a, 0x65, 0x3e, 0xdb, 0x4a,
+	0x80, 0x3f, 0x5a, 0x70, 0x98, 0x3b, 0x55, 0x39, 0xad, 0xa8, 0x83, 0x5b, 0x6f, 0xeb, 0xe0, 0xdf,
+	0x85, 0x56, 0x4a, 0xd2, 0xbe, 0xb2, 0x0b, 0x4a, 0x42, 0x96, 0xed, 0x4d, 0x6e, 0xf9, 0x0c, 0x8e,
+	0xb8, 0x81, 0xf7, 0x82, 0xa0, 0xc0, 0x33, 0xef, 0x90, 0x25, 0xbf, 0xb5, 0xa0, 0x5f, 0xa4, 0x50,
+	0x81, 0x7e, 0x06, 0x1d, 0x31, 0x40, 0xc4, 0x8a, 0xa0, 0xb3, 0xa5, 0xa0, 0xf5, 0x94, 0xf8, 0xcd,
+	0x6d, 0x73, 0x05, 0x7a, 0xb5, 0x8d, 0xad, 0xb2, 0x8d, 0x6d, 0x04, 0x07, 0x4f, 0x05, 0xaf, 0x9c,
+	0x0b, 0xfe, 0x13, 0x58, 0x13, 0xb8, 0x66, 0xa8, 0x52, 0x80, 0x4e, 0xa0, 0xa9, 0xe7, 0x0e, 0x8d,
+	0xa5, 0xab, 0x11, 0x68, 0x6e, 0x37, 0x65, 0x79, 0x93, 0xb9, 0xbf, 0xb1, 0xa0, 0x7f, 0x36, 0xc7,
+	0xe9, 0x8b, 0xe2, 0x60, 0x6c, 0x4d, 0x34, 0x9e, 0x4c, 0xcf, 0x5c, 0xba, 0xa6, 0x13, 0x8d, 0xa2,
+	0xbc, 0x4b, 0xd6, 0xfe, 0xdd, 0x82, 0xf7, 0x0b, 0x0d, 0x51, 0x98, 0x07, 0x50, 0x57, 0xbd, 0xd5,
+	0xcc, 0x57, 0x45, 0xb7, 0x3f, 0x86, 0xf7, 0xd6, 0x62, 0xce, 0x48, 0x47, 0x22, 0x73, 0x38, 0xed,
+	0x68, 0x06, 0xd5, 0x84, 0x8d, 0xcc, 0xae, 0x5e, 0x3e, 0xb3, 0x6b, 0xdb, 0xee, 0x1c, 0xc2, 0x61,
+	0x2a, 0x35, 0x0a, 0x7d, 0x32, 0x45, 0xca, 0x4b, 0xd0, 0x8a, 0x72, 0xd8, 0x62, 0x4a, 0x17, 0xf6,
+	0x37, 0x5c, 0xb9, 0x70, 0x3e, 0x87, 0xee, 0x59, 0xb4, 0x58, 0x10, 0x4a, 0x49, 0x14, 0x3e, 0xe5,
+	0x9e, 0x10, 0x95, 0x75, 0xe1, 0xbd, 0xe2, 0x03, 0xa7, 0x6e, 0x8c, 0x0b, 0xef, 0xd5, 0x43, 0x44,
+	0xfb, 0x9b, 0xd0, 0x51, 0x1b, 0xe3, 0xe9, 0xdc, 0x0b, 0x67, 0xa8, 0x2a, 0xef, 0xbe, 0xdc, 0x3f,
+	0x13, 0x34, 0xe7, 0x2f, 0x16, 0x1c, 0x14, 0x95, 0xad, 0x9d, 0x6b, 0x4e, 0x57, 0x4e, 0xc4, 0x52,
+	0x37, 0xff, 0x94, 0xb5, 0x7e, 0x2d, 0x2c, 0xaa, 0xea, 0x5a, 0xbf, 0xe6, 0x16, 0xdd, 0x85, 0xba,
+	0x08, 0x1f, 0x55, 0x53, 0x4c, 0xc1, 0xc8, 0x60, 0xc2, 0x73, 0x95, 0x84, 0xf3, 0x67, 0x0b, 0x6e,
+	0xb9, 0x38, 0x23, 0x94, 0x61, 0x9c, 0xd8, 0xfb, 0x33, 0xc2, 0xe6, 0xa9, 0x84, 0x4e, 0xc3, 0x1f,
+	0xe6, 0x2d, 0x1f, 0xa4, 0x09, 0x6f, 0xa8, 0x50, 0x42, 0x59, 0x44, 0xa9, 0x99, 0x95, 0x4b, 0x9b,
+	0xf9, 0x73, 0xe8, 0x3f, 0x47, 0x96, 0xdb, 0x56, 0x96, 0xa5, 0x9a, 0xad, 0x4b, 0x6b, 0x7e, 0x0c,
+	0xc7, 0x8f, 0x90, 0x15, 0x76, 0x98, 0xcb, 0x75, 0x09, 0x27, 0x80, 0x9b, 0xa5, 0x9a, 0xd4, 0xfd,
+	0x19, 0xc1, 0x7e, 0xb6, 0xe5, 0x29, 0x6d, 0xbb, 0x76, 0xbc, 0x56, 0xa6, 0xe3, 0x39, 0x7f, 0xb2,
+	0xe0, 0x30, 0xcb, 0x25, 0xf3, 0xee, 0xc1, 0x4b, 0x0c, 0x99, 0xed, 0xc0, 0x5e, 0x59, 0x99, 0xd0,
+	0x1b, 0xd9, 0x6c, 0xaa, 0x6c, 0x65, 0x93, 0x69, 0x63, 0xf5, 0xdd, 0x6d, 0xfc, 0x5d, 0x05, 0x3a,
+	0x5f, 0x86, 0x93, 0x28, 0xf4, 0x49, 0x38, 0x7b, 0x10, 0xb2, 0x78, 0xb3, 0xf3, 0xc4, 0xb5, 0xf5,
+	0x1c, 0xa8, 0xec, 0xf0, 0x1c, 0xa8, 0x66, 0x9f, 0x03, 0x69, 0x9d, 0xaa, 0x95, 0xd4, 0xa9, 0x21,
+	0x5c, 0x9d, 0x46, 0x8b, 0x65, 0x80, 0xa2, 0x07, 0xeb, 0x11, 0x5c, 0xcc, 0xcd, 0x35, 0xd7, 0x4e,
+	0xb7, 0xf4, 0xd8, 0x69, 0x7f, 0x0b, 0x3a, 0xb1, 0x4c, 0x08, 0xf4, 0xe5, 0xf8, 0x5e, 0x17, 0xe3,
+	0x7b, 0x3b, 0xa1, 0x8a, 0xf9, 0xfd, 0x43, 0x68, 0x2f, 0x3c, 0xb6, 0x8a, 0x09, 0xdb, 0x48, 0xae,
+	0x3d, 0x39, 0xe4, 0x6b, 0x22, 0x67, 0xe2, 0x97, 0xee, 0x9a, 0x76, 0x92, 0xf4, 0x4f, 0xa6, 0xd4,
+	0x27, 0xd8, 0xca, 0x4b, 0x7d, 0xc2, 0xa2, 0x28, 0x19, 0xbc, 0x95, 0x12, 0xbc, 0xc5, 0x7e, 0xba,
+	0x01, 0x4d, 0x0d, 0x5d, 0x16, 0x8f, 0x9a, 0x9b, 0x12, 0x9c, 0x09, 0x5c, 0x3d, 0xf3, 0xc2, 0x29,
+	0x06, 0xff, 0x15, 0x1b, 0x0b, 0x1f, 0x6e, 0xce, 0x8f, 0x64, 0x7b, 0x4d, 0xb2, 0x84, 0x5e, 0x72,
+	0x34, 0x77, 0x5e, 0xc3, 0x75, 0x53, 0x81, 0xba, 0x6c, 0x9f, 0x02, 0xac, 0x12, 0x6a, 0xf9, 0xc3,
+	0x62, 0x3b, 0x41, 0xdd, 0x8c, 0x0c, 0x0f, 0xa6, 0x78, 0x1c, 0x25, 0xe9, 0xa1, 0x2a, 0x3d, 0x27,
+	0xea, 0xc4, 0x70, 0xbe, 0x0f, 0xbd, 0x47, 0x98, 0x9e, 0xff, 0xf9, 0x0a, 0x57, 0x49, 0xe9, 0xe8,
+	0x43, 0x23, 0x91, 0x95, 0x5d, 0x24, 0x59, 0x3b, 0x1b, 0x38, 0x2a, 0x90, 0x53, 0xb6, 0xbf, 0x41,
+	0xd0, 0xc0, 0x55, 0xb9, 0x3c, 0x2e, 0xfe, 0x00, 0xbf, 0x79, 0xbe, 0x8c, 0x68, 0xf2, 0xb8, 0x90,
+	0x61, 0xa6, 0x52, 0x44, 0xd6, 0x90, 0xff, 0x93, 0x8b, 0xea, 0xfc, 0xda, 0x82, 0x83, 0xe4, 0xb7,
+	0x88, 0x27, 0xb8, 0x71, 0x23, 0x26, 0xfa, 0xfa, 0xce, 0x8d, 0xf4, 0x18, 0x5a, 0xbc, 0xd0, 0x2d,
+	0x57, 0x93, 0xcc, 0x20, 0xd4, 0x0c, 0x71, 0xfd, 0x6c, 0x35, 0xe1, 0x93, 0x50, 0xfe, 0x62, 0x57,
+	0x0b, 0x2e, 0xb6, 0xf3, 0x0b, 0x38, 0x12, 0x47, 0xe3, 0x96, 0x31, 0x2a, 0xce, 0xc6, 0x19, 0x96,
+	0x79, 0xc6, 0x0d, 0x68, 0x52, 0x32, 0x0b, 0x79, 0x0d, 0x40, 0x6d, 0x41, 0x42, 0x70, 0x3e, 0x86,
+	0xa3, 0x22, 0x84, 0x9f, 0x45, 0xe1, 0x14, 0xb9, 0xdf, 0x42, 0xfe, 0xa1, 0xd2, 0x40, 0x2e, 0x54,
+	0xd7, 0x2a, 0x92, 0xba, 0x6c, 0xd7, 0xfa, 0x1a, 0x6e, 0x96, 0x6a, 0x52, 0xc9, 0x78, 0x1f, 0x1a,
+	0xb1, 0xa2, 0x95, 0x77, 0xac, 0x42, 0x0d, 0x89, 0x5c, 0x0a, 0xa3, 0x92, 0x85, 0xf1, 0x07, 0x0b,
+	0xae, 0xba, 0xc8, 0x48, 0x8c, 0x7e, 0x56, 0x9e, 0x37, 0xa7, 0x6d, 0x5f, 0xd6, 0x97, 0xd2, 0x91,
+	0x5b, 0xa8, 0x2a, 0x3b, 0x07, 0xbd, 0x6a, 0x06, 0xc4, 0xfc, 0x9d, 0xac, 0x96, 0xfb, 0x9d, 0xcc,
+	0x79, 0x09, 0xc7, 0x3f, 0x21, 0xb3, 0x38, 0x1b, 0x71, 0x7d, 0x90, 0x72, 0xf1, 0x1d, 0x68, 0x45,
+	0x81, 0x5f, 0x5a, 0x02, 0x21, 0x0a, 0x7c, 0xf5, 0x6d, 0xdf, 0x91, 0xf6, 0x68, 0x56, 0xd3, 0x72,
+	0x08, 0x71, 0xad, 0xbe, 0x9d, 0x7f, 0x5a, 0x70, 0x83, 0x5f, 0xce, 0x9c, 0x43, 0x51, 0xdd, 0xcc,
+	0xff, 0xc9, 0xb1, 0xf6, 0xb1, 0xd4, 0x6a, 0x78, 0x2c, 0x0a, 0x7c, 0xe5, 0x31, 0xc3, 0xa3, 0xb5,
+	0xb7, 0x79, 0xf4, 0x4a, 0xce, 0xa3, 0xf7, 0x1b, 0xbf, 0xac, 0xcb, 0x64, 0x99, 0xd4, 0xc5, 0xcf,
+	0xe1, 0xa7, 0xff, 0x1e, 0x00, 0x36, 0x1a, 0x47, 0xf1, 0xdc, 0x17, 0x00, 0x00,
+}
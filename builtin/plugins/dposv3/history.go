@@ -0,0 +1,306 @@
+package dposv3
+
+import (
+	"encoding/binary"
+	"sort"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// The election history ledger keeps a record of the stake, rewards, fees & slashes of every
+// validator in each election, and a record of the rewards credited to each delegator in each
+// election. Records are never pruned, they're only written once the DPOSVersion3_13 feature flag
+// is enabled.
+
+const (
+	defaultHistoryPageSize = 20
+	maxHistoryPageSize     = 100
+)
+
+var (
+	electionHistoryCounterKey  = []byte("ehcount")
+	electionHistoryPrefix      = []byte("eh")
+	rewardHistoryCounterPrefix = []byte("rhcount")
+	rewardHistoryPrefix        = []byte("rh")
+)
+
+func historyID(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return b
+}
+
+func electionRecordKey(id uint64) []byte {
+	return util.PrefixKey(electionHistoryPrefix, historyID(id))
+}
+
+func rewardHistoryCounterKey(delegator loom.Address) []byte {
+	return util.PrefixKey(rewardHistoryCounterPrefix, delegator.Bytes())
+}
+
+func rewardRecordKey(delegator loom.Address, id uint64) []byte {
+	return util.PrefixKey(rewardHistoryPrefix, delegator.Bytes(), historyID(id))
+}
+
+// electionLedger accumulates the history of a single election while it's being processed. All the
+// methods are no-ops on a nil ledger, which is what newElectionLedger returns while the ledger is
+// disabled.
+type electionLedger struct {
+	validators map[string]*ValidatorElectionRecord
+	delegators map[string]*DelegatorRewardRecord
+}
+
+func newElectionLedger(ctx contract.StaticContext) *electionLedger {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_13, false) {
+		return nil
+	}
+	return &electionLedger{
+		validators: map[string]*ValidatorElectionRecord{},
+		delegators: map[string]*DelegatorRewardRecord{},
+	}
+}
+
+func (l *electionLedger) validator(addr loom.Address) *ValidatorElectionRecord {
+	key := addr.String()
+	record, ok := l.validators[key]
+	if !ok {
+		record = &ValidatorElectionRecord{
+			Validator:          addr.MarshalPB(),
+			DelegationTotal:    loom.BigZeroPB(),
+			NewDelegationTotal: loom.BigZeroPB(),
+			WhitelistAmount:    loom.BigZeroPB(),
+			TotalRewards:       loom.BigZeroPB(),
+			Fee:                loom.BigZeroPB(),
+			ReferrerFees:       loom.BigZeroPB(),
+			DelegatorRewards:   loom.BigZeroPB(),
+			SlashPercentage:    loom.BigZeroPB(),
+			SlashedAmount:      loom.BigZeroPB(),
+		}
+		l.validators[key] = record
+	}
+	return record
+}
+
+// Records the stake of a validator at the start of the election.
+func (l *electionLedger) recordValidator(addr loom.Address, statistic *ValidatorStatistic) {
+	if l == nil {
+		return
+	}
+	record := l.validator(addr)
+	if statistic.DelegationTotal != nil {
+		record.DelegationTotal = statistic.DelegationTotal
+	}
+	if statistic.WhitelistAmount != nil {
+		record.WhitelistAmount = statistic.WhitelistAmount
+	}
+	record.Jailed = statistic.Jailed
+}
+
+// Records the split of the rewards generated by a validator.
+func (l *electionLedger) recordValidatorRewards(
+	addr loom.Address, total, fee, referrerFees, delegatorRewards loom.BigUInt,
+) {
+	if l == nil {
+		return
+	}
+	record := l.validator(addr)
+	record.TotalRewards = &types.BigUInt{Value: total}
+	record.Fee = &types.BigUInt{Value: fee}
+	record.ReferrerFees = &types.BigUInt{Value: referrerFees}
+	record.DelegatorRewards = &types.BigUInt{Value: delegatorRewards}
+}
+
+func (l *electionLedger) recordSlash(addr loom.Address, slashPercentage *types.BigUInt, amount loom.BigUInt) {
+	if l == nil {
+		return
+	}
+	record := l.validator(addr)
+	record.SlashPercentage = slashPercentage
+	slashed := common.BigZero()
+	slashed.Add(&record.SlashedAmount.Value, &amount)
+	record.SlashedAmount = &types.BigUInt{Value: *slashed}
+}
+
+// Records a reward credited to a delegator, rewards credited to the same delegation multiple times
+// in an election (e.g. a validator's fee & its share of its own delegator rewards) are recorded
+// separately.
+func (l *electionLedger) recordReward(
+	delegator, validator *types.Address, index uint64, delegationAmount, reward loom.BigUInt,
+) {
+	if l == nil || !common.IsPositive(reward) {
+		return
+	}
+	key := loom.UnmarshalAddressPB(delegator).String()
+	record, ok := l.delegators[key]
+	if !ok {
+		record = &DelegatorRewardRecord{Total: loom.BigZeroPB()}
+		l.delegators[key] = record
+	}
+	record.Rewards = append(record.Rewards, &DelegationReward{
+		Validator:        validator,
+		Index:            index,
+		DelegationAmount: &types.BigUInt{Value: delegationAmount},
+		Reward:           &types.BigUInt{Value: reward},
+	})
+	total := common.BigZero()
+	total.Add(&record.Total.Value, &reward)
+	record.Total = &types.BigUInt{Value: *total}
+}
+
+// Persists the election record, along with the reward records of all the delegators that were
+// credited rewards in the election.
+func (l *electionLedger) save(
+	ctx contract.Context, newDelegationTotals map[string]*loom.BigUInt, distributedRewards *loom.BigUInt,
+) error {
+	if l == nil {
+		return nil
+	}
+
+	for validatorKey, total := range newDelegationTotals {
+		addr, err := loom.ParseAddress(validatorKey)
+		if err != nil {
+			return err
+		}
+		l.validator(addr).NewDelegationTotal = &types.BigUInt{Value: *total}
+	}
+
+	validatorKeys := make([]string, 0, len(l.validators))
+	for key := range l.validators {
+		validatorKeys = append(validatorKeys, key)
+	}
+	sort.Strings(validatorKeys)
+	validators := make([]*ValidatorElectionRecord, 0, len(validatorKeys))
+	for _, key := range validatorKeys {
+		validators = append(validators, l.validators[key])
+	}
+
+	var counter HistoryCounter
+	if err := ctx.Get(electionHistoryCounterKey, &counter); err != nil && err != contract.ErrNotFound {
+		return err
+	}
+	counter.LastId++
+	electionTime := ctx.Now().Unix()
+	election := &ElectionRecord{
+		Id:               counter.LastId,
+		ElectionTime:     electionTime,
+		BlockHeight:      ctx.Block().Height,
+		Validators:       validators,
+		TotalDistributed: &types.BigUInt{Value: *distributedRewards},
+	}
+	if err := ctx.Set(electionRecordKey(election.Id), election); err != nil {
+		return err
+	}
+	if err := ctx.Set(electionHistoryCounterKey, &counter); err != nil {
+		return err
+	}
+
+	delegatorKeys := make([]string, 0, len(l.delegators))
+	for key := range l.delegators {
+		delegatorKeys = append(delegatorKeys, key)
+	}
+	sort.Strings(delegatorKeys)
+	for _, key := range delegatorKeys {
+		delegator, err := loom.ParseAddress(key)
+		if err != nil {
+			return err
+		}
+		var delegatorCounter HistoryCounter
+		err = ctx.Get(rewardHistoryCounterKey(delegator), &delegatorCounter)
+		if err != nil && err != contract.ErrNotFound {
+			return err
+		}
+		delegatorCounter.LastId++
+
+		record := l.delegators[key]
+		record.Id = delegatorCounter.LastId
+		record.ElectionId = election.Id
+		record.ElectionTime = electionTime
+		if err := ctx.Set(rewardRecordKey(delegator, record.Id), record); err != nil {
+			return err
+		}
+		if err := ctx.Set(rewardHistoryCounterKey(delegator), &delegatorCounter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the IDs of the records in a page of a history, newest first, and the ID the next page
+// starts at (zero if this is the last page).
+func historyPage(lastID, startID uint64, limit uint32) ([]uint64, uint64) {
+	if startID == 0 || startID > lastID {
+		startID = lastID
+	}
+	if limit == 0 {
+		limit = defaultHistoryPageSize
+	} else if limit > maxHistoryPageSize {
+		limit = maxHistoryPageSize
+	}
+
+	ids := make([]uint64, 0, limit)
+	id := startID
+	for ; id > 0 && len(ids) < int(limit); id-- {
+		ids = append(ids, id)
+	}
+	return ids, id
+}
+
+// GetElectionHistory returns a page of election records, newest first.
+func (c *DPOS) GetElectionHistory(
+	ctx contract.StaticContext, req *GetElectionHistoryRequest,
+) (*GetElectionHistoryResponse, error) {
+	var counter HistoryCounter
+	if err := ctx.Get(electionHistoryCounterKey, &counter); err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+
+	ids, nextID := historyPage(counter.LastId, req.StartId, req.Limit)
+	records := make([]*ElectionRecord, 0, len(ids))
+	for _, id := range ids {
+		var record ElectionRecord
+		if err := ctx.Get(electionRecordKey(id), &record); err != nil {
+			return nil, errors.Wrapf(err, "failed to load election record %d", id)
+		}
+		records = append(records, &record)
+	}
+	return &GetElectionHistoryResponse{
+		Records: records,
+		NextId:  nextID,
+	}, nil
+}
+
+// GetDelegatorRewardHistory returns a page of the rewards credited to a delegator (or the sender if
+// no delegator is specified), newest first.
+func (c *DPOS) GetDelegatorRewardHistory(
+	ctx contract.StaticContext, req *GetDelegatorRewardHistoryRequest,
+) (*GetDelegatorRewardHistoryResponse, error) {
+	delegator := ctx.Message().Sender
+	if req.Delegator != nil {
+		delegator = loom.UnmarshalAddressPB(req.Delegator)
+	}
+
+	var counter HistoryCounter
+	if err := ctx.Get(rewardHistoryCounterKey(delegator), &counter); err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+
+	ids, nextID := historyPage(counter.LastId, req.StartId, req.Limit)
+	records := make([]*DelegatorRewardRecord, 0, len(ids))
+	for _, id := range ids {
+		var record DelegatorRewardRecord
+		if err := ctx.Get(rewardRecordKey(delegator, id), &record); err != nil {
+			return nil, errors.Wrapf(err, "failed to load reward record %d", id)
+		}
+		records = append(records, &record)
+	}
+	return &GetDelegatorRewardHistoryResponse{
+		Records: records,
+		NextId:  nextID,
+	}, nil
+}
@@ -0,0 +1,136 @@
+package dposv3
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryPage(t *testing.T) {
+	ids, next := historyPage(0, 0, 0)
+	require.Len(t, ids, 0)
+	require.Equal(t, uint64(0), next)
+
+	ids, next = historyPage(5, 0, 2)
+	require.Equal(t, []uint64{5, 4}, ids)
+	require.Equal(t, uint64(3), next)
+
+	ids, next = historyPage(5, next, 10)
+	require.Equal(t, []uint64{3, 2, 1}, ids)
+	require.Equal(t, uint64(0), next)
+
+	// start IDs past the last record start from the last record
+	ids, _ = historyPage(5, 10, 1)
+	require.Equal(t, []uint64{5}, ids)
+
+	ids, _ = historyPage(1000, 0, 1000)
+	require.Len(t, ids, maxHistoryPageSize)
+}
+
+func TestElectionHistory(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_1, true)
+	pctx.SetFeature(features.DPOSVersion3_11, true)
+	pctx.SetFeature(features.DPOSVersion3_13, true)
+	coinAddr := pctx.CreateContract(coin.Contract)
+
+	coinContract := &coin.Coin{}
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 100000000),
+			makeAccount(addr1, 100000000),
+		},
+	})
+
+	cycleLengthSeconds := int64(100)
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:      10,
+		ElectionCycleLength: cycleLengthSeconds,
+		CoinContractAddress: coinAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := contractpb.WrapPluginContext(pctx.WithAddress(dpos.Address))
+
+	// transfer coins to reward fund
+	amount := big.NewInt(10)
+	amount.Exp(amount, big.NewInt(19), nil)
+	coinContract.Transfer(contractpb.WrapPluginContext(coinCtx), &coin.TransferRequest{
+		To:     dpos.Address.MarshalPB(),
+		Amount: &types.BigUInt{Value: common.BigUInt{amount}},
+	})
+
+	registrationFee := &types.BigUInt{Value: *scientificNotation(defaultRegistrationRequirement, tokenDecimals)}
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(addr1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  registrationFee,
+	})
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	delegationAmount := loom.BigUInt{big.NewInt(1e18)}
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: delegationAmount},
+	})
+	require.Nil(t, err)
+	err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &addr1, delegationAmount.Int, nil, nil)
+	require.Nil(t, err)
+
+	for i := 0; i < 5; i++ {
+		require.NoError(t, elect(pctx, dpos.Address))
+		pctx.SetTime(pctx.Now().Add(time.Duration(cycleLengthSeconds) * time.Second))
+	}
+
+	history, err := dpos.Contract.GetElectionHistory(dposCtx, &GetElectionHistoryRequest{Limit: 3})
+	require.Nil(t, err)
+	require.Len(t, history.Records, 3)
+	require.Equal(t, uint64(5), history.Records[0].Id)
+	require.Equal(t, uint64(2), history.NextId)
+	latest := history.Records[0]
+	require.Len(t, latest.Validators, 1)
+	require.Equal(t, 0, loom.UnmarshalAddressPB(latest.Validators[0].Validator).Compare(addr1))
+	require.True(t, latest.Validators[0].TotalRewards.Value.Sign() > 0)
+	require.True(t, latest.Validators[0].Fee.Value.Sign() > 0)
+	require.True(t, latest.Validators[0].NewDelegationTotal.Value.Sign() > 0)
+	require.True(t, latest.TotalDistributed.Value.Sign() > 0)
+
+	history, err = dpos.Contract.GetElectionHistory(dposCtx, &GetElectionHistoryRequest{StartId: history.NextId})
+	require.Nil(t, err)
+	require.Len(t, history.Records, 2)
+	require.Equal(t, uint64(0), history.NextId)
+
+	// the delegator was credited every reward they can claim
+	rewardHistory, err := dpos.Contract.GetDelegatorRewardHistory(dposCtx, &GetDelegatorRewardHistoryRequest{
+		Delegator: delegatorAddress1.MarshalPB(),
+		Limit:     maxHistoryPageSize,
+	})
+	require.Nil(t, err)
+	require.True(t, len(rewardHistory.Records) > 0)
+	recorded := common.BigZero()
+	for _, record := range rewardHistory.Records {
+		recorded.Add(recorded, &record.Total.Value)
+	}
+	rewards, err := dpos.CheckDelegatorRewards(pctx, &delegatorAddress1)
+	require.Nil(t, err)
+	require.Equal(t, 0, recorded.Int.Cmp(rewards))
+
+	// slashes are recorded in the election they're applied in
+	require.NoError(t, SlashDoubleSignEvidence(dposCtx, []byte("evidence"), addr1))
+	require.NoError(t, elect(pctx, dpos.Address))
+	history, err = dpos.Contract.GetElectionHistory(dposCtx, &GetElectionHistoryRequest{Limit: 1})
+	require.Nil(t, err)
+	require.Len(t, history.Records, 1)
+	require.Equal(t, uint64(6), history.Records[0].Id)
+	require.True(t, history.Records[0].Validators[0].SlashedAmount.Value.Sign() > 0)
+	require.True(t, history.Records[0].Validators[0].SlashPercentage.Value.Cmp(&doubleSignSlashPercentage) == 0)
+}
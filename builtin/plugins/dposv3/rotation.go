@@ -0,0 +1,449 @@
+package dposv3
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+)
+
+// Since the address of a candidate is derived from its consensus key, rotating the key moves the
+// candidate to a new address. A rotation requested by a candidate is applied in the next election,
+// after the rewards for the last election period have been distributed, at which point everything
+// keyed by the old address (the candidate record, statistics, delegations to & from the candidate,
+// commission limits, pending unbondings, the key rotation nonce & the candidate's reward history)
+// is migrated to the new address, and the new key replaces the old one in the validator set. The
+// contracts in addressMigrationContracts are then asked to migrate their own state. The election
+// records, and the reward records of other delegators, still name the old address since that's the
+// address the candidate had at the time. The old key is retired, it can't be used by any candidate
+// again, but evidence & downtime reported for blocks signed with it is still attributed to the
+// candidate.
+
+var (
+	keyRotationPrefix         = []byte("kr")
+	keyRotationNoncePrefix    = []byte("rkn")
+	retiredValidatorKeyPrefix = []byte("rvk")
+
+	errKeyRotationConflict = errors.New("New key is already in use.")
+
+	// Contracts that keep state keyed by validator addresses, each of them must implement a
+	// MigrateValidatorAddress method that accepts a MigrateValidatorAddressRequest from DPOS.
+	addressMigrationContracts = []string{governance.ContractName, "liquid-staking"}
+)
+
+func keyRotationKey(candidate loom.Address) []byte {
+	return util.PrefixKey(keyRotationPrefix, candidate.Bytes())
+}
+
+func keyRotationNonceKey(candidate loom.Address) []byte {
+	return util.PrefixKey(keyRotationNoncePrefix, candidate.Bytes())
+}
+
+func loadKeyRotationNonce(ctx contract.StaticContext, candidate loom.Address) (uint64, error) {
+	var nonce ValidatorKeyRotationNonce
+	if err := ctx.Get(keyRotationNonceKey(candidate), &nonce); err != nil {
+		if err == contract.ErrNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "failed to load key rotation nonce")
+	}
+	return nonce.Nonce, nil
+}
+
+// RotateValidatorKeySignBytes returns the bytes the new key must sign to prove the candidate
+// controls it. The signature is bound to the chain, the candidate, the new key & the candidate's
+// key rotation nonce, so it can't be used for another rotation, or replayed on another chain.
+func RotateValidatorKeySignBytes(chainID string, candidate loom.Address, newPubKey []byte, nonce uint64) []byte {
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, nonce)
+	h := sha256.New()
+	h.Write([]byte("loom:dposv3:rotate-key"))
+	for _, field := range [][]byte{[]byte(chainID), candidate.Local, newPubKey, nonceBytes} {
+		lenBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(lenBytes, uint64(len(field)))
+		h.Write(lenBytes)
+		h.Write(field)
+	}
+	return h.Sum(nil)
+}
+
+// Retired keys are indexed by their tendermint address, which is what evidence & votes identify
+// validators by.
+func retiredValidatorKeyKey(tendermintAddress loom.LocalAddress) []byte {
+	return util.PrefixKey(retiredValidatorKeyPrefix, tendermintAddress)
+}
+
+// Returns the address of the candidate that signed blocks with the retired key that has the given
+// tendermint address, following the key through any subsequent rotations.
+func getCandidateAddressFromRetiredKey(
+	ctx contract.StaticContext, tendermintAddress loom.LocalAddress, cl []*Candidate,
+) (loom.Address, error) {
+	for {
+		var retired RetiredValidatorKey
+		if err := ctx.Get(retiredValidatorKeyKey(tendermintAddress), &retired); err != nil {
+			return loom.Address{}, err
+		}
+		candidateAddress := loom.UnmarshalAddressPB(retired.Candidate)
+		for _, candidate := range cl {
+			if candidateAddress.Compare(loom.UnmarshalAddressPB(candidate.Address)) == 0 {
+				return candidateAddress, nil
+			}
+		}
+		tendermintAddress = loom.LocalAddressFromPublicKeyV2(retired.NewPubKey)
+	}
+}
+
+// RotateValidatorKey schedules the replacement of the sender's consensus key in the next election,
+// replacing any rotation that's already pending. The request must be signed by the new key, see
+// RotateValidatorKeyRequest.
+func (c *DPOS) RotateValidatorKey(ctx contract.Context, req *RotateValidatorKeyRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+		return errors.New("DPOS v3.16 is not enabled")
+	}
+
+	candidateAddress := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 RotateValidatorKey", "candidate", candidateAddress, "request", req)
+
+	if len(req.NewPubKey) != ed25519.PublicKeySize {
+		return logDposError(ctx, errors.New("Invalid public key."), req.String())
+	}
+	nonce, err := loadKeyRotationNonce(ctx, candidateAddress)
+	if err != nil {
+		return err
+	}
+	signBytes := RotateValidatorKeySignBytes(ctx.Block().ChainID, candidateAddress, req.NewPubKey, nonce)
+	if !ed25519.Verify(req.NewPubKey, signBytes, req.Signature) {
+		return logDposError(ctx, errors.New("Invalid signature, the rotation must be signed by the new key."), req.String())
+	}
+
+	candidates, err := LoadCandidateList(ctx)
+	if err != nil {
+		return err
+	}
+	cand := candidates.Get(candidateAddress)
+	if cand == nil {
+		return errCandidateNotFound
+	}
+	if cand.State == UNREGISTERING {
+		return logDposError(ctx, errors.New("Candidate is unregistering."), req.String())
+	}
+
+	newAddress := loom.Address{ChainID: ctx.Block().ChainID, Local: loom.LocalAddressFromPublicKey(req.NewPubKey)}
+	if newAddress.Compare(candidateAddress) == 0 || candidates.Get(newAddress) != nil {
+		return logDposError(ctx, errKeyRotationConflict, req.String())
+	}
+	if ctx.Has(retiredValidatorKeyKey(loom.LocalAddressFromPublicKeyV2(req.NewPubKey))) {
+		return logDposError(ctx, errKeyRotationConflict, req.String())
+	}
+	// The statistics, delegations & reward history of the candidate are migrated to the new address,
+	// so it mustn't have any of its own.
+	if ctx.Has(rewardHistoryCounterKey(newAddress)) {
+		return logDposError(ctx, errKeyRotationConflict, req.String())
+	}
+	statistic, err := GetStatistic(ctx, newAddress)
+	if err != nil && err != contract.ErrNotFound {
+		return err
+	}
+	if statistic != nil {
+		return logDposError(ctx, errKeyRotationConflict, req.String())
+	}
+	delegations, err := loadDelegationList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range delegations {
+		if newAddress.Compare(loom.UnmarshalAddressPB(d.Validator)) == 0 ||
+			newAddress.Compare(loom.UnmarshalAddressPB(d.Delegator)) == 0 {
+			return logDposError(ctx, errKeyRotationConflict, req.String())
+		}
+	}
+
+	if err := ctx.Set(keyRotationNonceKey(candidateAddress), &ValidatorKeyRotationNonce{Nonce: nonce + 1}); err != nil {
+		return err
+	}
+	return ctx.Set(keyRotationKey(candidateAddress), &ValidatorKeyRotation{
+		Candidate:     candidateAddress.MarshalPB(),
+		NewPubKey:     req.NewPubKey,
+		RequestedTime: ctx.Now().Unix(),
+	})
+}
+
+// GetValidatorKeyRotation returns the pending key rotation of a candidate.
+func (c *DPOS) GetValidatorKeyRotation(
+	ctx contract.StaticContext, req *GetValidatorKeyRotationRequest,
+) (*GetValidatorKeyRotationResponse, error) {
+	if req.Candidate == nil {
+		return nil, logStaticDposError(ctx, errors.New("GetValidatorKeyRotation called with req.Candidate == nil"), req.String())
+	}
+
+	candidate := loom.UnmarshalAddressPB(req.Candidate)
+	nonce, err := loadKeyRotationNonce(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+
+	var rotation ValidatorKeyRotation
+	err = ctx.Get(keyRotationKey(candidate), &rotation)
+	if err == contract.ErrNotFound {
+		return &GetValidatorKeyRotationResponse{Nonce: nonce}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &GetValidatorKeyRotationResponse{Rotation: &rotation, Nonce: nonce}, nil
+}
+
+// Applies all the pending key rotations, the validator addresses in the election results are
+// updated to match.
+func applyValidatorKeyRotations(
+	ctx contract.Context, cachedDelegations *CachedDposStorage, delegationResults []*DelegationResult,
+) error {
+	var rotations []*ValidatorKeyRotation
+	for _, kv := range ctx.Range(keyRotationPrefix) {
+		var rotation ValidatorKeyRotation
+		if err := proto.Unmarshal(kv.Value, &rotation); err != nil {
+			return errors.Wrap(err, "failed to unmarshal key rotation")
+		}
+		rotations = append(rotations, &rotation)
+	}
+	if len(rotations) == 0 {
+		return nil
+	}
+
+	candidates, err := LoadCandidateList(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, rotation := range rotations {
+		oldAddress := loom.UnmarshalAddressPB(rotation.Candidate)
+		newAddress := loom.Address{ChainID: ctx.Block().ChainID, Local: loom.LocalAddressFromPublicKey(rotation.NewPubKey)}
+		ctx.Delete(keyRotationKey(oldAddress))
+
+		// The candidate may have unregistered, or another candidate may have claimed the key, since
+		// the rotation was requested.
+		cand := candidates.Get(oldAddress)
+		if cand == nil || cand.State == UNREGISTERING || candidates.Get(newAddress) != nil {
+			ctx.Logger().Info("DPOSv3 skipping key rotation", "candidate", oldAddress, "newAddress", newAddress)
+			continue
+		}
+
+		if err := migrateCandidateAddress(ctx, cachedDelegations, oldAddress, newAddress); err != nil {
+			return err
+		}
+
+		oldPubKey := cand.PubKey
+		cand.Address = newAddress.MarshalPB()
+		cand.PubKey = rotation.NewPubKey
+		for _, res := range delegationResults {
+			if res.ValidatorAddress.Compare(oldAddress) == 0 {
+				res.ValidatorAddress = newAddress
+			}
+		}
+
+		retired := &RetiredValidatorKey{
+			PubKey:      oldPubKey,
+			Candidate:   newAddress.MarshalPB(),
+			NewPubKey:   rotation.NewPubKey,
+			BlockHeight: ctx.Block().Height,
+		}
+		if err := ctx.Set(retiredValidatorKeyKey(loom.LocalAddressFromPublicKeyV2(oldPubKey)), retired); err != nil {
+			return err
+		}
+
+		if err := emitValidatorKeyRotatedEvent(ctx, oldAddress, newAddress, oldPubKey, rotation.NewPubKey); err != nil {
+			return err
+		}
+	}
+
+	return saveCandidateList(ctx, candidates)
+}
+
+// Moves all the contract state keyed by a candidate's address to a new address.
+func migrateCandidateAddress(
+	ctx contract.Context, cachedDelegations *CachedDposStorage, oldAddress, newAddress loom.Address,
+) error {
+	statistic, err := GetStatistic(ctx, oldAddress)
+	if err != nil && err != contract.ErrNotFound {
+		return err
+	}
+	if statistic != nil {
+		statistic.Address = newAddress.MarshalPB()
+		if err := SetStatistic(ctx, statistic); err != nil {
+			return err
+		}
+		if err := deleteStatistic(ctx, oldAddress); err != nil {
+			return err
+		}
+	}
+
+	limits, err := loadCommissionLimits(ctx, oldAddress)
+	if err != nil {
+		return err
+	}
+	if limits != nil {
+		if err := saveCommissionLimits(ctx, newAddress, limits); err != nil {
+			return err
+		}
+		ctx.Delete(commissionLimitsKey(oldAddress))
+	}
+
+	nonce, err := loadKeyRotationNonce(ctx, oldAddress)
+	if err != nil {
+		return err
+	}
+	if nonce > 0 {
+		if err := ctx.Set(keyRotationNonceKey(newAddress), &ValidatorKeyRotationNonce{Nonce: nonce}); err != nil {
+			return err
+		}
+		ctx.Delete(keyRotationNonceKey(oldAddress))
+	}
+
+	if err := migrateRewardHistory(ctx, oldAddress, newAddress); err != nil {
+		return err
+	}
+
+	delegations, err := cachedDelegations.loadDelegationList(ctx)
+	if err != nil {
+		return err
+	}
+	// The delegation list is rewritten once all the delegations have been migrated
+	var migrated = make(DelegationList, 0, len(delegations))
+	var changed bool
+	for _, d := range delegations {
+		delegation, err := GetDelegation(ctx, d.Index, *d.Validator, *d.Delegator)
+		if err == contract.ErrNotFound {
+			migrated = append(migrated, d)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		validator := migratedAddress(delegation.Validator, oldAddress, newAddress)
+		delegator := migratedAddress(delegation.Delegator, oldAddress, newAddress)
+		updateValidator := migratedAddress(delegation.UpdateValidator, oldAddress, newAddress)
+		if validator == delegation.Validator && delegator == delegation.Delegator {
+			if updateValidator != delegation.UpdateValidator {
+				delegation.UpdateValidator = updateValidator
+				if err := saveDelegationRecord(ctx, delegation); err != nil {
+					return err
+				}
+			}
+			migrated = append(migrated, d)
+			continue
+		}
+
+		unbonding, err := loadDelegationUnbonding(ctx, delegation)
+		if err != nil {
+			return err
+		}
+		if unbonding != nil {
+			deleteUnbondingEntry(ctx, unbonding)
+		}
+		if err := deleteDelegationRecord(ctx, delegation); err != nil {
+			return err
+		}
+
+		delegation.Validator = validator
+		delegation.Delegator = delegator
+		delegation.UpdateValidator = updateValidator
+		if err := saveDelegationRecord(ctx, delegation); err != nil {
+			return err
+		}
+		migrated = append(migrated, &DelegationIndex{
+			Validator: validator,
+			Delegator: delegator,
+			Index:     delegation.Index,
+		})
+		changed = true
+
+		if unbonding != nil {
+			unbonding.Validator = validator
+			unbonding.Delegator = delegator
+			if err := saveUnbondingEntry(ctx, unbonding); err != nil {
+				return err
+			}
+		}
+	}
+	if changed {
+		if err := cachedDelegations.SaveDelegationList(ctx, migrated); err != nil {
+			return err
+		}
+	}
+
+	req := &MigrateValidatorAddressRequest{
+		OldAddress: oldAddress.MarshalPB(),
+		NewAddress: newAddress.MarshalPB(),
+	}
+	for _, name := range addressMigrationContracts {
+		contractAddr, err := ctx.Resolve(name)
+		if err != nil {
+			// the contract isn't deployed on this chain
+			continue
+		}
+		if err := contract.CallMethod(ctx, contractAddr, "MigrateValidatorAddress", req, nil); err != nil {
+			return errors.Wrapf(err, "failed to migrate validator address in %s contract", name)
+		}
+	}
+	return nil
+}
+
+// Moves the reward records of a delegator to a new address, the new address mustn't have any
+// reward records of its own.
+func migrateRewardHistory(ctx contract.Context, oldAddress, newAddress loom.Address) error {
+	var counter HistoryCounter
+	if err := ctx.Get(rewardHistoryCounterKey(oldAddress), &counter); err != nil {
+		if err == contract.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	for id := uint64(1); id <= counter.LastId; id++ {
+		var record DelegatorRewardRecord
+		if err := ctx.Get(rewardRecordKey(oldAddress, id), &record); err != nil {
+			return errors.Wrapf(err, "failed to load reward record %d", id)
+		}
+		if err := ctx.Set(rewardRecordKey(newAddress, id), &record); err != nil {
+			return err
+		}
+		ctx.Delete(rewardRecordKey(oldAddress, id))
+	}
+	if err := ctx.Set(rewardHistoryCounterKey(newAddress), &counter); err != nil {
+		return err
+	}
+	ctx.Delete(rewardHistoryCounterKey(oldAddress))
+	return nil
+}
+
+// Returns newAddress if addr is oldAddress, otherwise addr is returned unchanged.
+func migratedAddress(addr *types.Address, oldAddress, newAddress loom.Address) *types.Address {
+	if addr != nil && oldAddress.Compare(loom.UnmarshalAddressPB(addr)) == 0 {
+		return newAddress.MarshalPB()
+	}
+	return addr
+}
+
+func emitValidatorKeyRotatedEvent(
+	ctx contract.Context, oldAddress, newAddress loom.Address, oldPubKey, newPubKey []byte,
+) error {
+	marshalled, err := proto.Marshal(&DposValidatorKeyRotatedEvent{
+		OldAddress:  oldAddress.MarshalPB(),
+		NewAddress:  newAddress.MarshalPB(),
+		OldPubKey:   oldPubKey,
+		NewPubKey:   newPubKey,
+		BlockHeight: ctx.Block().Height,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, ValidatorKeyRotatedEventTopic)
+	return nil
+}
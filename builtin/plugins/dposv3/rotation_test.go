@@ -0,0 +1,239 @@
+package dposv3
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestRotateValidatorKey(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_1, true)
+	pctx.SetFeature(features.DPOSVersion3_16, true)
+	coinAddr := pctx.CreateContract(coin.Contract)
+
+	coinContract := &coin.Coin{}
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 100000000),
+			makeAccount(addr1, 100000000),
+		},
+	})
+
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:      10,
+		CoinContractAddress: coinAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	candidateCtx := contractpb.WrapPluginContext(pctx.WithSender(addr1).WithAddress(dpos.Address))
+
+	registrationFee := &types.BigUInt{Value: *scientificNotation(defaultRegistrationRequirement, tokenDecimals)}
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(addr1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  registrationFee,
+	})
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	delegationAmount := big.NewInt(1e18)
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+	})
+	require.Nil(t, err)
+	err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &addr1, delegationAmount, nil, nil)
+	require.Nil(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	newPubKey, newPrivKey, err := ed25519.GenerateKey(nil)
+	require.Nil(t, err)
+	newAddr := loom.Address{ChainID: addr1.ChainID, Local: loom.LocalAddressFromPublicKey(newPubKey)}
+
+	// the request must be signed by the new key, and the signature must be bound to the chain, the
+	// candidate & the candidate's rotation nonce
+	for _, signBytes := range [][]byte{
+		addr1.Local,
+		RotateValidatorKeySignBytes("other-chain", addr1, newPubKey, 0),
+		RotateValidatorKeySignBytes(chainID, delegatorAddress1, newPubKey, 0),
+		RotateValidatorKeySignBytes(chainID, addr1, newPubKey, 1),
+	} {
+		err = dpos.Contract.RotateValidatorKey(candidateCtx, &RotateValidatorKeyRequest{
+			NewPubKey: newPubKey,
+			Signature: ed25519.Sign(newPrivKey, signBytes),
+		})
+		require.Error(t, err)
+	}
+
+	rotateReq := &RotateValidatorKeyRequest{
+		NewPubKey: newPubKey,
+		Signature: ed25519.Sign(newPrivKey, RotateValidatorKeySignBytes(chainID, addr1, newPubKey, 0)),
+	}
+	require.NoError(t, dpos.Contract.RotateValidatorKey(candidateCtx, rotateReq))
+	rotation, err := dpos.Contract.GetValidatorKeyRotation(candidateCtx, &GetValidatorKeyRotationRequest{
+		Candidate: addr1.MarshalPB(),
+	})
+	require.Nil(t, err)
+	require.NotNil(t, rotation.Rotation)
+	require.Equal(t, uint64(1), rotation.Nonce)
+	// the signature can't be replayed once the nonce has been used
+	require.Error(t, dpos.Contract.RotateValidatorKey(candidateCtx, rotateReq))
+
+	// nothing changes until the next election
+	validators, err := dpos.ListValidators(pctx)
+	require.Nil(t, err)
+	require.Len(t, validators, 1)
+	require.Equal(t, 0, loom.UnmarshalAddressPB(validators[0].Address).Compare(addr1))
+
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	candidates, err := dpos.ListCandidates(pctx)
+	require.Nil(t, err)
+	require.Len(t, candidates, 1)
+	require.Equal(t, 0, loom.UnmarshalAddressPB(candidates[0].Candidate.Address).Compare(newAddr))
+	require.Equal(t, []byte(newPubKey), candidates[0].Candidate.PubKey)
+
+	validatorList, err := ValidatorList(contractpb.WrapPluginContext(pctx.WithAddress(dpos.Address)))
+	require.Nil(t, err)
+	require.Len(t, validatorList, 1)
+	require.Equal(t, []byte(newPubKey), validatorList[0].PubKey)
+
+	// delegations to & from the candidate have been migrated
+	delegations, amount, _, err := dpos.CheckDelegation(pctx, &newAddr, &delegatorAddress1)
+	require.Nil(t, err)
+	require.True(t, len(delegations) > 0)
+	// the amount includes any rewards earned by the delegator
+	require.True(t, amount.Cmp(delegationAmount) >= 0)
+	delegations, _, _, err = dpos.CheckDelegation(pctx, &addr1, &delegatorAddress1)
+	require.Nil(t, err)
+	require.Len(t, delegations, 0)
+	delegations, _, _, err = dpos.CheckAllDelegations(pctx, &newAddr)
+	require.Nil(t, err)
+	require.True(t, len(delegations) > 0)
+
+	rotation, err = dpos.Contract.GetValidatorKeyRotation(candidateCtx, &GetValidatorKeyRotationRequest{
+		Candidate: addr1.MarshalPB(),
+	})
+	require.Nil(t, err)
+	require.Nil(t, rotation.Rotation)
+	require.Equal(t, uint64(0), rotation.Nonce)
+	// the nonce moves to the new address along with the candidate
+	rotation, err = dpos.Contract.GetValidatorKeyRotation(candidateCtx, &GetValidatorKeyRotationRequest{
+		Candidate: newAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), rotation.Nonce)
+
+	// blocks signed with the old key are still attributed to the candidate
+	staticCtx := contractpb.WrapPluginContext(pctx.WithAddress(dpos.Address))
+	cl, err := LoadCandidateList(staticCtx)
+	require.Nil(t, err)
+	address, err := GetLocalCandidateAddressFromTendermintAddress(
+		staticCtx, loom.LocalAddressFromPublicKeyV2(pubKey1), cl,
+	)
+	require.Nil(t, err)
+	require.Equal(t, 0, address.Compare(newAddr))
+}
+
+func TestRotateValidatorKeyConflicts(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_16, true)
+	oraclePubKey, _ := hex.DecodeString(validatorPubKeyHex2)
+	oracleAddr := loom.Address{
+		Local: loom.LocalAddressFromPublicKey(oraclePubKey),
+	}
+
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(addr1, 1000000000000000000),
+			makeAccount(delegatorAddress1, 100000000),
+		},
+	})
+
+	registrationFee := &types.BigUInt{Value: *loom.NewBigUIntFromInt(100)}
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:          21,
+		RegistrationRequirement: registrationFee,
+		OracleAddress:           oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	dposCtx := pctx.WithAddress(dpos.Address)
+
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(addr1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  registrationFee,
+	})
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(dposCtx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	delegationAmount := big.NewInt(100)
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+	})
+	require.Nil(t, err)
+	err = dpos.Delegate(dposCtx.WithSender(delegatorAddress1), &addr1, delegationAmount, nil, nil)
+	require.Nil(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	// failed requests don't use up the rotation nonce
+	rotateReq := func(newPrivKey ed25519.PrivateKey) *RotateValidatorKeyRequest {
+		newPubKey := newPrivKey.Public().(ed25519.PublicKey)
+		return &RotateValidatorKeyRequest{
+			NewPubKey: newPubKey,
+			Signature: ed25519.Sign(newPrivKey, RotateValidatorKeySignBytes(chainID, addr1, newPubKey, 0)),
+		}
+	}
+
+	// the new address mustn't have any statistics of its own
+	newPubKey, newPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	newAddr := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(newPubKey)}
+	err = dpos.WhitelistCandidate(dposCtx.WithSender(oracleAddr), newAddr, big.NewInt(1000000000000), 0)
+	require.Nil(t, err)
+	err = dpos.Contract.RotateValidatorKey(
+		contractpb.WrapPluginContext(dposCtx.WithSender(addr1)), rotateReq(newPrivKey),
+	)
+	require.Equal(t, errKeyRotationConflict, err)
+
+	newPubKey, newPrivKey, err = ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	newAddr = loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(newPubKey)}
+	err = dpos.Contract.RotateValidatorKey(
+		contractpb.WrapPluginContext(dposCtx.WithSender(addr1)), rotateReq(newPrivKey),
+	)
+	require.Nil(t, err)
+
+	pctx.SetTime(pctx.Now().Add(time.Duration(1) * time.Second))
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	// the delegation has moved to the new address
+	delegations, _, _, err := dpos.CheckDelegation(pctx, &addr1, &delegatorAddress1)
+	require.Nil(t, err)
+	require.Len(t, delegations, 0)
+	delegations, _, _, err = dpos.CheckDelegation(pctx, &newAddr, &delegatorAddress1)
+	require.Nil(t, err)
+	require.Len(t, delegations, 1)
+	require.True(t, delegations[0].Amount.Value.Cmp(loom.NewBigUInt(delegationAmount)) == 0)
+	allDelegations, err := dpos.ListAllDelegations(pctx)
+	require.Nil(t, err)
+	for _, resp := range allDelegations {
+		for _, d := range resp.Delegations {
+			require.NotEqual(t, 0, loom.UnmarshalAddressPB(d.Validator).Compare(addr1))
+		}
+	}
+}
@@ -2,6 +2,7 @@ package dposv3
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"sort"
@@ -30,12 +31,27 @@ var (
 	requestBatchTallyKey   = []byte("request_batch_tally")
 	deprecatedReferrersKey = []byte("referrers")
 	referrerPrefix         = []byte("rf")
+	// Used to track double-sign evidence that has already been processed
+	doubleSignEvidencePrefix = []byte("dse")
+	commissionLimitsPrefix   = []byte("cml")
+
+	validatorDelegationsPrefix = []byte("dv")
+	delegatorDelegationsPrefix = []byte("dd")
+	delegationIndicesKey       = []byte("dix")
 )
 
 func referrerKey(referrerName string) []byte {
 	return util.PrefixKey([]byte(referrerPrefix), []byte(referrerName))
 }
 
+func doubleSignEvidenceKey(evidenceHash []byte) []byte {
+	return util.PrefixKey(doubleSignEvidencePrefix, evidenceHash)
+}
+
+func commissionLimitsKey(candidate loom.Address) []byte {
+	return util.PrefixKey(commissionLimitsPrefix, candidate.Bytes())
+}
+
 func sortValidators(validators []*Validator) []*Validator {
 	sort.Sort(byPubkey(validators))
 	return validators
@@ -146,6 +162,9 @@ func (c *CachedDposStorage) SetDelegation(ctx contract.Context, delegation *Dele
 		if err := c.SaveDelegationList(ctx, delegations); err != nil {
 			return err
 		}
+		if err := indexDelegation(ctx, delegationIndex); err != nil {
+			return err
+		}
 	}
 
 	delegationKey, err := computeDelegationsKey(delegationIndex.Index, *delegation.Validator, *delegation.Delegator)
@@ -188,10 +207,108 @@ func (c *CachedDposStorage) DeleteDelegation(ctx contract.Context, delegation *D
 	}
 
 	ctx.Delete(append(delegationsKey, delegationKey...))
+	unindexDelegation(ctx, delegation)
+
+	return nil
+}
+
+// Stores a delegation without adding it to the delegation list, the caller is responsible for
+// keeping the list in sync.
+func saveDelegationRecord(ctx contract.Context, delegation *Delegation) error {
+	delegationKey, err := computeDelegationsKey(delegation.Index, *delegation.Validator, *delegation.Delegator)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Set(append(delegationsKey, delegationKey...), delegation); err != nil {
+		return err
+	}
+	return indexDelegation(ctx, &DelegationIndex{
+		Validator: delegation.Validator,
+		Delegator: delegation.Delegator,
+		Index:     delegation.Index,
+	})
+}
 
+// Removes a delegation without removing it from the delegation list, the caller is responsible
+// for keeping the list in sync.
+func deleteDelegationRecord(ctx contract.Context, delegation *Delegation) error {
+	delegationKey, err := computeDelegationsKey(delegation.Index, *delegation.Validator, *delegation.Delegator)
+	if err != nil {
+		return err
+	}
+	ctx.Delete(append(delegationsKey, delegationKey...))
+	unindexDelegation(ctx, delegation)
 	return nil
 }
 
+// The delegations are indexed by validator & by delegator so that the delegations of a single
+// validator or delegator can be paged through with ctx.Range without loading the delegation list.
+// Within the range of a validator the delegations are ordered by delegator address & delegation
+// index, and within the range of a delegator by validator address & delegation index.
+func validatorDelegationsKey(validator types.Address) []byte {
+	return util.PrefixKey(validatorDelegationsPrefix, validator.Local)
+}
+
+func delegatorDelegationsKey(delegator types.Address) []byte {
+	return util.PrefixKey(delegatorDelegationsPrefix, delegator.Local)
+}
+
+// Builds the key of a delegation within the range of a validator or delegator from the local
+// address of the other party to the delegation & the delegation index.
+func delegationRangeKey(addr types.Address, index uint64) []byte {
+	key := make([]byte, len(addr.Local)+8)
+	copy(key, addr.Local)
+	binary.BigEndian.PutUint64(key[len(addr.Local):], index)
+	return key
+}
+
+func indexDelegation(ctx contract.Context, d *DelegationIndex) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_17, false) {
+		return nil
+	}
+	validatorKey := util.PrefixKey(validatorDelegationsKey(*d.Validator), delegationRangeKey(*d.Delegator, d.Index))
+	if err := ctx.Set(validatorKey, d); err != nil {
+		return err
+	}
+	delegatorKey := util.PrefixKey(delegatorDelegationsKey(*d.Delegator), delegationRangeKey(*d.Validator, d.Index))
+	return ctx.Set(delegatorKey, d)
+}
+
+func unindexDelegation(ctx contract.Context, delegation *Delegation) {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_17, false) {
+		return
+	}
+	ctx.Delete(util.PrefixKey(
+		validatorDelegationsKey(*delegation.Validator), delegationRangeKey(*delegation.Delegator, delegation.Index),
+	))
+	ctx.Delete(util.PrefixKey(
+		delegatorDelegationsKey(*delegation.Delegator), delegationRangeKey(*delegation.Validator, delegation.Index),
+	))
+}
+
+// Indexes the delegations that were created before the delegation indices were enabled, this only
+// needs to be done once.
+func buildDelegationIndices(ctx contract.Context, cachedDelegations *CachedDposStorage) error {
+	if ctx.Has(delegationIndicesKey) {
+		return nil
+	}
+	delegations, err := cachedDelegations.loadDelegationList(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range delegations {
+		if err := indexDelegation(ctx, d); err != nil {
+			return err
+		}
+	}
+	return ctx.Set(delegationIndicesKey, &DelegationIndicesStatus{Built: true})
+}
+
+// Returns true if all the delegations have been indexed by validator & delegator.
+func delegationIndicesBuilt(ctx contract.StaticContext) bool {
+	return ctx.Has(delegationIndicesKey)
+}
+
 func (c *CachedDposStorage) SaveDelegationList(ctx contract.Context, dl DelegationList) error {
 	sorted := sortDelegations(dl)
 	if c.EnableCaching {
@@ -284,6 +401,16 @@ func SetStatistic(ctx contract.Context, statistic *ValidatorStatistic) error {
 	return ctx.Set(append(statisticsKey, addressBytes...), statistic)
 }
 
+func deleteStatistic(ctx contract.Context, address loom.Address) error {
+	addressBytes, err := address.Local.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.Delete(append(statisticsKey, addressBytes...))
+	return nil
+}
+
 func (c *CachedDposStorage) IncreaseRewardDelegation(ctx contract.Context, validator *types.Address, delegator *types.Address, increase loom.BigUInt) error {
 	// check if rewards delegation already exists
 	delegation, err := GetDelegation(ctx, REWARD_DELEGATION_INDEX, *validator, *delegator)
@@ -508,6 +635,11 @@ func GetLocalCandidateAddressFromTendermintAddress(
 		}
 	}
 
+	// The validator may have signed with a key that has since been rotated out
+	if ctx.FeatureEnabled(features.DPOSVersion3_16, false) {
+		return getCandidateAddressFromRetiredKey(ctx, tendermintAddress, cl)
+	}
+
 	return loom.Address{}, contract.ErrNotFound
 }
 
@@ -597,3 +729,22 @@ func loadRequestBatchTally(ctx contract.StaticContext) (*RequestBatchTally, erro
 func saveRequestBatchTally(ctx contract.Context, tally *RequestBatchTally) error {
 	return ctx.Set(requestBatchTallyKey, tally)
 }
+
+// COMMISSION LIMITS
+
+// Returns the commission limits declared by the given candidate, or nil if the candidate hasn't
+// declared any.
+func loadCommissionLimits(ctx contract.StaticContext, candidate loom.Address) (*CommissionLimits, error) {
+	var limits CommissionLimits
+	err := ctx.Get(commissionLimitsKey(candidate), &limits)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func saveCommissionLimits(ctx contract.Context, candidate loom.Address, limits *CommissionLimits) error {
+	return ctx.Set(commissionLimitsKey(candidate), limits)
+}
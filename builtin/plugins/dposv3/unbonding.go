@@ -0,0 +1,437 @@
+package dposv3
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+// The unbonding queue tracks every unbonding requested by a delegator, keyed by the election it
+// completes in. An unbonding is only reflected in the state of the delegation (UNBONDING) during
+// the period leading up to its completion election, before that the delegation remains BONDED and
+// keeps earning rewards. Until the completion election releases the funds the unbonding can be
+// cancelled, which re-bonds the delegation. Elections are numbered from the first election held
+// after the DPOSVersion3_15 feature flag is enabled.
+//
+// Delegations unbonded by the oracle (UnbondAll), by claiming rewards, or by unregistering a
+// candidate are added to the queue as completing in the next election, replacing any unbonding
+// that was previously scheduled for them. Redelegating a delegation drops its scheduled unbonding.
+
+// Maximum number of elections an unbonding can be scheduled ahead of time.
+const maxUnbondingScheduleElections = 100
+
+var (
+	unbondingElectionCounterKey = []byte("ubcount")
+	unbondingQueuePrefix        = []byte("ubq")
+	delegatorUnbondingPrefix    = []byte("ubd")
+
+	errUnbondingPending  = errors.New("An unbonding is already pending for this delegation.")
+	errUnbondingNotFound = errors.New("No pending unbonding found for this delegation.")
+)
+
+func delegationIndexKey(index uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, index)
+	return b
+}
+
+func unbondingQueueElectionKey(election uint64) []byte {
+	return util.PrefixKey(unbondingQueuePrefix, historyID(election))
+}
+
+func unbondingQueueKey(entry *UnbondingEntry) []byte {
+	return util.PrefixKey(
+		unbondingQueueElectionKey(entry.CompletionElection),
+		loom.UnmarshalAddressPB(entry.Delegator).Bytes(),
+		loom.UnmarshalAddressPB(entry.Validator).Bytes(),
+		delegationIndexKey(entry.Index),
+	)
+}
+
+func delegatorUnbondingsKey(delegator *types.Address) []byte {
+	return util.PrefixKey(delegatorUnbondingPrefix, loom.UnmarshalAddressPB(delegator).Bytes())
+}
+
+func delegatorUnbondingKey(delegator, validator *types.Address, index uint64) []byte {
+	return util.PrefixKey(
+		delegatorUnbondingsKey(delegator),
+		loom.UnmarshalAddressPB(validator).Bytes(),
+		delegationIndexKey(index),
+	)
+}
+
+// Returns the number of the last election processed while the unbonding queue was enabled.
+func loadLastUnbondingElection(ctx contract.StaticContext) (uint64, error) {
+	var counter HistoryCounter
+	if err := ctx.Get(unbondingElectionCounterKey, &counter); err != nil && err != contract.ErrNotFound {
+		return 0, err
+	}
+	return counter.LastId, nil
+}
+
+// Returns the pending unbonding of the given delegation, or nil if there's none.
+func loadDelegationUnbonding(ctx contract.StaticContext, delegation *Delegation) (*UnbondingEntry, error) {
+	var entry UnbondingEntry
+	err := ctx.Get(delegatorUnbondingKey(delegation.Delegator, delegation.Validator, delegation.Index), &entry)
+	if err == contract.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Entries are stored both in the queue & under the delegator, so they can be looked up by either
+// completion election or delegator without scanning the whole queue.
+func saveUnbondingEntry(ctx contract.Context, entry *UnbondingEntry) error {
+	if err := ctx.Set(unbondingQueueKey(entry), entry); err != nil {
+		return err
+	}
+	return ctx.Set(delegatorUnbondingKey(entry.Delegator, entry.Validator, entry.Index), entry)
+}
+
+func deleteUnbondingEntry(ctx contract.Context, entry *UnbondingEntry) {
+	ctx.Delete(unbondingQueueKey(entry))
+	ctx.Delete(delegatorUnbondingKey(entry.Delegator, entry.Validator, entry.Index))
+}
+
+func loadUnbondingQueue(ctx contract.StaticContext, election uint64) ([]*UnbondingEntry, error) {
+	var entries []*UnbondingEntry
+	for _, kv := range ctx.Range(unbondingQueueElectionKey(election)) {
+		var entry UnbondingEntry
+		if err := proto.Unmarshal(kv.Value, &entry); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal unbonding entry")
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, nil
+}
+
+// Adds an entry to the queue for a delegation that has just been moved into the UNBONDING state,
+// the unbonding will complete in the next election.
+func enqueueActiveUnbonding(ctx contract.Context, delegation *Delegation) error {
+	lastElection, err := loadLastUnbondingElection(ctx)
+	if err != nil {
+		return err
+	}
+	return saveUnbondingEntry(ctx, &UnbondingEntry{
+		Delegator:          delegation.Delegator,
+		Validator:          delegation.Validator,
+		Index:              delegation.Index,
+		Amount:             delegation.UpdateAmount,
+		CompletionElection: lastElection + 1,
+		RequestedTime:      ctx.Now().Unix(),
+	})
+}
+
+// Replaces the pending unbonding of a delegation that has just been moved into the UNBONDING state
+// outside of Unbond & ScheduleUnbond with an entry that completes in the next election.
+func requeueActiveUnbonding(ctx contract.Context, delegation *Delegation) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_15, false) {
+		return nil
+	}
+	if err := dropDelegationUnbonding(ctx, delegation); err != nil {
+		return err
+	}
+	return enqueueActiveUnbonding(ctx, delegation)
+}
+
+// Removes the pending unbonding of a delegation that's about to be consumed by another state change,
+// e.g. a redelegation, so the entry isn't pushed back every election until the delegation is gone.
+func dropDelegationUnbonding(ctx contract.Context, delegation *Delegation) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_15, false) {
+		return nil
+	}
+	pending, err := loadDelegationUnbonding(ctx, delegation)
+	if err != nil {
+		return err
+	}
+	if pending != nil {
+		deleteUnbondingEntry(ctx, pending)
+	}
+	return nil
+}
+
+// Bumps the election counter, drops the entries of the unbondings that completed in the election
+// that was just held, and moves the delegations whose unbondings complete in the next election
+// into the UNBONDING state. Delegations that can't be unbonded yet, because they're locked or busy
+// with another state change, have their unbondings pushed back to the following election.
+func advanceUnbondingQueue(ctx contract.Context, state *State) error {
+	lastElection, err := loadLastUnbondingElection(ctx)
+	if err != nil {
+		return err
+	}
+	lastElection++
+	if err := ctx.Set(unbondingElectionCounterKey, &HistoryCounter{LastId: lastElection}); err != nil {
+		return err
+	}
+
+	completed, err := loadUnbondingQueue(ctx, lastElection)
+	if err != nil {
+		return err
+	}
+	for _, entry := range completed {
+		deleteUnbondingEntry(ctx, entry)
+	}
+
+	scheduled, err := loadUnbondingQueue(ctx, lastElection+1)
+	if err != nil {
+		return err
+	}
+	now := uint64(ctx.Now().Unix())
+	for _, entry := range scheduled {
+		delegation, err := GetDelegation(ctx, entry.Index, *entry.Validator, *entry.Delegator)
+		if err == contract.ErrNotFound {
+			// the delegation has been fully unbonded or redelegated in the meantime
+			deleteUnbondingEntry(ctx, entry)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		instantUnlock := state.Params.ElectionCycleLength == 0 && delegation.LocktimeTier == TIER_ZERO
+		if !instantUnlock && ctx.FeatureEnabled(features.DPOSVersion3_9, false) {
+			instantUnlock = state.Params.IgnoreUnbondLocktime
+		}
+		if delegation.State != BONDED || (delegation.LockTime > now && !instantUnlock) {
+			deleteUnbondingEntry(ctx, entry)
+			entry.CompletionElection++
+			if err := saveUnbondingEntry(ctx, entry); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// The delegation may have been slashed since the unbonding was scheduled
+		amount := entry.Amount.Value
+		if common.IsZero(amount) || amount.Cmp(&delegation.Amount.Value) > 0 {
+			amount = delegation.Amount.Value
+		}
+		delegation.State = UNBONDING
+		delegation.UpdateAmount = &types.BigUInt{Value: amount}
+		if err := SetDelegation(ctx, delegation); err != nil {
+			return err
+		}
+		entry.Amount = delegation.UpdateAmount
+		if err := saveUnbondingEntry(ctx, entry); err != nil {
+			return err
+		}
+		if err := emitDelegatorUnbondsEvent(ctx, delegation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the estimated time of the given election, assuming all the elections between now and
+// then are held on schedule.
+func estimateElectionTime(state *State, lastElection, election uint64) int64 {
+	if election <= lastElection {
+		return state.LastElectionTime
+	}
+	return state.LastElectionTime + int64(election-lastElection)*state.Params.ElectionCycleLength
+}
+
+// ScheduleUnbond queues the unbonding of a delegation so that the amount is released in the given
+// number of elections from now. The delegation remains bonded until the election before that.
+func (c *DPOS) ScheduleUnbond(ctx contract.Context, req *ScheduleUnbondRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_15, false) {
+		return errors.New("DPOS v3.15 is not enabled")
+	}
+
+	delegator := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 ScheduleUnbond", "delegator", delegator, "request", req)
+
+	if req.ValidatorAddress == nil {
+		return logDposError(ctx, errors.New("ScheduleUnbond called with req.ValidatorAddress == nil"), req.String())
+	} else if req.Amount == nil {
+		return logDposError(ctx, errors.New("ScheduleUnbond called with req.Amount == nil"), req.String())
+	} else if req.Elections > maxUnbondingScheduleElections {
+		return logDposError(
+			ctx,
+			fmt.Errorf("Unbonding can't be scheduled more than %d elections ahead.", maxUnbondingScheduleElections),
+			req.String(),
+		)
+	}
+
+	if req.Elections <= 1 {
+		return c.Unbond(ctx, &UnbondRequest{
+			ValidatorAddress: req.ValidatorAddress,
+			Amount:           req.Amount,
+			Index:            req.Index,
+		})
+	}
+
+	delegation, err := GetDelegation(ctx, req.Index, *req.ValidatorAddress, *delegator.MarshalPB())
+	if err == contract.ErrNotFound {
+		return logDposError(ctx, errors.New(fmt.Sprintf("delegation not found: %s %s", req.ValidatorAddress, delegator.MarshalPB())), req.String())
+	} else if err != nil {
+		return errors.Wrap(err, "failed to load delegation")
+	}
+
+	if delegation.Amount.Value.Cmp(&req.Amount.Value) < 0 {
+		return logDposError(ctx, errors.New("Unbond amount exceeds delegation amount."), req.String())
+	}
+
+	pending, err := loadDelegationUnbonding(ctx, delegation)
+	if err != nil {
+		return err
+	}
+	if pending != nil || delegation.State == UNBONDING {
+		return logDposError(ctx, errUnbondingPending, req.String())
+	}
+
+	lastElection, err := loadLastUnbondingElection(ctx)
+	if err != nil {
+		return err
+	}
+	return saveUnbondingEntry(ctx, &UnbondingEntry{
+		Delegator:          delegation.Delegator,
+		Validator:          delegation.Validator,
+		Index:              delegation.Index,
+		Amount:             req.Amount,
+		CompletionElection: lastElection + req.Elections,
+		RequestedTime:      ctx.Now().Unix(),
+	})
+}
+
+// CancelUnbond removes a pending unbonding from the queue, if the delegation has already been
+// moved into the UNBONDING state it's bonded again. Unbondings can't be cancelled once the funds
+// have been released.
+func (c *DPOS) CancelUnbond(ctx contract.Context, req *CancelUnbondRequest) error {
+	if !ctx.FeatureEnabled(features.DPOSVersion3_15, false) {
+		return errors.New("DPOS v3.15 is not enabled")
+	}
+
+	delegator := ctx.Message().Sender
+	ctx.Logger().Info("DPOSv3 CancelUnbond", "delegator", delegator, "request", req)
+
+	if req.ValidatorAddress == nil {
+		return logDposError(ctx, errors.New("CancelUnbond called with req.ValidatorAddress == nil"), req.String())
+	}
+
+	delegation, err := GetDelegation(ctx, req.Index, *req.ValidatorAddress, *delegator.MarshalPB())
+	if err == contract.ErrNotFound {
+		return logDposError(ctx, errors.New(fmt.Sprintf("delegation not found: %s %s", req.ValidatorAddress, delegator.MarshalPB())), req.String())
+	} else if err != nil {
+		return errors.Wrap(err, "failed to load delegation")
+	}
+
+	entry, err := loadDelegationUnbonding(ctx, delegation)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return logDposError(ctx, errUnbondingNotFound, req.String())
+	}
+
+	lastElection, err := loadLastUnbondingElection(ctx)
+	if err != nil {
+		return err
+	}
+	if entry.CompletionElection == lastElection+1 {
+		if delegation.State != UNBONDING {
+			return logDposError(ctx, errors.New("Existing delegation not in UNBONDING state."), req.String())
+		}
+		delegation.State = BONDED
+		delegation.UpdateAmount = loom.BigZeroPB()
+		if err := SetDelegation(ctx, delegation); err != nil {
+			return err
+		}
+	}
+	deleteUnbondingEntry(ctx, entry)
+
+	return emitDelegatorCancelsUnbondEvent(ctx, entry)
+}
+
+// ListUnbondings returns the pending unbondings of a delegator (or the sender if no delegator is
+// specified), ordered by completion election.
+func (c *DPOS) ListUnbondings(ctx contract.StaticContext, req *ListUnbondingsRequest) (*ListUnbondingsResponse, error) {
+	delegator := ctx.Message().Sender.MarshalPB()
+	if req.Delegator != nil {
+		delegator = req.Delegator
+	}
+
+	state, err := LoadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastElection, err := loadLastUnbondingElection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var unbondings []*UnbondingEntry
+	for _, kv := range ctx.Range(delegatorUnbondingsKey(delegator)) {
+		var entry UnbondingEntry
+		if err := proto.Unmarshal(kv.Value, &entry); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal unbonding entry")
+		}
+		entry.MaturityTime = estimateElectionTime(state, lastElection, entry.CompletionElection)
+		unbondings = append(unbondings, &entry)
+	}
+	sort.SliceStable(unbondings, func(i, j int) bool {
+		return unbondings[i].CompletionElection < unbondings[j].CompletionElection
+	})
+
+	return &ListUnbondingsResponse{
+		Unbondings:   unbondings,
+		LastElection: lastElection,
+	}, nil
+}
+
+// GetUnbondingQueue returns the unbondings that complete in the given election.
+func (c *DPOS) GetUnbondingQueue(
+	ctx contract.StaticContext, req *GetUnbondingQueueRequest,
+) (*GetUnbondingQueueResponse, error) {
+	state, err := LoadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lastElection, err := loadLastUnbondingElection(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	election := req.Election
+	if election == 0 {
+		election = lastElection + 1
+	}
+	unbondings, err := loadUnbondingQueue(ctx, election)
+	if err != nil {
+		return nil, err
+	}
+	maturityTime := estimateElectionTime(state, lastElection, election)
+	for _, entry := range unbondings {
+		entry.MaturityTime = maturityTime
+	}
+
+	return &GetUnbondingQueueResponse{
+		Election:   election,
+		Unbondings: unbondings,
+	}, nil
+}
+
+func emitDelegatorCancelsUnbondEvent(ctx contract.Context, entry *UnbondingEntry) error {
+	marshalled, err := proto.Marshal(&DposDelegatorCancelsUnbondEvent{
+		Delegator: entry.Delegator,
+		Validator: entry.Validator,
+		Index:     entry.Index,
+		Amount:    entry.Amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EmitTopics(marshalled, DelegatorCancelsUnbondEventTopic)
+	return nil
+}
@@ -0,0 +1,195 @@
+package dposv3
+
+import (
+	"math/big"
+	"testing"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	types "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnbondingQueue(t *testing.T) {
+	pctx := createCtx()
+	pctx.SetFeature(features.DPOSVersion3_1, true)
+	pctx.SetFeature(features.DPOSVersion3_7, true)
+	pctx.SetFeature(features.DPOSVersion3_15, true)
+	coinAddr := pctx.CreateContract(coin.Contract)
+
+	coinContract := &coin.Coin{}
+	coinCtx := pctx.WithAddress(coinAddr)
+	coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			makeAccount(delegatorAddress1, 100000000),
+			makeAccount(addr1, 100000000),
+		},
+	})
+
+	oracleAddr := addr3
+	dpos, err := deployDPOSContract(pctx, &Params{
+		ValidatorCount:      10,
+		CoinContractAddress: coinAddr.MarshalPB(),
+		OracleAddress:       oracleAddr.MarshalPB(),
+	})
+	require.Nil(t, err)
+	delegatorCtx := contractpb.WrapPluginContext(pctx.WithSender(delegatorAddress1).WithAddress(dpos.Address))
+
+	registrationFee := &types.BigUInt{Value: *scientificNotation(defaultRegistrationRequirement, tokenDecimals)}
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(addr1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  registrationFee,
+	})
+	require.Nil(t, err)
+	err = dpos.RegisterCandidate(pctx.WithSender(addr1), pubKey1, nil, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	delegationAmount := big.NewInt(1e18)
+	err = coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(delegatorAddress1)), &coin.ApproveRequest{
+		Spender: dpos.Address.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *loom.NewBigUInt(delegationAmount)},
+	})
+	require.Nil(t, err)
+	err = dpos.Delegate(pctx.WithSender(delegatorAddress1), &addr1, delegationAmount, nil, nil)
+	require.Nil(t, err)
+	require.NoError(t, elect(pctx, dpos.Address))
+
+	checkDelegation := func() *Delegation {
+		delegations, _, _, err := dpos.CheckDelegation(pctx, &addr1, &delegatorAddress1)
+		require.Nil(t, err)
+		for _, d := range delegations {
+			if d.Index == 1 {
+				return d
+			}
+		}
+		require.FailNow(t, "delegation not found")
+		return nil
+	}
+	balanceOf := func() *big.Int {
+		resp, err := coinContract.BalanceOf(
+			contractpb.WrapPluginContext(coinCtx),
+			&coin.BalanceOfRequest{Owner: delegatorAddress1.MarshalPB()},
+		)
+		require.Nil(t, err)
+		return resp.Balance.Value.Int
+	}
+
+	// schedule the unbonding to complete three elections from now
+	unbondAmount := big.NewInt(4e17)
+	err = dpos.Contract.ScheduleUnbond(delegatorCtx, &ScheduleUnbondRequest{
+		ValidatorAddress: addr1.MarshalPB(),
+		Amount:           &types.BigUInt{Value: *loom.NewBigUInt(unbondAmount)},
+		Index:            1,
+		Elections:        3,
+	})
+	require.Nil(t, err)
+	unbondings, err := dpos.Contract.ListUnbondings(delegatorCtx, &ListUnbondingsRequest{})
+	require.Nil(t, err)
+	require.Len(t, unbondings.Unbondings, 1)
+	require.Equal(t, uint64(4), unbondings.Unbondings[0].CompletionElection)
+	require.Equal(t, BONDED, checkDelegation().State)
+
+	// only one unbonding can be pending at a time
+	require.Error(t, dpos.Unbond(pctx.WithSender(delegatorAddress1), &addr1, unbondAmount, 1))
+
+	// the delegation is moved into the UNBONDING state after the election preceding the completion
+	// election
+	require.NoError(t, elect(pctx, dpos.Address))
+	require.Equal(t, BONDED, checkDelegation().State)
+	require.NoError(t, elect(pctx, dpos.Address))
+	delegation := checkDelegation()
+	require.Equal(t, UNBONDING, delegation.State)
+	require.Equal(t, 0, delegation.UpdateAmount.Value.Int.Cmp(unbondAmount))
+
+	queue, err := dpos.Contract.GetUnbondingQueue(delegatorCtx, &GetUnbondingQueueRequest{})
+	require.Nil(t, err)
+	require.Equal(t, uint64(4), queue.Election)
+	require.Len(t, queue.Unbondings, 1)
+
+	// cancelling the unbonding re-bonds the delegation
+	balance := balanceOf()
+	err = dpos.Contract.CancelUnbond(delegatorCtx, &CancelUnbondRequest{
+		ValidatorAddress: addr1.MarshalPB(),
+		Index:            1,
+	})
+	require.Nil(t, err)
+	delegation = checkDelegation()
+	require.Equal(t, BONDED, delegation.State)
+	unbondings, err = dpos.Contract.ListUnbondings(delegatorCtx, &ListUnbondingsRequest{})
+	require.Nil(t, err)
+	require.Len(t, unbondings.Unbondings, 0)
+	require.NoError(t, elect(pctx, dpos.Address))
+	require.Equal(t, 0, balance.Cmp(balanceOf()))
+	require.Equal(t, 0, delegationAmount.Cmp(checkDelegation().Amount.Value.Int))
+
+	err = dpos.Contract.CancelUnbond(delegatorCtx, &CancelUnbondRequest{
+		ValidatorAddress: addr1.MarshalPB(),
+		Index:            1,
+	})
+	require.Equal(t, errUnbondingNotFound, err)
+
+	// Unbond queues the unbonding for the next election
+	require.NoError(t, dpos.Unbond(pctx.WithSender(delegatorAddress1), &addr1, unbondAmount, 1))
+	unbondings, err = dpos.Contract.ListUnbondings(delegatorCtx, &ListUnbondingsRequest{})
+	require.Nil(t, err)
+	require.Len(t, unbondings.Unbondings, 1)
+	require.Equal(t, unbondings.LastElection+1, unbondings.Unbondings[0].CompletionElection)
+
+	require.NoError(t, elect(pctx, dpos.Address))
+	expectedBalance := new(big.Int).Add(balance, unbondAmount)
+	require.Equal(t, 0, expectedBalance.Cmp(balanceOf()))
+	unbondings, err = dpos.Contract.ListUnbondings(delegatorCtx, &ListUnbondingsRequest{})
+	require.Nil(t, err)
+	require.Len(t, unbondings.Unbondings, 0)
+
+	findUnbonding := func() *UnbondingEntry {
+		unbondings, err := dpos.Contract.ListUnbondings(delegatorCtx, &ListUnbondingsRequest{})
+		require.Nil(t, err)
+		for _, entry := range unbondings.Unbondings {
+			if entry.Index == 1 {
+				return entry
+			}
+		}
+		return nil
+	}
+	scheduleUnbond := func() {
+		err := dpos.Contract.ScheduleUnbond(delegatorCtx, &ScheduleUnbondRequest{
+			ValidatorAddress: addr1.MarshalPB(),
+			Amount:           &types.BigUInt{Value: *loom.NewBigUInt(unbondAmount)},
+			Index:            1,
+			Elections:        3,
+		})
+		require.Nil(t, err)
+	}
+
+	// UnbondAll replaces the scheduled unbonding with one that completes in the next election, and
+	// that unbonding can be cancelled like any other
+	scheduleUnbond()
+	err = dpos.Contract.UnbondAll(
+		contractpb.WrapPluginContext(pctx.WithAddress(dpos.Address).WithSender(oracleAddr)),
+		&UnbondAllRequest{ValidatorAddress: addr1.MarshalPB()},
+	)
+	require.Nil(t, err)
+	entry := findUnbonding()
+	require.NotNil(t, entry)
+	lastElection, err := loadLastUnbondingElection(delegatorCtx)
+	require.Nil(t, err)
+	require.Equal(t, lastElection+1, entry.CompletionElection)
+	require.Equal(t, 0, checkDelegation().Amount.Value.Cmp(&entry.Amount.Value))
+
+	err = dpos.Contract.CancelUnbond(delegatorCtx, &CancelUnbondRequest{
+		ValidatorAddress: addr1.MarshalPB(),
+		Index:            1,
+	})
+	require.Nil(t, err)
+	require.Equal(t, BONDED, checkDelegation().State)
+	require.Nil(t, findUnbonding())
+
+	// redelegating drops the scheduled unbonding
+	scheduleUnbond()
+	limboValidatorAddress := LimboValidatorAddress(contractpb.WrapPluginStaticContext(pctx))
+	require.NoError(t, dpos.Redelegate(pctx.WithSender(delegatorAddress1), &addr1, &limboValidatorAddress, nil, 1, nil, nil))
+	require.Nil(t, findUnbonding())
+}
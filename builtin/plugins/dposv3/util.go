@@ -8,6 +8,7 @@ import (
 	loom "github.com/loomnetwork/go-loom"
 	"github.com/loomnetwork/go-loom/common"
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
 )
 
 var TierMap = map[uint64]LocktimeTier{
@@ -133,3 +134,15 @@ func logStaticDposError(ctx contract.StaticContext, err error, req string) error
 func LimboValidatorAddress(ctx contract.StaticContext) loom.Address {
 	return loom.RootAddress(ctx.Block().ChainID)
 }
+
+// ORACLE
+
+// isOracle checks if the sender of the current message is allowed to call oracle (admin) methods,
+// i.e. the sender is either the oracle, or the Governance contract (if on-chain governance is enabled).
+func isOracle(ctx contract.StaticContext, state *State) bool {
+	sender := ctx.Message().Sender
+	if state.Params.OracleAddress != nil && sender.Compare(loom.UnmarshalAddressPB(state.Params.OracleAddress)) == 0 {
+		return true
+	}
+	return governance.IsSenderGovernance(ctx)
+}
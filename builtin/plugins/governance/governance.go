@@ -0,0 +1,726 @@
+package governance
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	dtypes "github.com/loomnetwork/go-loom/builtin/types/dposv3"
+	"github.com/loomnetwork/go-loom/plugin"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ContractName is the name the Governance contract is registered under.
+	ContractName = "governance"
+
+	// Percentages are specified in basis points.
+	maxPercentage = 10000
+
+	// Limits on the size of proposals.
+	maxDescriptionLength = 2048
+	maxCallArgsSize      = 16 * 1024
+
+	// Number of finished proposals that are kept in the contract state, once this limit is reached
+	// the oldest finished proposals are pruned.
+	maxFinishedProposals = 256
+
+	dposContractName = "dposV3"
+
+	// Proposals that are still being voted on, or waiting for their timelock to expire, are kept
+	// separate from finished proposals so they can be processed at the start of each block without
+	// having to scan through all the proposals ever submitted.
+	activeProposalPrefix   = "ap"
+	finishedProposalPrefix = "fp"
+	// Power delegators had delegated to the validators at the time a proposal was submitted, only
+	// kept while the proposal is active.
+	delegatorPowerPrefix = "dp"
+)
+
+var (
+	// ErrNotAuthorized indicates that a contract method failed because the caller didn't have
+	// the permission to execute that method.
+	ErrNotAuthorized = errors.New("[Governance] not authorized")
+	// ErrInvalidRequest is a generic error that's returned when something is wrong with the
+	// request message, e.g. missing or invalid fields.
+	ErrInvalidRequest = errors.New("[Governance] invalid request")
+	// ErrFeatureNotEnabled is returned if a contract method is called before the feature that
+	// enables it has been activated.
+	ErrFeatureNotEnabled = errors.New("[Governance] feature not enabled")
+	// ErrNoVotingPower is returned when an account that is neither a validator, nor has any stake
+	// delegated to the validators, tries to vote on a proposal, or when an account that doesn't
+	// have the minimum proposer power tries to submit a proposal.
+	ErrNoVotingPower = errors.New("[Governance] sender has no voting power")
+	// ErrProposalNotFound is returned if the requested proposal doesn't exist.
+	ErrProposalNotFound = errors.New("[Governance] proposal not found")
+	// ErrVotingClosed is returned when a vote is cast after the voting period of a proposal ends.
+	ErrVotingClosed = errors.New("[Governance] voting closed")
+	// ErrAlreadyVoted is returned when a validator tries to vote on the same proposal twice.
+	ErrAlreadyVoted = errors.New("[Governance] already voted")
+	// ErrProposalNotExecutable is returned when a proposal that didn't pass, or whose timelock
+	// hasn't expired yet, is executed.
+	ErrProposalNotExecutable = errors.New("[Governance] proposal not executable")
+)
+
+var (
+	paramsKey          = []byte("params")
+	proposalCounterKey = []byte("pcount")
+
+	// Must match the correction DPOSv3 applies to the delegation total of a validator to obtain
+	// the validator power.
+	dposPowerCorrection = big.NewInt(1000000000000)
+)
+
+func activeProposalKey(id uint64) []byte {
+	return util.PrefixKey([]byte(activeProposalPrefix), proposalIDBytes(id))
+}
+
+func finishedProposalKey(id uint64) []byte {
+	return util.PrefixKey([]byte(finishedProposalPrefix), proposalIDBytes(id))
+}
+
+func delegatorPowersKey(id uint64) []byte {
+	return util.PrefixKey([]byte(delegatorPowerPrefix), proposalIDBytes(id))
+}
+
+func delegatorPowerKey(id uint64, delegator loom.Address) []byte {
+	return util.PrefixKey(delegatorPowersKey(id), delegator.Bytes())
+}
+
+// IDs are stored big-endian so ranging over proposals yields them in the order they were submitted.
+func proposalIDBytes(id uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], id)
+	return buf[:]
+}
+
+// Governance contract allows validators & delegators to change the admin settings of other builtin
+// contracts. Validators and delegators submit proposals that carry a contract call, and vote on
+// them, each vote is weighted by the power of the validator (which reflects the stake delegated
+// to it) plus the stake the voter has delegated to the validators. The power of the validators
+// and delegators is snapshotted when a proposal is submitted, so stake that moves while the
+// proposal is being voted on can't be counted again. A delegator that votes takes its share of the
+// power away from the validators it delegated to, so stake is never counted twice. Proposals that
+// reach quorum & are approved by the required percentage of the voting power are executed
+// automatically by the node at the start of the first block after the proposal timelock expires.
+type Governance struct {
+}
+
+func (g *Governance) Meta() (plugin.Meta, error) {
+	return plugin.Meta{
+		Name:    ContractName,
+		Version: "1.0.0",
+	}, nil
+}
+
+func (g *Governance) Init(ctx contract.Context, req *InitRequest) error {
+	if err := validateParams(req.Params); err != nil {
+		return err
+	}
+	return ctx.Set(paramsKey, req.Params)
+}
+
+// Propose submits a new proposal, only validators & delegators of the current validators that have
+// at least the minimum proposer power can submit proposals.
+func (g *Governance) Propose(ctx contract.Context, req *ProposeRequest) (*ProposeResponse, error) {
+	if !ctx.FeatureEnabled(features.GovernanceFeature, false) {
+		return nil, ErrFeatureNotEnabled
+	}
+	if req.Call == nil || req.Call.Contract == nil || req.Call.Method == "" {
+		return nil, errors.Wrap(ErrInvalidRequest, "proposal call not specified")
+	}
+	if len(req.Description) > maxDescriptionLength {
+		return nil, errors.Wrapf(ErrInvalidRequest, "description exceeds %d bytes", maxDescriptionLength)
+	}
+	if len(req.Call.Args) > maxCallArgsSize {
+		return nil, errors.Wrapf(ErrInvalidRequest, "call args exceed %d bytes", maxCallArgsSize)
+	}
+
+	params, err := loadParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sender := ctx.Message().Sender
+	snapshot, err := snapshotPower(ctx)
+	if err != nil {
+		return nil, err
+	}
+	power := validatorPower(snapshot.validators, sender)
+	for _, d := range snapshot.delegated[string(sender.Bytes())] {
+		power += d.Power
+	}
+	if power == 0 || power < params.MinProposerPower {
+		return nil, ErrNoVotingPower
+	}
+
+	var counter ProposalCounter
+	if err := ctx.Get(proposalCounterKey, &counter); err != nil && err != contract.ErrNotFound {
+		return nil, errors.Wrap(err, "failed to load proposal counter")
+	}
+	counter.LastId++
+	if err := ctx.Set(proposalCounterKey, &counter); err != nil {
+		return nil, errors.Wrap(err, "failed to save proposal counter")
+	}
+
+	now := ctx.Now().Unix()
+	proposal := &Proposal{
+		Id:              counter.LastId,
+		Proposer:        sender.MarshalPB(),
+		Description:     req.Description,
+		Call:            req.Call,
+		Status:          ProposalStatus_VOTING,
+		CreatedAt:       now,
+		VotingEndsAt:    now + int64(params.VotingPeriod),
+		TotalPower:      snapshot.totalPower,
+		ValidatorPowers: snapshot.validators,
+	}
+	if err := ctx.Set(activeProposalKey(proposal.Id), proposal); err != nil {
+		return nil, errors.Wrap(err, "failed to save proposal")
+	}
+	for _, delegator := range snapshot.delegators {
+		key := delegatorPowerKey(proposal.Id, delegator)
+		delegated := &DelegatorPowerSnapshot{DelegatedPower: snapshot.delegated[string(delegator.Bytes())]}
+		if err := ctx.Set(key, delegated); err != nil {
+			return nil, errors.Wrap(err, "failed to save delegator power")
+		}
+	}
+	ctx.Logger().Info("Governance proposal submitted",
+		"id", proposal.Id, "proposer", sender.String(),
+		"contract", loom.UnmarshalAddressPB(req.Call.Contract).String(), "method", req.Call.Method,
+	)
+	return &ProposeResponse{ProposalId: proposal.Id}, nil
+}
+
+// Vote records the vote of a validator or delegator on a proposal, votes can't be changed once
+// they're cast.
+func (g *Governance) Vote(ctx contract.Context, req *VoteRequest) error {
+	if !ctx.FeatureEnabled(features.GovernanceFeature, false) {
+		return ErrFeatureNotEnabled
+	}
+
+	sender := ctx.Message().Sender
+	var proposal Proposal
+	if err := ctx.Get(activeProposalKey(req.ProposalId), &proposal); err != nil {
+		if err == contract.ErrNotFound {
+			if ctx.Has(finishedProposalKey(req.ProposalId)) {
+				return ErrVotingClosed
+			}
+			return ErrProposalNotFound
+		}
+		return errors.Wrapf(err, "failed to load proposal %d", req.ProposalId)
+	}
+	if proposal.Status != ProposalStatus_VOTING || ctx.Now().Unix() >= proposal.VotingEndsAt {
+		return ErrVotingClosed
+	}
+	for _, v := range proposal.Votes {
+		if sender.Compare(loom.UnmarshalAddressPB(v.Voter)) == 0 {
+			return ErrAlreadyVoted
+		}
+	}
+
+	power := validatorPower(proposal.ValidatorPowers, sender)
+	var snapshot DelegatorPowerSnapshot
+	if err := ctx.Get(delegatorPowerKey(proposal.Id, sender), &snapshot); err != nil && err != contract.ErrNotFound {
+		return errors.Wrap(err, "failed to load delegator power")
+	}
+	if power == 0 && len(snapshot.DelegatedPower) == 0 {
+		return ErrNoVotingPower
+	}
+
+	// delegators that already voted take their share of the power away from the sender
+	for _, v := range proposal.Votes {
+		for _, d := range v.DelegatedPower {
+			if sender.Compare(loom.UnmarshalAddressPB(d.Validator)) == 0 {
+				power -= d.Power
+			}
+		}
+	}
+	if power < 0 {
+		power = 0
+	}
+
+	// the weighted stake of the delegators may exceed the power of a validator, so the power
+	// delegators take away from a validator is capped by whatever is left of the validator power
+	var delegated []*DelegatedPower
+	for _, d := range snapshot.DelegatedPower {
+		validator := loom.UnmarshalAddressPB(d.Validator)
+		remaining := validatorPower(proposal.ValidatorPowers, validator)
+		for _, v := range proposal.Votes {
+			for _, vd := range v.DelegatedPower {
+				if validator.Compare(loom.UnmarshalAddressPB(vd.Validator)) == 0 {
+					remaining -= vd.Power
+				}
+			}
+		}
+		if d.Power > remaining {
+			d.Power = remaining
+		}
+		if d.Power > 0 {
+			delegated = append(delegated, d)
+		}
+	}
+
+	// and the sender takes its share of the power away from the validators it delegated to
+	vote := &Vote{
+		Voter:          sender.MarshalPB(),
+		Approve:        req.Approve,
+		ValidatorPower: power,
+		DelegatedPower: delegated,
+	}
+	for _, d := range delegated {
+		vote.Power += d.Power
+		for _, v := range proposal.Votes {
+			if loom.UnmarshalAddressPB(v.Voter).Compare(loom.UnmarshalAddressPB(d.Validator)) != 0 {
+				continue
+			}
+			overridden := d.Power
+			if overridden > v.ValidatorPower {
+				overridden = v.ValidatorPower
+			}
+			v.ValidatorPower -= overridden
+			v.Power -= overridden
+			addPower(&proposal, v.Approve, -overridden)
+		}
+	}
+	vote.Power += power
+	proposal.Votes = append(proposal.Votes, vote)
+	addPower(&proposal, vote.Approve, vote.Power)
+	return ctx.Set(activeProposalKey(proposal.Id), &proposal)
+}
+
+func addPower(proposal *Proposal, approve bool, power int64) {
+	if approve {
+		proposal.ApprovePower += power
+	} else {
+		proposal.RejectPower += power
+	}
+}
+
+func (g *Governance) GetProposal(
+	ctx contract.StaticContext, req *GetProposalRequest,
+) (*GetProposalResponse, error) {
+	proposal, err := loadProposal(ctx, req.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	return &GetProposalResponse{Proposal: proposal}, nil
+}
+
+// ListProposals returns all the proposals, in the order they were submitted.
+func (g *Governance) ListProposals(
+	ctx contract.StaticContext, req *ListProposalsRequest,
+) (*ListProposalsResponse, error) {
+	finished, err := listProposals(ctx, finishedProposalPrefix)
+	if err != nil {
+		return nil, err
+	}
+	active, err := listProposals(ctx, activeProposalPrefix)
+	if err != nil {
+		return nil, err
+	}
+	proposals := append(finished, active...)
+	sort.Slice(proposals, func(i, j int) bool { return proposals[i].Id < proposals[j].Id })
+	return &ListProposalsResponse{Proposals: proposals}, nil
+}
+
+// MigrateValidatorAddress is called by the DPOSv3 contract when a validator's key is rotated, which
+// moves the validator to a new address. The validator's power, votes & delegated power in the
+// active proposals are moved to the new address, finished proposals are left as they were.
+func (g *Governance) MigrateValidatorAddress(ctx contract.Context, req *MigrateValidatorAddressRequest) error {
+	dposAddr, err := ctx.Resolve(dposContractName)
+	if err != nil || ctx.Message().Sender.Compare(dposAddr) != 0 {
+		return ErrNotAuthorized
+	}
+	if req.OldAddress == nil || req.NewAddress == nil {
+		return ErrInvalidRequest
+	}
+	oldAddr := loom.UnmarshalAddressPB(req.OldAddress)
+	newAddr := loom.UnmarshalAddressPB(req.NewAddress)
+
+	proposals, err := listProposals(ctx, activeProposalPrefix)
+	if err != nil {
+		return err
+	}
+	for _, proposal := range proposals {
+		proposal.Proposer = migratedAddress(proposal.Proposer, oldAddr, newAddr)
+		for _, v := range proposal.ValidatorPowers {
+			v.Validator = migratedAddress(v.Validator, oldAddr, newAddr)
+		}
+		for _, v := range proposal.Votes {
+			v.Voter = migratedAddress(v.Voter, oldAddr, newAddr)
+			for _, d := range v.DelegatedPower {
+				d.Validator = migratedAddress(d.Validator, oldAddr, newAddr)
+			}
+		}
+		if err := ctx.Set(activeProposalKey(proposal.Id), proposal); err != nil {
+			return errors.Wrapf(err, "failed to save proposal %d", proposal.Id)
+		}
+
+		powersKey := delegatorPowersKey(proposal.Id)
+		for _, entry := range ctx.Range(powersKey) {
+			var snapshot DelegatorPowerSnapshot
+			if err := proto.Unmarshal(entry.Value, &snapshot); err != nil {
+				return errors.Wrapf(err, "failed to unmarshal delegator power %x", entry.Key)
+			}
+			for _, d := range snapshot.DelegatedPower {
+				d.Validator = migratedAddress(d.Validator, oldAddr, newAddr)
+			}
+			key := util.PrefixKey(powersKey, entry.Key)
+			if bytes.Equal(entry.Key, oldAddr.Bytes()) {
+				ctx.Delete(key)
+				key = delegatorPowerKey(proposal.Id, newAddr)
+			}
+			if err := ctx.Set(key, &snapshot); err != nil {
+				return errors.Wrap(err, "failed to save delegator power")
+			}
+		}
+	}
+	return nil
+}
+
+// SetParams changes the voting parameters, this method can only be called by the contract itself,
+// so the parameters can only be changed by a proposal.
+func (g *Governance) SetParams(ctx contract.Context, req *SetParamsRequest) error {
+	if ctx.Message().Sender.Compare(ctx.ContractAddress()) != 0 {
+		return ErrNotAuthorized
+	}
+	if err := validateParams(req.Params); err != nil {
+		return err
+	}
+	return ctx.Set(paramsKey, req.Params)
+}
+
+func (g *Governance) GetParams(ctx contract.StaticContext, req *GetParamsRequest) (*GetParamsResponse, error) {
+	params, err := loadParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GetParamsResponse{Params: params}, nil
+}
+
+// TallyProposals is called by the node at the start of each block to close voting on proposals
+// whose voting period has ended. Returns the IDs of passed proposals whose timelock has expired,
+// these should be executed by calling ExecuteProposal.
+func TallyProposals(ctx contract.Context) ([]uint64, error) {
+	params, err := loadParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proposals, err := listProposals(ctx, activeProposalPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	now := ctx.Now().Unix()
+	var executable []uint64
+	for _, proposal := range proposals {
+		if proposal.Status == ProposalStatus_VOTING && now >= proposal.VotingEndsAt {
+			if !isApproved(params, proposal) {
+				proposal.Status = ProposalStatus_REJECTED
+				ctx.Logger().Info("Governance proposal rejected", "id", proposal.Id)
+				if err := finishProposal(ctx, proposal); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			proposal.Status = ProposalStatus_PASSED
+			proposal.ExecutableAt = proposal.VotingEndsAt + int64(params.Timelock)
+			ctx.Logger().Info("Governance proposal passed",
+				"id", proposal.Id, "executableAt", proposal.ExecutableAt,
+			)
+			if err := ctx.Set(activeProposalKey(proposal.Id), proposal); err != nil {
+				return nil, errors.Wrapf(err, "failed to save proposal %d", proposal.Id)
+			}
+		}
+		if proposal.Status == ProposalStatus_PASSED && now >= proposal.ExecutableAt {
+			executable = append(executable, proposal.Id)
+		}
+	}
+	return executable, nil
+}
+
+// ExecuteProposal executes the contract call carried by a passed proposal, the call is made by the
+// Governance contract so the target contract will see the Governance contract as the sender.
+// If the call fails any state changes made by it should be discarded, and SetProposalFailed
+// should be called to mark the proposal as failed.
+func ExecuteProposal(ctx contract.Context, id uint64) error {
+	var proposal Proposal
+	if err := ctx.Get(activeProposalKey(id), &proposal); err != nil {
+		if err == contract.ErrNotFound {
+			return ErrProposalNotFound
+		}
+		return errors.Wrapf(err, "failed to load proposal %d", id)
+	}
+	if proposal.Status != ProposalStatus_PASSED || ctx.Now().Unix() < proposal.ExecutableAt {
+		return ErrProposalNotExecutable
+	}
+
+	contractAddr := loom.UnmarshalAddressPB(proposal.Call.Contract)
+	args := rawMessage(proposal.Call.Args)
+	if err := contract.CallMethod(ctx, contractAddr, proposal.Call.Method, &args, nil); err != nil {
+		return errors.Wrapf(err, "proposal %d call failed", id)
+	}
+
+	proposal.Status = ProposalStatus_EXECUTED
+	ctx.Logger().Info("Governance proposal executed", "id", proposal.Id)
+	return finishProposal(ctx, &proposal)
+}
+
+// SetProposalFailed marks a passed proposal as failed, so it won't be executed again.
+func SetProposalFailed(ctx contract.Context, id uint64, reason error) error {
+	var proposal Proposal
+	if err := ctx.Get(activeProposalKey(id), &proposal); err != nil {
+		if err == contract.ErrNotFound {
+			return ErrProposalNotFound
+		}
+		return errors.Wrapf(err, "failed to load proposal %d", id)
+	}
+	proposal.Status = ProposalStatus_FAILED
+	if reason != nil {
+		proposal.Error = reason.Error()
+	}
+	ctx.Logger().Error("Governance proposal failed", "id", proposal.Id, "err", reason)
+	return finishProposal(ctx, &proposal)
+}
+
+// IsSenderGovernance checks if the sender of the current message is the Governance contract,
+// other contracts use this to allow proposals to call methods that are restricted to admins.
+func IsSenderGovernance(ctx contract.StaticContext) bool {
+	if !ctx.FeatureEnabled(features.GovernanceFeature, false) {
+		return false
+	}
+	addr, err := ctx.Resolve(ContractName)
+	if err != nil {
+		return false
+	}
+	return ctx.Message().Sender.Compare(addr) == 0
+}
+
+// HasPermission checks if the sender of the current message has been granted the given permission
+// with any of the given roles, or is the Governance contract. Contracts use this to gate admin
+// methods so they can be called by proposals as well as by the admins themselves.
+func HasPermission(ctx contract.Context, permission []byte, roles []string) bool {
+	if ok, _ := ctx.HasPermission(permission, roles); ok {
+		return true
+	}
+	return IsSenderGovernance(ctx)
+}
+
+func isApproved(params *Params, proposal *Proposal) bool {
+	cast := proposal.ApprovePower + proposal.RejectPower
+	if cast == 0 || proposal.TotalPower == 0 {
+		return false
+	}
+	if cast*maxPercentage < int64(params.Quorum)*proposal.TotalPower {
+		return false
+	}
+	return proposal.ApprovePower*maxPercentage >= int64(params.Threshold)*cast
+}
+
+// powerSnapshot holds the power of the current validators, and of their delegators, at the time
+// a proposal is submitted.
+type powerSnapshot struct {
+	validators []*ValidatorPower
+	totalPower int64
+	// delegated power keyed by delegator address bytes, delegators lists the keys in the order
+	// they were found so the snapshot is always stored in the same order
+	delegated  map[string][]*DelegatedPower
+	delegators []loom.Address
+}
+
+// validatorPower returns the power of the given validator, or zero if the address doesn't belong
+// to any of the given validators.
+func validatorPower(validators []*ValidatorPower, addr loom.Address) int64 {
+	for _, v := range validators {
+		if addr.Compare(loom.UnmarshalAddressPB(v.Validator)) == 0 {
+			return v.Power
+		}
+	}
+	return 0
+}
+
+// snapshotPower returns the power of each of the current validators, and the power each delegator
+// has delegated to them (other than to itself), in the same units as the validator power.
+func snapshotPower(ctx contract.StaticContext) (*powerSnapshot, error) {
+	snapshot := &powerSnapshot{delegated: make(map[string][]*DelegatedPower)}
+	for _, v := range ctx.Validators() {
+		if v == nil {
+			continue
+		}
+		addr := loom.Address{ChainID: ctx.Block().ChainID, Local: loom.LocalAddressFromPublicKey(v.PubKey)}
+		snapshot.validators = append(snapshot.validators, &ValidatorPower{
+			Validator: addr.MarshalPB(),
+			Power:     v.Power,
+		})
+		snapshot.totalPower += v.Power
+	}
+
+	dposAddr, err := ctx.Resolve(dposContractName)
+	if err != nil {
+		// delegators can't vote on chains that don't run DPOSv3
+		return snapshot, nil
+	}
+
+	for _, v := range snapshot.validators {
+		validator := loom.UnmarshalAddressPB(v.Validator)
+		var listResp dtypes.ListDelegationsResponse
+		err := contract.StaticCallMethod(
+			ctx, dposAddr, "ListDelegations", &dtypes.ListDelegationsRequest{Candidate: v.Validator}, &listResp,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load delegations to %s", validator.String())
+		}
+
+		seen := make(map[string]bool)
+		for _, d := range listResp.Delegations {
+			delegator := loom.UnmarshalAddressPB(d.Delegator)
+			key := string(delegator.Bytes())
+			if seen[key] || delegator.Compare(validator) == 0 {
+				continue
+			}
+			seen[key] = true
+
+			// the weighted amount is what counts towards the validator power
+			var resp dtypes.CheckDelegationResponse
+			err := contract.StaticCallMethod(
+				ctx, dposAddr, "CheckDelegation",
+				&dtypes.CheckDelegationRequest{ValidatorAddress: v.Validator, DelegatorAddress: d.Delegator},
+				&resp,
+			)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to load delegations to %s", validator.String())
+			}
+			if resp.WeightedAmount == nil || resp.WeightedAmount.Value.Int == nil {
+				continue
+			}
+			power := new(big.Int).Div(resp.WeightedAmount.Value.Int, dposPowerCorrection)
+			if power.Sign() <= 0 {
+				continue
+			}
+			if _, ok := snapshot.delegated[key]; !ok {
+				snapshot.delegators = append(snapshot.delegators, delegator)
+			}
+			snapshot.delegated[key] = append(snapshot.delegated[key], &DelegatedPower{
+				Validator: v.Validator,
+				Power:     power.Int64(),
+			})
+		}
+	}
+	return snapshot, nil
+}
+
+// Returns newAddr if addr is oldAddr, otherwise addr is returned unchanged.
+func migratedAddress(addr *types.Address, oldAddr, newAddr loom.Address) *types.Address {
+	if addr != nil && oldAddr.Compare(loom.UnmarshalAddressPB(addr)) == 0 {
+		return newAddr.MarshalPB()
+	}
+	return addr
+}
+
+func finishProposal(ctx contract.Context, proposal *Proposal) error {
+	ctx.Delete(activeProposalKey(proposal.Id))
+	powersKey := delegatorPowersKey(proposal.Id)
+	for _, entry := range ctx.Range(powersKey) {
+		ctx.Delete(util.PrefixKey(powersKey, entry.Key))
+	}
+	if err := ctx.Set(finishedProposalKey(proposal.Id), proposal); err != nil {
+		return errors.Wrapf(err, "failed to save proposal %d", proposal.Id)
+	}
+	return pruneFinishedProposals(ctx)
+}
+
+// pruneFinishedProposals deletes the oldest finished proposals once there are more than
+// maxFinishedProposals of them.
+func pruneFinishedProposals(ctx contract.Context) error {
+	entries := ctx.Range([]byte(finishedProposalPrefix))
+	if len(entries) <= maxFinishedProposals {
+		return nil
+	}
+	ids := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		if len(entry.Key) != 8 {
+			return errors.Errorf("invalid finished proposal key %x", entry.Key)
+		}
+		ids = append(ids, binary.BigEndian.Uint64(entry.Key))
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids[:len(ids)-maxFinishedProposals] {
+		ctx.Delete(finishedProposalKey(id))
+	}
+	return nil
+}
+
+func loadProposal(ctx contract.StaticContext, id uint64) (*Proposal, error) {
+	var proposal Proposal
+	err := ctx.Get(activeProposalKey(id), &proposal)
+	if err == contract.ErrNotFound {
+		err = ctx.Get(finishedProposalKey(id), &proposal)
+	}
+	if err != nil {
+		if err == contract.ErrNotFound {
+			return nil, ErrProposalNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to load proposal %d", id)
+	}
+	return &proposal, nil
+}
+
+func listProposals(ctx contract.StaticContext, prefix string) ([]*Proposal, error) {
+	var proposals []*Proposal
+	for _, entry := range ctx.Range([]byte(prefix)) {
+		var proposal Proposal
+		if err := proto.Unmarshal(entry.Value, &proposal); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal proposal %x", entry.Key)
+		}
+		proposals = append(proposals, &proposal)
+	}
+	return proposals, nil
+}
+
+func loadParams(ctx contract.StaticContext) (*Params, error) {
+	var params Params
+	if err := ctx.Get(paramsKey, &params); err != nil {
+		return nil, errors.Wrap(err, "failed to load params")
+	}
+	return &params, nil
+}
+
+func validateParams(params *Params) error {
+	if params == nil {
+		return errors.Wrap(ErrInvalidRequest, "params not specified")
+	}
+	if params.VotingPeriod == 0 {
+		return errors.Wrap(ErrInvalidRequest, "voting period must be greater than zero")
+	}
+	if params.Quorum == 0 || params.Quorum > maxPercentage {
+		return errors.Wrap(ErrInvalidRequest, "quorum must be between 1 and 10000")
+	}
+	if params.Threshold == 0 || params.Threshold > maxPercentage {
+		return errors.Wrap(ErrInvalidRequest, "threshold must be between 1 and 10000")
+	}
+	if params.MinProposerPower <= 0 {
+		return errors.Wrap(ErrInvalidRequest, "min proposer power must be greater than zero")
+	}
+	return nil
+}
+
+// rawMessage wraps the protobuf encoded args of a proposal call so they can be passed through
+// contract.CallMethod without having to know the request type of the target method.
+type rawMessage []byte
+
+func (m *rawMessage) Reset()                   { *m = nil }
+func (m *rawMessage) String() string           { return fmt.Sprintf("%x", []byte(*m)) }
+func (m *rawMessage) ProtoMessage()            {}
+func (m *rawMessage) Marshal() ([]byte, error) { return *m, nil }
+
+var Contract plugin.Contract = contract.MakePluginContract(&Governance{})
@@ -0,0 +1,1116 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/governance/governance.proto
+
+package governance
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/loomnetwork/go-loom/types"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProposalStatus int32
+
+const (
+	ProposalStatus_VOTING   ProposalStatus = 0
+	ProposalStatus_PASSED   ProposalStatus = 1
+	ProposalStatus_REJECTED ProposalStatus = 2
+	ProposalStatus_EXECUTED ProposalStatus = 3
+	ProposalStatus_FAILED   ProposalStatus = 4
+)
+
+var ProposalStatus_name = map[int32]string{
+	0: "VOTING",
+	1: "PASSED",
+	2: "REJECTED",
+	3: "EXECUTED",
+	4: "FAILED",
+}
+
+var ProposalStatus_value = map[string]int32{
+	"VOTING":   0,
+	"PASSED":   1,
+	"REJECTED": 2,
+	"EXECUTED": 3,
+	"FAILED":   4,
+}
+
+func (x ProposalStatus) String() string {
+	return proto.EnumName(ProposalStatus_name, int32(x))
+}
+
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{0}
+}
+
+type Params struct {
+	VotingPeriod         uint64   `protobuf:"varint,1,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
+	Timelock             uint64   `protobuf:"varint,2,opt,name=timelock,proto3" json:"timelock,omitempty"`
+	Quorum               uint64   `protobuf:"varint,3,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	Threshold            uint64   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	MinProposerPower     int64    `protobuf:"varint,5,opt,name=min_proposer_power,json=minProposerPower,proto3" json:"min_proposer_power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Params.Unmarshal(m, b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return xxx_messageInfo_Params.Size(m)
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetVotingPeriod() uint64 {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetTimelock() uint64 {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
+func (m *Params) GetQuorum() uint64 {
+	if m != nil {
+		return m.Quorum
+	}
+	return 0
+}
+
+func (m *Params) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Params) GetMinProposerPower() int64 {
+	if m != nil {
+		return m.MinProposerPower
+	}
+	return 0
+}
+
+type ContractCall struct {
+	Contract             *types.Address `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Method               string         `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Args                 []byte         `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ContractCall) Reset()         { *m = ContractCall{} }
+func (m *ContractCall) String() string { return proto.CompactTextString(m) }
+func (*ContractCall) ProtoMessage()    {}
+func (*ContractCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{1}
+}
+func (m *ContractCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractCall.Unmarshal(m, b)
+}
+func (m *ContractCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractCall.Marshal(b, m, deterministic)
+}
+func (m *ContractCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCall.Merge(m, src)
+}
+func (m *ContractCall) XXX_Size() int {
+	return xxx_messageInfo_ContractCall.Size(m)
+}
+func (m *ContractCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCall proto.InternalMessageInfo
+
+func (m *ContractCall) GetContract() *types.Address {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *ContractCall) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *ContractCall) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type DelegatedPower struct {
+	Validator            *types.Address `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Power                int64          `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *DelegatedPower) Reset()         { *m = DelegatedPower{} }
+func (m *DelegatedPower) String() string { return proto.CompactTextString(m) }
+func (*DelegatedPower) ProtoMessage()    {}
+func (*DelegatedPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{2}
+}
+func (m *DelegatedPower) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegatedPower.Unmarshal(m, b)
+}
+func (m *DelegatedPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegatedPower.Marshal(b, m, deterministic)
+}
+func (m *DelegatedPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedPower.Merge(m, src)
+}
+func (m *DelegatedPower) XXX_Size() int {
+	return xxx_messageInfo_DelegatedPower.Size(m)
+}
+func (m *DelegatedPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatedPower proto.InternalMessageInfo
+
+func (m *DelegatedPower) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *DelegatedPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+type ValidatorPower struct {
+	Validator            *types.Address `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Power                int64          `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ValidatorPower) Reset()         { *m = ValidatorPower{} }
+func (m *ValidatorPower) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPower) ProtoMessage()    {}
+func (*ValidatorPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{3}
+}
+func (m *ValidatorPower) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorPower.Unmarshal(m, b)
+}
+func (m *ValidatorPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorPower.Marshal(b, m, deterministic)
+}
+func (m *ValidatorPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPower.Merge(m, src)
+}
+func (m *ValidatorPower) XXX_Size() int {
+	return xxx_messageInfo_ValidatorPower.Size(m)
+}
+func (m *ValidatorPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPower proto.InternalMessageInfo
+
+func (m *ValidatorPower) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *ValidatorPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+type DelegatorPowerSnapshot struct {
+	DelegatedPower       []*DelegatedPower `protobuf:"bytes,1,rep,name=delegated_power,json=delegatedPower,proto3" json:"delegated_power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DelegatorPowerSnapshot) Reset()         { *m = DelegatorPowerSnapshot{} }
+func (m *DelegatorPowerSnapshot) String() string { return proto.CompactTextString(m) }
+func (*DelegatorPowerSnapshot) ProtoMessage()    {}
+func (*DelegatorPowerSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{4}
+}
+func (m *DelegatorPowerSnapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegatorPowerSnapshot.Unmarshal(m, b)
+}
+func (m *DelegatorPowerSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegatorPowerSnapshot.Marshal(b, m, deterministic)
+}
+func (m *DelegatorPowerSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorPowerSnapshot.Merge(m, src)
+}
+func (m *DelegatorPowerSnapshot) XXX_Size() int {
+	return xxx_messageInfo_DelegatorPowerSnapshot.Size(m)
+}
+func (m *DelegatorPowerSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorPowerSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorPowerSnapshot proto.InternalMessageInfo
+
+func (m *DelegatorPowerSnapshot) GetDelegatedPower() []*DelegatedPower {
+	if m != nil {
+		return m.DelegatedPower
+	}
+	return nil
+}
+
+type Vote struct {
+	Voter                *types.Address    `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Approve              bool              `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	Power                int64             `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	ValidatorPower       int64             `protobuf:"varint,4,opt,name=validator_power,json=validatorPower,proto3" json:"validator_power,omitempty"`
+	DelegatedPower       []*DelegatedPower `protobuf:"bytes,5,rep,name=delegated_power,json=delegatedPower,proto3" json:"delegated_power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{5}
+}
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Vote.Unmarshal(m, b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return xxx_messageInfo_Vote.Size(m)
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetVoter() *types.Address {
+	if m != nil {
+		return m.Voter
+	}
+	return nil
+}
+
+func (m *Vote) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+func (m *Vote) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *Vote) GetValidatorPower() int64 {
+	if m != nil {
+		return m.ValidatorPower
+	}
+	return 0
+}
+
+func (m *Vote) GetDelegatedPower() []*DelegatedPower {
+	if m != nil {
+		return m.DelegatedPower
+	}
+	return nil
+}
+
+type Proposal struct {
+	Id                   uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Proposer             *types.Address    `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Description          string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Call                 *ContractCall     `protobuf:"bytes,4,opt,name=call,proto3" json:"call,omitempty"`
+	Status               ProposalStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=governance.ProposalStatus" json:"status,omitempty"`
+	CreatedAt            int64             `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VotingEndsAt         int64             `protobuf:"varint,7,opt,name=voting_ends_at,json=votingEndsAt,proto3" json:"voting_ends_at,omitempty"`
+	ExecutableAt         int64             `protobuf:"varint,8,opt,name=executable_at,json=executableAt,proto3" json:"executable_at,omitempty"`
+	Votes                []*Vote           `protobuf:"bytes,9,rep,name=votes,proto3" json:"votes,omitempty"`
+	TotalPower           int64             `protobuf:"varint,10,opt,name=total_power,json=totalPower,proto3" json:"total_power,omitempty"`
+	ApprovePower         int64             `protobuf:"varint,11,opt,name=approve_power,json=approvePower,proto3" json:"approve_power,omitempty"`
+	RejectPower          int64             `protobuf:"varint,12,opt,name=reject_power,json=rejectPower,proto3" json:"reject_power,omitempty"`
+	Error                string            `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	ValidatorPowers      []*ValidatorPower `protobuf:"bytes,14,rep,name=validator_powers,json=validatorPowers,proto3" json:"validator_powers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{6}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Proposal.Unmarshal(m, b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return xxx_messageInfo_Proposal.Size(m)
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Proposal) GetProposer() *types.Address {
+	if m != nil {
+		return m.Proposer
+	}
+	return nil
+}
+
+func (m *Proposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Proposal) GetCall() *ContractCall {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *Proposal) GetStatus() ProposalStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProposalStatus_VOTING
+}
+
+func (m *Proposal) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Proposal) GetVotingEndsAt() int64 {
+	if m != nil {
+		return m.VotingEndsAt
+	}
+	return 0
+}
+
+func (m *Proposal) GetExecutableAt() int64 {
+	if m != nil {
+		return m.ExecutableAt
+	}
+	return 0
+}
+
+func (m *Proposal) GetVotes() []*Vote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *Proposal) GetTotalPower() int64 {
+	if m != nil {
+		return m.TotalPower
+	}
+	return 0
+}
+
+func (m *Proposal) GetApprovePower() int64 {
+	if m != nil {
+		return m.ApprovePower
+	}
+	return 0
+}
+
+func (m *Proposal) GetRejectPower() int64 {
+	if m != nil {
+		return m.RejectPower
+	}
+	return 0
+}
+
+func (m *Proposal) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Proposal) GetValidatorPowers() []*ValidatorPower {
+	if m != nil {
+		return m.ValidatorPowers
+	}
+	return nil
+}
+
+type ProposalCounter struct {
+	LastId               uint64   `protobuf:"varint,1,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposalCounter) Reset()         { *m = ProposalCounter{} }
+func (m *ProposalCounter) String() string { return proto.CompactTextString(m) }
+func (*ProposalCounter) ProtoMessage()    {}
+func (*ProposalCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{7}
+}
+func (m *ProposalCounter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalCounter.Unmarshal(m, b)
+}
+func (m *ProposalCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalCounter.Marshal(b, m, deterministic)
+}
+func (m *ProposalCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalCounter.Merge(m, src)
+}
+func (m *ProposalCounter) XXX_Size() int {
+	return xxx_messageInfo_ProposalCounter.Size(m)
+}
+func (m *ProposalCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalCounter proto.InternalMessageInfo
+
+func (m *ProposalCounter) GetLastId() uint64 {
+	if m != nil {
+		return m.LastId
+	}
+	return 0
+}
+
+type InitRequest struct {
+	Params               *Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitRequest) Reset()         { *m = InitRequest{} }
+func (m *InitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitRequest) ProtoMessage()    {}
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{8}
+}
+func (m *InitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitRequest.Unmarshal(m, b)
+}
+func (m *InitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitRequest.Merge(m, src)
+}
+func (m *InitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitRequest.Size(m)
+}
+func (m *InitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitRequest proto.InternalMessageInfo
+
+func (m *InitRequest) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type ProposeRequest struct {
+	Description          string        `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Call                 *ContractCall `protobuf:"bytes,2,opt,name=call,proto3" json:"call,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ProposeRequest) Reset()         { *m = ProposeRequest{} }
+func (m *ProposeRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposeRequest) ProtoMessage()    {}
+func (*ProposeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{9}
+}
+func (m *ProposeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposeRequest.Unmarshal(m, b)
+}
+func (m *ProposeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposeRequest.Marshal(b, m, deterministic)
+}
+func (m *ProposeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeRequest.Merge(m, src)
+}
+func (m *ProposeRequest) XXX_Size() int {
+	return xxx_messageInfo_ProposeRequest.Size(m)
+}
+func (m *ProposeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeRequest proto.InternalMessageInfo
+
+func (m *ProposeRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ProposeRequest) GetCall() *ContractCall {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+type ProposeResponse struct {
+	ProposalId           uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposeResponse) Reset()         { *m = ProposeResponse{} }
+func (m *ProposeResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposeResponse) ProtoMessage()    {}
+func (*ProposeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{10}
+}
+func (m *ProposeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposeResponse.Unmarshal(m, b)
+}
+func (m *ProposeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposeResponse.Marshal(b, m, deterministic)
+}
+func (m *ProposeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeResponse.Merge(m, src)
+}
+func (m *ProposeResponse) XXX_Size() int {
+	return xxx_messageInfo_ProposeResponse.Size(m)
+}
+func (m *ProposeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeResponse proto.InternalMessageInfo
+
+func (m *ProposeResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type VoteRequest struct {
+	ProposalId           uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	Approve              bool     `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteRequest) Reset()         { *m = VoteRequest{} }
+func (m *VoteRequest) String() string { return proto.CompactTextString(m) }
+func (*VoteRequest) ProtoMessage()    {}
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{11}
+}
+func (m *VoteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteRequest.Unmarshal(m, b)
+}
+func (m *VoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteRequest.Marshal(b, m, deterministic)
+}
+func (m *VoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteRequest.Merge(m, src)
+}
+func (m *VoteRequest) XXX_Size() int {
+	return xxx_messageInfo_VoteRequest.Size(m)
+}
+func (m *VoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteRequest proto.InternalMessageInfo
+
+func (m *VoteRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *VoteRequest) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type GetProposalRequest struct {
+	ProposalId           uint64   `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProposalRequest) Reset()         { *m = GetProposalRequest{} }
+func (m *GetProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProposalRequest) ProtoMessage()    {}
+func (*GetProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{12}
+}
+func (m *GetProposalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProposalRequest.Unmarshal(m, b)
+}
+func (m *GetProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProposalRequest.Marshal(b, m, deterministic)
+}
+func (m *GetProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProposalRequest.Merge(m, src)
+}
+func (m *GetProposalRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProposalRequest.Size(m)
+}
+func (m *GetProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProposalRequest proto.InternalMessageInfo
+
+func (m *GetProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type GetProposalResponse struct {
+	Proposal             *Proposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetProposalResponse) Reset()         { *m = GetProposalResponse{} }
+func (m *GetProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProposalResponse) ProtoMessage()    {}
+func (*GetProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{13}
+}
+func (m *GetProposalResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProposalResponse.Unmarshal(m, b)
+}
+func (m *GetProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProposalResponse.Marshal(b, m, deterministic)
+}
+func (m *GetProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProposalResponse.Merge(m, src)
+}
+func (m *GetProposalResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProposalResponse.Size(m)
+}
+func (m *GetProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProposalResponse proto.InternalMessageInfo
+
+func (m *GetProposalResponse) GetProposal() *Proposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return nil
+}
+
+type ListProposalsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProposalsRequest) Reset()         { *m = ListProposalsRequest{} }
+func (m *ListProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProposalsRequest) ProtoMessage()    {}
+func (*ListProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{14}
+}
+func (m *ListProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProposalsRequest.Unmarshal(m, b)
+}
+func (m *ListProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProposalsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProposalsRequest.Merge(m, src)
+}
+func (m *ListProposalsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProposalsRequest.Size(m)
+}
+func (m *ListProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProposalsRequest proto.InternalMessageInfo
+
+type ListProposalsResponse struct {
+	Proposals            []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListProposalsResponse) Reset()         { *m = ListProposalsResponse{} }
+func (m *ListProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListProposalsResponse) ProtoMessage()    {}
+func (*ListProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{15}
+}
+func (m *ListProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProposalsResponse.Unmarshal(m, b)
+}
+func (m *ListProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProposalsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProposalsResponse.Merge(m, src)
+}
+func (m *ListProposalsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListProposalsResponse.Size(m)
+}
+func (m *ListProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProposalsResponse proto.InternalMessageInfo
+
+func (m *ListProposalsResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type MigrateValidatorAddressRequest struct {
+	OldAddress           *types.Address `protobuf:"bytes,1,opt,name=old_address,json=oldAddress,proto3" json:"old_address,omitempty"`
+	NewAddress           *types.Address `protobuf:"bytes,2,opt,name=new_address,json=newAddress,proto3" json:"new_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MigrateValidatorAddressRequest) Reset()         { *m = MigrateValidatorAddressRequest{} }
+func (m *MigrateValidatorAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateValidatorAddressRequest) ProtoMessage()    {}
+func (*MigrateValidatorAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{16}
+}
+func (m *MigrateValidatorAddressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateValidatorAddressRequest.Unmarshal(m, b)
+}
+func (m *MigrateValidatorAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateValidatorAddressRequest.Marshal(b, m, deterministic)
+}
+func (m *MigrateValidatorAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateValidatorAddressRequest.Merge(m, src)
+}
+func (m *MigrateValidatorAddressRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateValidatorAddressRequest.Size(m)
+}
+func (m *MigrateValidatorAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateValidatorAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateValidatorAddressRequest proto.InternalMessageInfo
+
+func (m *MigrateValidatorAddressRequest) GetOldAddress() *types.Address {
+	if m != nil {
+		return m.OldAddress
+	}
+	return nil
+}
+
+func (m *MigrateValidatorAddressRequest) GetNewAddress() *types.Address {
+	if m != nil {
+		return m.NewAddress
+	}
+	return nil
+}
+
+type SetParamsRequest struct {
+	Params               *Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetParamsRequest) Reset()         { *m = SetParamsRequest{} }
+func (m *SetParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetParamsRequest) ProtoMessage()    {}
+func (*SetParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{17}
+}
+func (m *SetParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetParamsRequest.Unmarshal(m, b)
+}
+func (m *SetParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetParamsRequest.Merge(m, src)
+}
+func (m *SetParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetParamsRequest.Size(m)
+}
+func (m *SetParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetParamsRequest proto.InternalMessageInfo
+
+func (m *SetParamsRequest) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type GetParamsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetParamsRequest) Reset()         { *m = GetParamsRequest{} }
+func (m *GetParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetParamsRequest) ProtoMessage()    {}
+func (*GetParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{18}
+}
+func (m *GetParamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetParamsRequest.Unmarshal(m, b)
+}
+func (m *GetParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetParamsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetParamsRequest.Merge(m, src)
+}
+func (m *GetParamsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetParamsRequest.Size(m)
+}
+func (m *GetParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetParamsRequest proto.InternalMessageInfo
+
+type GetParamsResponse struct {
+	Params               *Params  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetParamsResponse) Reset()         { *m = GetParamsResponse{} }
+func (m *GetParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetParamsResponse) ProtoMessage()    {}
+func (*GetParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfa0056765c94686, []int{19}
+}
+func (m *GetParamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetParamsResponse.Unmarshal(m, b)
+}
+func (m *GetParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetParamsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetParamsResponse.Merge(m, src)
+}
+func (m *GetParamsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetParamsResponse.Size(m)
+}
+func (m *GetParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetParamsResponse proto.InternalMessageInfo
+
+func (m *GetParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("governance.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
+	proto.RegisterType((*Params)(nil), "governance.Params")
+	proto.RegisterType((*ContractCall)(nil), "governance.ContractCall")
+	proto.RegisterType((*DelegatedPower)(nil), "governance.DelegatedPower")
+	proto.RegisterType((*ValidatorPower)(nil), "governance.ValidatorPower")
+	proto.RegisterType((*DelegatorPowerSnapshot)(nil), "governance.DelegatorPowerSnapshot")
+	proto.RegisterType((*Vote)(nil), "governance.Vote")
+	proto.RegisterType((*Proposal)(nil), "governance.Proposal")
+	proto.RegisterType((*ProposalCounter)(nil), "governance.ProposalCounter")
+	proto.RegisterType((*InitRequest)(nil), "governance.InitRequest")
+	proto.RegisterType((*ProposeRequest)(nil), "governance.ProposeRequest")
+	proto.RegisterType((*ProposeResponse)(nil), "governance.ProposeResponse")
+	proto.RegisterType((*VoteRequest)(nil), "governance.VoteRequest")
+	proto.RegisterType((*GetProposalRequest)(nil), "governance.GetProposalRequest")
+	proto.RegisterType((*GetProposalResponse)(nil), "governance.GetProposalResponse")
+	proto.RegisterType((*ListProposalsRequest)(nil), "governance.ListProposalsRequest")
+	proto.RegisterType((*ListProposalsResponse)(nil), "governance.ListProposalsResponse")
+	proto.RegisterType((*MigrateValidatorAddressRequest)(nil), "governance.MigrateValidatorAddressRequest")
+	proto.RegisterType((*SetParamsRequest)(nil), "governance.SetParamsRequest")
+	proto.RegisterType((*GetParamsRequest)(nil), "governance.GetParamsRequest")
+	proto.RegisterType((*GetParamsResponse)(nil), "governance.GetParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/governance/governance.proto", fileDescriptor_cfa0056765c94686)
+}
+
+var fileDescriptor_cfa0056765c94686 = []byte{
+	// 946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x23, 0x35,
+	0x14, 0x26, 0xbf, 0x9b, 0x9c, 0x49, 0xd3, 0x60, 0x4a, 0x19, 0x55, 0xb0, 0x94, 0xd9, 0xd5, 0x52,
+	0xaa, 0xa5, 0x59, 0x05, 0x71, 0xc1, 0x0d, 0x28, 0x4a, 0x43, 0x09, 0x94, 0x25, 0x9a, 0x2c, 0x15,
+	0x37, 0x28, 0xeb, 0xce, 0x1c, 0x25, 0xc3, 0x3a, 0xf6, 0xac, 0xed, 0xa4, 0xf0, 0x2e, 0xbc, 0x02,
+	0x6f, 0xc1, 0x83, 0xa1, 0xb1, 0x3d, 0x49, 0x26, 0x6d, 0xc5, 0x2e, 0xe2, 0x26, 0xf2, 0xf9, 0xce,
+	0x37, 0xf6, 0x77, 0x8e, 0xbf, 0x63, 0x05, 0x2e, 0x67, 0x89, 0x9e, 0x2f, 0xaf, 0xcf, 0x22, 0xb1,
+	0xe8, 0x32, 0x21, 0x16, 0x1c, 0xf5, 0x8d, 0x90, 0xaf, 0xcc, 0x3a, 0x9a, 0xd3, 0x84, 0x77, 0xaf,
+	0x97, 0x09, 0xd3, 0x09, 0xef, 0xa6, 0x6c, 0x39, 0x4b, 0xb8, 0xea, 0xce, 0xc4, 0x0a, 0x25, 0xa7,
+	0x3c, 0xc2, 0xad, 0xe5, 0x59, 0x2a, 0x85, 0x16, 0x04, 0x36, 0xc8, 0xd1, 0xb3, 0x7b, 0x76, 0x9e,
+	0x89, 0xcf, 0xb3, 0xb0, 0xab, 0xff, 0x48, 0x51, 0xd9, 0x5f, 0xfb, 0x75, 0xf0, 0x57, 0x09, 0xea,
+	0x63, 0x2a, 0xe9, 0x42, 0x91, 0x47, 0xb0, 0xb7, 0x12, 0x3a, 0xe1, 0xb3, 0x69, 0x8a, 0x32, 0x11,
+	0xb1, 0x5f, 0x3a, 0x2e, 0x9d, 0x54, 0xc3, 0x96, 0x05, 0xc7, 0x06, 0x23, 0x47, 0xd0, 0xd0, 0xc9,
+	0x02, 0x99, 0x88, 0x5e, 0xf9, 0x65, 0x93, 0x5f, 0xc7, 0xe4, 0x10, 0xea, 0xaf, 0x97, 0x42, 0x2e,
+	0x17, 0x7e, 0xc5, 0x64, 0x5c, 0x44, 0x3e, 0x84, 0xa6, 0x9e, 0x4b, 0x54, 0x73, 0xc1, 0x62, 0xbf,
+	0x6a, 0x52, 0x1b, 0x80, 0x3c, 0x05, 0xb2, 0x48, 0xf8, 0x34, 0x95, 0x22, 0x15, 0x0a, 0xe5, 0x34,
+	0x15, 0x37, 0x28, 0xfd, 0xda, 0x71, 0xe9, 0xa4, 0x12, 0x76, 0x16, 0x09, 0x1f, 0xbb, 0xc4, 0x38,
+	0xc3, 0x83, 0x97, 0xd0, 0x1a, 0x08, 0xae, 0x25, 0x8d, 0xf4, 0x80, 0x32, 0x46, 0x1e, 0x43, 0x23,
+	0x72, 0xb1, 0xd1, 0xeb, 0xf5, 0x1a, 0x67, 0xfd, 0x38, 0x96, 0xa8, 0x54, 0xb8, 0xce, 0x64, 0xca,
+	0x16, 0xa8, 0xe7, 0x22, 0x36, 0x9a, 0x9b, 0xa1, 0x8b, 0x08, 0x81, 0x2a, 0x95, 0x33, 0x65, 0xf4,
+	0xb6, 0x42, 0xb3, 0x0e, 0x9e, 0x43, 0xfb, 0x1c, 0x19, 0xce, 0xa8, 0xc6, 0xd8, 0x9c, 0x49, 0x9e,
+	0x40, 0x73, 0x45, 0x59, 0x12, 0x53, 0x2d, 0xe4, 0xad, 0x43, 0x36, 0x29, 0x72, 0x00, 0x35, 0x2b,
+	0xbe, 0x6c, 0xc4, 0xdb, 0x20, 0xdb, 0xef, 0x2a, 0xa7, 0xfc, 0x1f, 0xfb, 0xfd, 0x0a, 0x87, 0x4e,
+	0x9f, 0xdb, 0x6f, 0xc2, 0x69, 0xaa, 0xe6, 0x42, 0x93, 0x01, 0xec, 0xc7, 0xb9, 0x72, 0xd7, 0xc6,
+	0xd2, 0x71, 0xe5, 0xc4, 0xeb, 0x1d, 0x9d, 0x6d, 0xb9, 0xa6, 0x58, 0x5c, 0xd8, 0x8e, 0x0b, 0x71,
+	0xf0, 0x77, 0x09, 0xaa, 0x57, 0x42, 0x23, 0x79, 0x08, 0xb5, 0x95, 0xd0, 0x78, 0x5b, 0xa1, 0x85,
+	0x89, 0x0f, 0x0f, 0x68, 0x9a, 0x4a, 0xb1, 0x42, 0xa3, 0xaf, 0x11, 0xe6, 0xe1, 0x46, 0x77, 0x65,
+	0x4b, 0x37, 0xf9, 0x14, 0xf6, 0xd7, 0xa5, 0x39, 0x75, 0x55, 0x93, 0x6f, 0xaf, 0x8a, 0xed, 0xb9,
+	0xa3, 0x8c, 0xda, 0x5b, 0x97, 0xf1, 0x67, 0x15, 0x1a, 0xd6, 0x39, 0x94, 0x91, 0x36, 0x94, 0x93,
+	0xdc, 0xce, 0xe5, 0x24, 0xce, 0x4c, 0x93, 0xdb, 0xcd, 0x2f, 0xef, 0x54, 0xb7, 0xce, 0x90, 0x63,
+	0xf0, 0x62, 0x54, 0x91, 0x4c, 0x52, 0x9d, 0x08, 0x6e, 0x8a, 0x69, 0x86, 0xdb, 0x10, 0x79, 0x0a,
+	0xd5, 0x88, 0x32, 0x66, 0xea, 0xf0, 0x7a, 0xfe, 0xb6, 0xbc, 0x6d, 0x93, 0x86, 0x86, 0x45, 0x7a,
+	0x50, 0x57, 0x9a, 0xea, 0xa5, 0x32, 0xe6, 0x6e, 0x17, 0xcb, 0xc9, 0xb5, 0x4e, 0x0c, 0x23, 0x74,
+	0x4c, 0xf2, 0x11, 0x40, 0x24, 0xd1, 0x74, 0x82, 0x6a, 0xbf, 0x6e, 0xfa, 0xd5, 0x74, 0x48, 0x5f,
+	0x93, 0xc7, 0xd0, 0x76, 0x23, 0x8b, 0x3c, 0x56, 0x19, 0xe5, 0x81, 0xa1, 0xb8, 0x99, 0x1d, 0xf2,
+	0x58, 0xf5, 0x75, 0x36, 0xd8, 0xf8, 0x3b, 0x46, 0x4b, 0x4d, 0xaf, 0x19, 0x66, 0xa4, 0x86, 0x25,
+	0x6d, 0xc0, 0xbe, 0x26, 0x4f, 0xec, 0x75, 0x2b, 0xbf, 0x69, 0x7a, 0xdd, 0xd9, 0x16, 0x97, 0xf9,
+	0xc1, 0x5e, 0xbb, 0x22, 0x1f, 0x83, 0xa7, 0x85, 0xa6, 0xcc, 0xdd, 0x0c, 0x98, 0xad, 0xc0, 0x40,
+	0xf6, 0xfa, 0x1e, 0xc1, 0x9e, 0x33, 0x82, 0xa3, 0x78, 0xf6, 0x34, 0x07, 0x5a, 0xd2, 0x27, 0xd0,
+	0x92, 0xf8, 0x1b, 0x46, 0xda, 0x71, 0x5a, 0x86, 0xe3, 0x59, 0xcc, 0x52, 0x0e, 0xa0, 0x86, 0x52,
+	0x0a, 0xe9, 0xef, 0x99, 0xc6, 0xdb, 0x80, 0x0c, 0xa1, 0xb3, 0xe3, 0x22, 0xe5, 0xb7, 0x6f, 0xbb,
+	0xa3, 0x38, 0x71, 0xe1, 0x7e, 0xd1, 0x62, 0x2a, 0x38, 0x85, 0xfd, 0xbc, 0xe3, 0x03, 0xb1, 0xe4,
+	0x99, 0x9f, 0x3f, 0x80, 0x07, 0x8c, 0x2a, 0x3d, 0x5d, 0x3b, 0xa5, 0x9e, 0x85, 0xa3, 0x38, 0xf8,
+	0x0a, 0xbc, 0x11, 0x4f, 0x74, 0x88, 0xaf, 0x97, 0xa8, 0x34, 0x39, 0x85, 0x7a, 0x6a, 0x1e, 0x4c,
+	0x37, 0x18, 0xa4, 0x70, 0x8d, 0x26, 0x13, 0x3a, 0x46, 0xf0, 0x12, 0xda, 0xf6, 0x18, 0xcc, 0xbf,
+	0xde, 0x31, 0x55, 0xe9, 0x7e, 0x53, 0x95, 0xdf, 0xc4, 0x54, 0x41, 0x2f, 0x2f, 0x04, 0x43, 0x54,
+	0xa9, 0xe0, 0x0a, 0xb3, 0x1b, 0x4a, 0x5d, 0x6d, 0x9b, 0x62, 0x20, 0x87, 0x46, 0x71, 0xf0, 0x1d,
+	0x78, 0xe6, 0x46, 0x9d, 0xa4, 0x7f, 0xe3, 0xdf, 0x3f, 0xe9, 0xc1, 0x97, 0x40, 0x2e, 0x50, 0xe7,
+	0x9d, 0x7c, 0xd3, 0x0d, 0x83, 0x0b, 0x78, 0xaf, 0xf0, 0x99, 0x13, 0xfe, 0x2c, 0x1f, 0x4b, 0xca,
+	0x5c, 0x6f, 0x0f, 0xee, 0x1a, 0x91, 0x70, 0xcd, 0x0a, 0x0e, 0xe1, 0xe0, 0x32, 0x51, 0xeb, 0x9d,
+	0x94, 0x53, 0x10, 0xfc, 0x00, 0xef, 0xef, 0xe0, 0xee, 0x88, 0x1e, 0x34, 0xf3, 0x8f, 0x95, 0x7b,
+	0x1c, 0xef, 0x3e, 0x63, 0x43, 0x0b, 0x56, 0xf0, 0xf0, 0xc7, 0x64, 0x26, 0xa9, 0xc6, 0xb5, 0xab,
+	0xf2, 0xc7, 0xc2, 0x15, 0xfc, 0x19, 0x78, 0x82, 0xc5, 0x53, 0x6a, 0xd1, 0x5b, 0x0f, 0x26, 0x08,
+	0x16, 0xbb, 0x75, 0x46, 0xe5, 0x78, 0xb3, 0xa6, 0xee, 0xbe, 0x3e, 0xc0, 0xf1, 0xc6, 0xad, 0x83,
+	0xaf, 0xa1, 0x33, 0x41, 0xed, 0x1c, 0xf5, 0x1f, 0xcc, 0x47, 0xa0, 0x73, 0xb1, 0xf3, 0x7d, 0xf0,
+	0x0d, 0xbc, 0xbb, 0x85, 0xb9, 0xa6, 0xbc, 0xc5, 0xa6, 0xa7, 0x63, 0x68, 0x17, 0x9f, 0x2a, 0x02,
+	0x50, 0xbf, 0xfa, 0xe9, 0xc5, 0xe8, 0xf9, 0x45, 0xe7, 0x9d, 0x6c, 0x3d, 0xee, 0x4f, 0x26, 0xc3,
+	0xf3, 0x4e, 0x89, 0xb4, 0xa0, 0x11, 0x0e, 0xbf, 0x1f, 0x0e, 0x5e, 0x0c, 0xcf, 0x3b, 0xe5, 0x2c,
+	0x1a, 0xfe, 0x32, 0x1c, 0xfc, 0x9c, 0x45, 0x95, 0x8c, 0xf7, 0x6d, 0x7f, 0x74, 0x39, 0x3c, 0xef,
+	0x54, 0xaf, 0xeb, 0xe6, 0x8f, 0xc8, 0x17, 0xff, 0x0c, 0x00, 0x9e, 0x85, 0xde, 0xc5, 0x16, 0x09,
+	0x00, 0x00,
+}
@@ -0,0 +1,353 @@
+package governance
+
+import (
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	dtypes "github.com/loomnetwork/go-loom/builtin/types/dposv3"
+	"github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+const chainID = "default"
+
+func makeValidator(t *testing.T, power int64) (*loom.Validator, loom.Address) {
+	pubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	addr := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(pubKey)}
+	return &loom.Validator{PubKey: pubKey, Power: power}, addr
+}
+
+func TestGovernanceProposals(t *testing.T) {
+	v1, addr1 := makeValidator(t, 10)
+	v2, addr2 := makeValidator(t, 20)
+	v3, addr3 := makeValidator(t, 30)
+	guest := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+
+	pctx := plugin.CreateFakeContext(addr1, addr1).WithBlock(loom.BlockHeader{
+		ChainID: chainID,
+		Time:    time.Now().Unix(),
+	}).WithValidators([]*loom.Validator{v1, v2, v3})
+	govAddr := pctx.CreateContract(Contract)
+	govCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(govAddr).WithSender(sender))
+	}
+	advanceTime := func(seconds int64) {
+		pctx.SetTime(pctx.Now().Add(time.Duration(seconds) * time.Second))
+	}
+	c := &Governance{}
+
+	require.Error(t, c.Init(govCtx(addr1), &InitRequest{}))
+	require.Error(t, c.Init(govCtx(addr1), &InitRequest{Params: &Params{VotingPeriod: 100, Quorum: 5000}}))
+	require.Error(t, c.Init(govCtx(addr1), &InitRequest{
+		Params: &Params{VotingPeriod: 100, Timelock: 50, Quorum: 5000, Threshold: 6000},
+	}))
+	params := &Params{VotingPeriod: 100, Timelock: 50, Quorum: 5000, Threshold: 6000, MinProposerPower: 20}
+	require.NoError(t, c.Init(govCtx(addr1), &InitRequest{Params: params}))
+
+	newParams := &Params{VotingPeriod: 200, Timelock: 50, Quorum: 5000, Threshold: 6000, MinProposerPower: 10}
+	args, err := proto.Marshal(&SetParamsRequest{Params: newParams})
+	require.NoError(t, err)
+	setParamsCall := &ContractCall{Contract: govAddr.MarshalPB(), Method: "SetParams", Args: args}
+
+	_, err = c.Propose(govCtx(addr1), &ProposeRequest{Call: setParamsCall})
+	require.Equal(t, ErrFeatureNotEnabled, err)
+	pctx.SetFeature(features.GovernanceFeature, true)
+
+	_, err = c.Propose(govCtx(guest), &ProposeRequest{Call: setParamsCall})
+	require.Equal(t, ErrNoVotingPower, err)
+	// validators below the min proposer power can vote, but can't submit proposals
+	_, err = c.Propose(govCtx(addr1), &ProposeRequest{Call: setParamsCall})
+	require.Equal(t, ErrNoVotingPower, err)
+	_, err = c.Propose(govCtx(addr2), &ProposeRequest{})
+	require.Error(t, err)
+
+	// the params can only be changed by a proposal
+	require.Equal(t, ErrNotAuthorized, c.SetParams(govCtx(addr1), &SetParamsRequest{Params: newParams}))
+
+	resp, err := c.Propose(govCtx(addr2), &ProposeRequest{Description: "approved", Call: setParamsCall})
+	require.NoError(t, err)
+	approvedID := resp.ProposalId
+	resp, err = c.Propose(govCtx(addr3), &ProposeRequest{Description: "no quorum", Call: setParamsCall})
+	require.NoError(t, err)
+	noQuorumID := resp.ProposalId
+	resp, err = c.Propose(govCtx(addr2), &ProposeRequest{
+		Description: "bad call",
+		Call:        &ContractCall{Contract: govAddr.MarshalPB(), Method: "NoSuchMethod"},
+	})
+	require.NoError(t, err)
+	failedID := resp.ProposalId
+
+	require.NoError(t, c.Vote(govCtx(addr2), &VoteRequest{ProposalId: approvedID, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(addr3), &VoteRequest{ProposalId: approvedID, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(addr1), &VoteRequest{ProposalId: approvedID, Approve: false}))
+	require.Equal(t, ErrAlreadyVoted, c.Vote(govCtx(addr3), &VoteRequest{ProposalId: approvedID, Approve: false}))
+	require.Equal(t, ErrNoVotingPower, c.Vote(govCtx(guest), &VoteRequest{ProposalId: approvedID, Approve: true}))
+	require.Equal(t, ErrProposalNotFound, c.Vote(govCtx(addr1), &VoteRequest{ProposalId: 100, Approve: true}))
+
+	require.NoError(t, c.Vote(govCtx(addr1), &VoteRequest{ProposalId: noQuorumID, Approve: true}))
+
+	require.NoError(t, c.Vote(govCtx(addr2), &VoteRequest{ProposalId: failedID, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(addr3), &VoteRequest{ProposalId: failedID, Approve: true}))
+
+	ids, err := TallyProposals(govCtx(addr1))
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	// voting closes, but the timelock hasn't expired yet
+	advanceTime(100)
+	ids, err = TallyProposals(govCtx(addr1))
+	require.NoError(t, err)
+	require.Empty(t, ids)
+	require.Equal(t, ErrVotingClosed, c.Vote(govCtx(addr3), &VoteRequest{ProposalId: noQuorumID, Approve: true}))
+	require.Equal(t, ErrProposalNotExecutable, ExecuteProposal(govCtx(addr1), approvedID))
+
+	proposal, err := c.GetProposal(govCtx(addr1), &GetProposalRequest{ProposalId: approvedID})
+	require.NoError(t, err)
+	require.Equal(t, ProposalStatus_PASSED, proposal.Proposal.Status)
+	require.Equal(t, int64(50), proposal.Proposal.ApprovePower)
+	require.Equal(t, int64(10), proposal.Proposal.RejectPower)
+	require.Equal(t, int64(60), proposal.Proposal.TotalPower)
+	proposal, err = c.GetProposal(govCtx(addr1), &GetProposalRequest{ProposalId: noQuorumID})
+	require.NoError(t, err)
+	require.Equal(t, ProposalStatus_REJECTED, proposal.Proposal.Status)
+
+	advanceTime(50)
+	ids, err = TallyProposals(govCtx(addr1))
+	require.NoError(t, err)
+	require.Equal(t, []uint64{approvedID, failedID}, ids)
+
+	require.NoError(t, ExecuteProposal(govCtx(addr1), approvedID))
+	paramsResp, err := c.GetParams(govCtx(addr1), &GetParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), paramsResp.Params.VotingPeriod)
+
+	execErr := ExecuteProposal(govCtx(addr1), failedID)
+	require.Error(t, execErr)
+	require.NoError(t, SetProposalFailed(govCtx(addr1), failedID, execErr))
+
+	ids, err = TallyProposals(govCtx(addr1))
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	list, err := c.ListProposals(govCtx(addr1), &ListProposalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Proposals, 3)
+	require.Equal(t, ProposalStatus_EXECUTED, list.Proposals[0].Status)
+	require.Equal(t, ProposalStatus_REJECTED, list.Proposals[1].Status)
+	require.Equal(t, ProposalStatus_FAILED, list.Proposals[2].Status)
+	require.NotEmpty(t, list.Proposals[2].Error)
+
+	require.True(t, IsSenderGovernance(govCtx(govAddr)))
+	require.False(t, IsSenderGovernance(govCtx(addr1)))
+}
+
+type mockDPOS struct {
+	// weighted delegation amounts, keyed by delegator & then by validator
+	delegations map[string]map[string]*loom.BigUInt
+}
+
+func (m *mockDPOS) Meta() (plugin.Meta, error) {
+	return plugin.Meta{Name: dposContractName, Version: "3.0.0"}, nil
+}
+
+func (m *mockDPOS) ListDelegations(
+	ctx contractpb.StaticContext, req *dtypes.ListDelegationsRequest,
+) (*dtypes.ListDelegationsResponse, error) {
+	validator := loom.UnmarshalAddressPB(req.Candidate)
+	var delegators []string
+	for delegator, delegations := range m.delegations {
+		if _, ok := delegations[validator.String()]; ok {
+			delegators = append(delegators, delegator)
+		}
+	}
+	sort.Strings(delegators)
+	var delegations []*dtypes.Delegation
+	for _, delegator := range delegators {
+		delegations = append(delegations, &dtypes.Delegation{
+			Validator: req.Candidate,
+			Delegator: loom.MustParseAddress(delegator).MarshalPB(),
+		})
+	}
+	return &dtypes.ListDelegationsResponse{Delegations: delegations}, nil
+}
+
+func (m *mockDPOS) CheckDelegation(
+	ctx contractpb.StaticContext, req *dtypes.CheckDelegationRequest,
+) (*dtypes.CheckDelegationResponse, error) {
+	delegator := loom.UnmarshalAddressPB(req.DelegatorAddress)
+	validator := loom.UnmarshalAddressPB(req.ValidatorAddress)
+	amount := m.delegations[delegator.String()][validator.String()]
+	return &dtypes.CheckDelegationResponse{WeightedAmount: &types.BigUInt{Value: *amount}}, nil
+}
+
+func TestGovernanceDelegatorVoting(t *testing.T) {
+	v1, addr1 := makeValidator(t, 10)
+	v2, addr2 := makeValidator(t, 20)
+	delegator := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	guest := loom.MustParseAddress("default:0x7262d4c97c7b93937e4810d289b7320e9da82857")
+
+	pctx := plugin.CreateFakeContext(addr1, addr1).WithBlock(loom.BlockHeader{
+		ChainID: chainID,
+		Time:    time.Now().Unix(),
+	}).WithValidators([]*loom.Validator{v1, v2})
+	pctx.SetFeature(features.GovernanceFeature, true)
+	// the delegator's weighted stake accounts for 5 of the validator's 20 power
+	pctx.CreateContract(contractpb.MakePluginContract(&mockDPOS{
+		delegations: map[string]map[string]*loom.BigUInt{
+			delegator.String(): {addr2.String(): loom.NewBigUIntFromInt(5000000000000)},
+		},
+	}))
+	govAddr := pctx.CreateContract(Contract)
+	govCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(govAddr).WithSender(sender))
+	}
+	c := &Governance{}
+	params := &Params{VotingPeriod: 100, Timelock: 50, Quorum: 5000, Threshold: 6000, MinProposerPower: 5}
+	require.NoError(t, c.Init(govCtx(addr1), &InitRequest{Params: params}))
+
+	call := &ContractCall{Contract: govAddr.MarshalPB(), Method: "SetParams"}
+	_, err := c.Propose(govCtx(delegator), &ProposeRequest{
+		Description: strings.Repeat("a", maxDescriptionLength+1),
+		Call:        call,
+	})
+	require.Error(t, err)
+	_, err = c.Propose(govCtx(delegator), &ProposeRequest{
+		Call: &ContractCall{
+			Contract: govAddr.MarshalPB(),
+			Method:   "SetParams",
+			Args:     make([]byte, maxCallArgsSize+1),
+		},
+	})
+	require.Error(t, err)
+	_, err = c.Propose(govCtx(guest), &ProposeRequest{Call: call})
+	require.Equal(t, ErrNoVotingPower, err)
+
+	// delegator votes after the validator
+	resp, err := c.Propose(govCtx(delegator), &ProposeRequest{Call: call})
+	require.NoError(t, err)
+	firstID := resp.ProposalId
+	require.NoError(t, c.Vote(govCtx(addr2), &VoteRequest{ProposalId: firstID, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(delegator), &VoteRequest{ProposalId: firstID, Approve: false}))
+	proposal, err := c.GetProposal(govCtx(addr1), &GetProposalRequest{ProposalId: firstID})
+	require.NoError(t, err)
+	require.Equal(t, int64(15), proposal.Proposal.ApprovePower)
+	require.Equal(t, int64(5), proposal.Proposal.RejectPower)
+	require.Equal(t, int64(30), proposal.Proposal.TotalPower)
+
+	// delegator votes before the validator
+	resp, err = c.Propose(govCtx(addr1), &ProposeRequest{Call: call})
+	require.NoError(t, err)
+	secondID := resp.ProposalId
+	require.NoError(t, c.Vote(govCtx(delegator), &VoteRequest{ProposalId: secondID, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(addr2), &VoteRequest{ProposalId: secondID, Approve: false}))
+	require.NoError(t, c.Vote(govCtx(addr1), &VoteRequest{ProposalId: secondID, Approve: true}))
+	proposal, err = c.GetProposal(govCtx(addr1), &GetProposalRequest{ProposalId: secondID})
+	require.NoError(t, err)
+	require.Equal(t, int64(15), proposal.Proposal.ApprovePower)
+	require.Equal(t, int64(15), proposal.Proposal.RejectPower)
+	require.Len(t, proposal.Proposal.Votes, 3)
+	require.Equal(t, int64(15), proposal.Proposal.Votes[1].Power)
+}
+
+func TestGovernancePowerSnapshot(t *testing.T) {
+	v1, addr1 := makeValidator(t, 10)
+	v2, addr2 := makeValidator(t, 20)
+	delegator := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	latecomer := loom.MustParseAddress("default:0x7262d4c97c7b93937e4810d289b7320e9da82857")
+
+	pctx := plugin.CreateFakeContext(addr1, addr1).WithBlock(loom.BlockHeader{
+		ChainID: chainID,
+		Time:    time.Now().Unix(),
+	}).WithValidators([]*loom.Validator{v1, v2})
+	pctx.SetFeature(features.GovernanceFeature, true)
+	dpos := &mockDPOS{
+		delegations: map[string]map[string]*loom.BigUInt{
+			delegator.String(): {addr2.String(): loom.NewBigUIntFromInt(5000000000000)},
+		},
+	}
+	pctx.CreateContract(contractpb.MakePluginContract(dpos))
+	govAddr := pctx.CreateContract(Contract)
+	govCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(govAddr).WithSender(sender))
+	}
+	c := &Governance{}
+	params := &Params{VotingPeriod: 100, Timelock: 50, Quorum: 5000, Threshold: 6000, MinProposerPower: 5}
+	require.NoError(t, c.Init(govCtx(addr1), &InitRequest{Params: params}))
+
+	call := &ContractCall{Contract: govAddr.MarshalPB(), Method: "SetParams"}
+	resp, err := c.Propose(govCtx(addr1), &ProposeRequest{Call: call})
+	require.NoError(t, err)
+	id := resp.ProposalId
+
+	// stake that's moved or added after the proposal is submitted doesn't count towards the vote
+	dpos.delegations[delegator.String()] = map[string]*loom.BigUInt{
+		addr1.String(): loom.NewBigUIntFromInt(5000000000000),
+	}
+	dpos.delegations[latecomer.String()] = map[string]*loom.BigUInt{
+		addr2.String(): loom.NewBigUIntFromInt(50000000000000),
+	}
+	require.Equal(t, ErrNoVotingPower, c.Vote(govCtx(latecomer), &VoteRequest{ProposalId: id, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(delegator), &VoteRequest{ProposalId: id, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(addr1), &VoteRequest{ProposalId: id, Approve: false}))
+	require.NoError(t, c.Vote(govCtx(addr2), &VoteRequest{ProposalId: id, Approve: false}))
+	proposal, err := c.GetProposal(govCtx(addr1), &GetProposalRequest{ProposalId: id})
+	require.NoError(t, err)
+	require.Equal(t, int64(30), proposal.Proposal.TotalPower)
+	require.Equal(t, int64(5), proposal.Proposal.ApprovePower)
+	require.Equal(t, int64(25), proposal.Proposal.RejectPower)
+	require.Equal(t, addr2.MarshalPB(), proposal.Proposal.Votes[0].DelegatedPower[0].Validator)
+
+	// the power delegators take away from a validator can't exceed the validator power
+	resp, err = c.Propose(govCtx(addr2), &ProposeRequest{Call: call})
+	require.NoError(t, err)
+	id = resp.ProposalId
+	require.NoError(t, c.Vote(govCtx(latecomer), &VoteRequest{ProposalId: id, Approve: true}))
+	require.NoError(t, c.Vote(govCtx(addr2), &VoteRequest{ProposalId: id, Approve: false}))
+	proposal, err = c.GetProposal(govCtx(addr1), &GetProposalRequest{ProposalId: id})
+	require.NoError(t, err)
+	require.Equal(t, int64(20), proposal.Proposal.ApprovePower)
+	require.Equal(t, int64(0), proposal.Proposal.RejectPower)
+}
+
+func TestGovernancePruneProposals(t *testing.T) {
+	v1, addr1 := makeValidator(t, 10)
+
+	pctx := plugin.CreateFakeContext(addr1, addr1).WithBlock(loom.BlockHeader{
+		ChainID: chainID,
+		Time:    time.Now().Unix(),
+	}).WithValidators([]*loom.Validator{v1})
+	pctx.SetFeature(features.GovernanceFeature, true)
+	govAddr := pctx.CreateContract(Contract)
+	govCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(govAddr).WithSender(sender))
+	}
+	c := &Governance{}
+	params := &Params{VotingPeriod: 100, Timelock: 50, Quorum: 5000, Threshold: 6000, MinProposerPower: 10}
+	require.NoError(t, c.Init(govCtx(addr1), &InitRequest{Params: params}))
+
+	call := &ContractCall{Contract: govAddr.MarshalPB(), Method: "SetParams"}
+	for i := 0; i < maxFinishedProposals+1; i++ {
+		_, err := c.Propose(govCtx(addr1), &ProposeRequest{Call: call})
+		require.NoError(t, err)
+	}
+	pctx.SetTime(pctx.Now().Add(100 * time.Second))
+	ids, err := TallyProposals(govCtx(addr1))
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	list, err := c.ListProposals(govCtx(addr1), &ListProposalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Proposals, maxFinishedProposals)
+	require.Equal(t, uint64(2), list.Proposals[0].Id)
+	_, err = c.GetProposal(govCtx(addr1), &GetProposalRequest{ProposalId: 1})
+	require.Equal(t, ErrProposalNotFound, err)
+}
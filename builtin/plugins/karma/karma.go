@@ -12,6 +12,7 @@ import (
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/types"
 	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
 	"github.com/pkg/errors"
 )
 
@@ -158,7 +159,7 @@ func (k *Karma) WithdrawCoin(ctx contract.Context, req *ktypes.KarmaUserAmount)
 }
 
 func (k *Karma) SetConfig(ctx contract.Context, req *ktypes.KarmaConfig) error {
-	if hasPermission, _ := ctx.HasPermission(ChangeConfigPermission, []string{oracleRole}); !hasPermission {
+	if !governance.HasPermission(ctx, ChangeConfigPermission, []string{oracleRole}) {
 		return ErrNotAuthorized
 	}
 	return SetConfig(ctx, req)
@@ -232,7 +233,7 @@ func GetUserState(ctx contract.StaticContext, userAddr loom.Address) (*ktypes.Ka
 }
 
 func (k *Karma) DeleteSourcesForUser(ctx contract.Context, ksu *ktypes.KarmaStateKeyUser) error {
-	if hasPermission, _ := ctx.HasPermission(ChangeUserSourcesPermission, []string{oracleRole}); !hasPermission {
+	if !governance.HasPermission(ctx, ChangeUserSourcesPermission, []string{oracleRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -262,7 +263,7 @@ func (k *Karma) DeleteSourcesForUser(ctx contract.Context, ksu *ktypes.KarmaStat
 }
 
 func (k *Karma) ResetSources(ctx contract.Context, kpo *ktypes.KarmaSources) error {
-	if hasPermission, _ := ctx.HasPermission(ResetSourcesPermission, []string{oracleRole}); !hasPermission {
+	if !governance.HasPermission(ctx, ResetSourcesPermission, []string{oracleRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -280,16 +281,26 @@ func SetAllowedKarmaSources(ctx contract.Context, sources []*ktypes.KarmaSourceR
 }
 
 func (k *Karma) UpdateOracle(ctx contract.Context, params *ktypes.KarmaNewOracle) error {
-	if hasPermission, _ := ctx.HasPermission(ChangeOraclePermission, []string{oracleRole}); !hasPermission {
+	if !governance.HasPermission(ctx, ChangeOraclePermission, []string{oracleRole}) {
 		return ErrNotAuthorized
 	}
 
 	currentOracle := ctx.Message().Sender
+	if governance.IsSenderGovernance(ctx) {
+		// the permissions of the current oracle must be revoked when it's replaced by a proposal
+		oracleAddr, err := GetOracleAddress(ctx)
+		if err != nil {
+			return err
+		}
+		if oracleAddr != nil {
+			currentOracle = *oracleAddr
+		}
+	}
 	return k.registerOracle(ctx, params.NewOracle, &currentOracle)
 }
 
 func (k *Karma) AddKarma(ctx contract.Context, req *ktypes.AddKarmaRequest) error {
-	if hasPermission, _ := ctx.HasPermission(ChangeUserSourcesPermission, []string{oracleRole}); !hasPermission {
+	if !governance.HasPermission(ctx, ChangeUserSourcesPermission, []string{oracleRole}) {
 		return ErrNotAuthorized
 	}
 
@@ -11,6 +11,7 @@ import (
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	"github.com/loomnetwork/go-loom/types"
 	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
 	"github.com/loomnetwork/loomchain/log"
 	"github.com/pkg/errors"
 )
@@ -46,7 +47,7 @@ func ContractActiveKey(owner loom.Address, contractId uint64) ([]byte, error) {
 }
 
 func (k *Karma) SetUpkeepParams(ctx contract.Context, params *ktypes.KarmaUpkeepParams) error {
-	if hasPermission, _ := ctx.HasPermission(SetUpkeepPermission, []string{oracleRole}); !hasPermission {
+	if !governance.HasPermission(ctx, SetUpkeepPermission, []string{oracleRole}) {
 		return ErrNotAuthorized
 	}
 	var oldParams ktypes.KarmaUpkeepParams
@@ -0,0 +1,69 @@
+pragma solidity ^0.4.24;
+
+// ERC20 mirror of the LiquidStaking contract receipt token. Tokens can only be minted & burned by
+// the LiquidStaking Go contract, which does so when receipt tokens are moved into & out of the EVM
+// via the MirrorToEVM & MirrorFromEVM methods.
+contract StakedLoomMirror {
+    string public constant name = "Staked LOOM";
+    string public constant symbol = "sLOOM";
+    uint8 public constant decimals = 18;
+
+    // Address of the LiquidStaking Go contract
+    address public minter;
+    uint256 public totalSupply;
+
+    mapping(address => uint256) public balanceOf;
+    mapping(address => mapping(address => uint256)) public allowance;
+
+    event Transfer(address indexed from, address indexed to, uint256 value);
+    event Approval(address indexed owner, address indexed spender, uint256 value);
+
+    constructor(address _minter) public {
+        minter = _minter;
+    }
+
+    modifier onlyMinter() {
+        require(msg.sender == minter, "only the minter can do this");
+        _;
+    }
+
+    function transfer(address to, uint256 value) public returns (bool) {
+        _transfer(msg.sender, to, value);
+        return true;
+    }
+
+    function approve(address spender, uint256 value) public returns (bool) {
+        allowance[msg.sender][spender] = value;
+        emit Approval(msg.sender, spender, value);
+        return true;
+    }
+
+    function transferFrom(address from, address to, uint256 value) public returns (bool) {
+        require(allowance[from][msg.sender] >= value, "amount is over spender's limit");
+        allowance[from][msg.sender] -= value;
+        _transfer(from, to, value);
+        return true;
+    }
+
+    function mint(address to, uint256 amount) public onlyMinter {
+        require(totalSupply + amount >= totalSupply, "overflow");
+        totalSupply += amount;
+        balanceOf[to] += amount;
+        emit Transfer(address(0), to, amount);
+    }
+
+    function burn(address from, uint256 amount) public onlyMinter {
+        require(balanceOf[from] >= amount, "balance is too low");
+        balanceOf[from] -= amount;
+        totalSupply -= amount;
+        emit Transfer(from, address(0), amount);
+    }
+
+    function _transfer(address from, address to, uint256 value) internal {
+        require(to != address(0), "invalid recipient");
+        require(balanceOf[from] >= value, "balance is too low");
+        balanceOf[from] -= value;
+        balanceOf[to] += value;
+        emit Transfer(from, to, value);
+    }
+}
@@ -0,0 +1,45 @@
+// +build evm
+
+package liquid_staking
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/pkg/errors"
+)
+
+// ABI of the mint & burn functions of the EVM mirror, see StakedLoomMirror.sol
+const mirrorABI = `[
+	{"constant":false,"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],
+	 "name":"mint","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"},
+	{"constant":false,"inputs":[{"name":"from","type":"address"},{"name":"amount","type":"uint256"}],
+	 "name":"burn","outputs":[],"payable":false,"stateMutability":"nonpayable","type":"function"}
+]`
+
+func mintOnEVM(ctx contract.Context, mirror, to loom.Address, amount *loom.BigUInt) error {
+	return callMirror(ctx, mirror, "mint", to, amount)
+}
+
+func burnOnEVM(ctx contract.Context, mirror, from loom.Address, amount *loom.BigUInt) error {
+	return callMirror(ctx, mirror, "burn", from, amount)
+}
+
+func callMirror(ctx contract.Context, mirror loom.Address, method string, account loom.Address, amount *loom.BigUInt) error {
+	parsedABI, err := abi.JSON(strings.NewReader(mirrorABI))
+	if err != nil {
+		return err
+	}
+	input, err := parsedABI.Pack(method, common.BytesToAddress(account.Local), amount.Int)
+	if err != nil {
+		return err
+	}
+	var output []byte
+	if err := contract.CallEVM(ctx, mirror, input, &output); err != nil {
+		return errors.Wrapf(err, "failed to call %s on EVM mirror", method)
+	}
+	return nil
+}
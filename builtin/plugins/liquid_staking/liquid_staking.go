@@ -0,0 +1,809 @@
+package liquid_staking
+
+import (
+	"encoding/binary"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	"github.com/loomnetwork/go-loom/plugin"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
+	"github.com/pkg/errors"
+)
+
+const (
+	// ContractName is the name the LiquidStaking contract is registered under.
+	ContractName = "liquid-staking"
+
+	StakeEventTopic  = "liquid-staking:stake"
+	RedeemEventTopic = "liquid-staking:redeem"
+
+	ownerRole = "owner"
+)
+
+var (
+	// ErrNotAuthorized indicates that a contract method failed because the caller didn't have
+	// the permission to execute that method.
+	ErrNotAuthorized = errors.New("[LiquidStaking] not authorized")
+	// ErrInvalidRequest is a generic error that's returned when something is wrong with the
+	// request message, e.g. missing or invalid fields.
+	ErrInvalidRequest = errors.New("[LiquidStaking] invalid request")
+	// ErrOwnerNotSpecified is returned if the init request doesn't have an owner address.
+	ErrOwnerNotSpecified = errors.New("[LiquidStaking] owner not specified")
+	// ErrRedemptionNotFound is returned if a redemption with the given ID doesn't exist.
+	ErrRedemptionNotFound = errors.New("[LiquidStaking] redemption not found")
+	// ErrRedemptionPending is returned when attempting to withdraw LOOM that hasn't been unbonded yet.
+	ErrRedemptionPending = errors.New("[LiquidStaking] redemption is pending until the next election")
+	// ErrNoUnbondableDelegation is returned when the delegations held by the contract that can be
+	// unbonded don't have enough stake to cover a redemption.
+	ErrNoUnbondableDelegation = errors.New("[LiquidStaking] not enough unlocked stake")
+	// ErrNothingStaked is returned when receipt tokens are in circulation but the stake backing
+	// them has been slashed away entirely.
+	ErrNothingStaked = errors.New("[LiquidStaking] nothing staked")
+	// ErrEVMMirrorNotSet is returned if the EVM mirror contract hasn't been set yet.
+	ErrEVMMirrorNotSet = errors.New("[LiquidStaking] EVM mirror not set")
+)
+
+var (
+	validatorsKey        = []byte("validators")
+	redemptionCounterKey = []byte("redemption-counter")
+	evmMirrorKey         = []byte("evm-mirror")
+	redemptionPrefix     = []byte("redemption")
+	pendingTotalsPrefix  = []byte("pending")
+	modifyPerm           = []byte("modp")
+)
+
+func redemptionKey(id uint64) []byte {
+	idBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(idBytes, id)
+	return util.PrefixKey(redemptionPrefix, idBytes)
+}
+
+func pendingTotalsKey(electionTime int64) []byte {
+	timeBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(timeBytes, uint64(electionTime))
+	return util.PrefixKey(pendingTotalsPrefix, timeBytes)
+}
+
+// LiquidStaking contract delegates LOOM to DPOSv3 validators on behalf of stakers, and mints a
+// transferable receipt token for each delegation. Rewards earned by the delegations increase the
+// amount of LOOM each receipt token can be redeemed for, the rewards are moved from the reward
+// delegations into regular delegations by CompoundRewards. Receipt tokens are redeemed by
+// unbonding the corresponding amount of LOOM from the delegations held by the contract, the LOOM
+// can be withdrawn once the unbonding completes in the next election. Redeemed LOOM remains
+// exposed to slashing until it's withdrawn, so losses are shared by all the receipt token holders.
+type LiquidStaking struct {
+}
+
+func (ls *LiquidStaking) Meta() (plugin.Meta, error) {
+	return plugin.Meta{
+		Name:    ContractName,
+		Version: "1.0.0",
+	}, nil
+}
+
+func (ls *LiquidStaking) Init(ctx contract.Context, req *InitRequest) error {
+	if req.Owner == nil {
+		return ErrOwnerNotSpecified
+	}
+	ownerAddr := loom.UnmarshalAddressPB(req.Owner)
+	ctx.GrantPermissionTo(ownerAddr, modifyPerm, ownerRole)
+	return nil
+}
+
+// Stake transfers LOOM from the sender to the contract, delegates it to the given validator, and
+// mints receipt tokens for the sender. The sender must approve the LOOM transfer beforehand.
+func (ls *LiquidStaking) Stake(ctx contract.Context, req *StakeRequest) (*StakeResponse, error) {
+	if req.ValidatorAddress == nil || req.Amount == nil || !common.IsPositive(req.Amount.Value) {
+		return nil, ErrInvalidRequest
+	}
+
+	coinAddr, dposAddr, err := resolveContracts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The receipt tokens have to be priced before the new delegation is made
+	p, err := loadPool(ctx, coinAddr, dposAddr)
+	if err != nil {
+		return nil, err
+	}
+	minted, err := receiptsForStake(&req.Amount.Value, p.value(), p.shares())
+	if err != nil {
+		return nil, err
+	}
+	if common.IsZero(*minted) {
+		return nil, errors.Wrap(ErrInvalidRequest, "amount is too small")
+	}
+
+	staker := ctx.Message().Sender
+	coin := &dposv3.ERC20{Context: ctx, ContractAddress: coinAddr}
+	if err := coin.TransferFrom(staker, ctx.ContractAddress(), &req.Amount.Value); err != nil {
+		return nil, errors.Wrap(err, "failed to transfer LOOM to contract")
+	}
+	if err := coin.Approve(dposAddr, &req.Amount.Value); err != nil {
+		return nil, errors.Wrap(err, "failed to approve DPOS contract")
+	}
+	delegateReq := &dposv3.DelegateRequest{
+		ValidatorAddress: req.ValidatorAddress,
+		Amount:           req.Amount,
+	}
+	if err := contract.CallMethod(ctx, dposAddr, "Delegate", delegateReq, nil); err != nil {
+		return nil, errors.Wrap(err, "failed to delegate LOOM")
+	}
+
+	if err := addValidator(ctx, req.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := mint(ctx, staker, minted); err != nil {
+		return nil, err
+	}
+
+	mintedPB := &types.BigUInt{Value: *minted}
+	if err := emitEvent(ctx, &StakeEvent{
+		Staker:    staker.MarshalPB(),
+		Validator: req.ValidatorAddress,
+		Amount:    req.Amount,
+		Minted:    mintedPB,
+	}, StakeEventTopic); err != nil {
+		return nil, err
+	}
+
+	return &StakeResponse{Minted: mintedPB}, nil
+}
+
+// Redeem burns the given amount of the sender's receipt tokens and unbonds the LOOM they're worth
+// from the delegations held by the contract, optionally limited to a single validator. The unbonded
+// LOOM can be withdrawn by calling Withdraw after the next election.
+func (ls *LiquidStaking) Redeem(ctx contract.Context, req *RedeemRequest) (*RedeemResponse, error) {
+	if req.Amount == nil || !common.IsPositive(req.Amount.Value) {
+		return nil, ErrInvalidRequest
+	}
+
+	owner := ctx.Message().Sender
+	account, err := loadAccount(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if account.Balance.Value.Cmp(&req.Amount.Value) < 0 {
+		return nil, ErrSenderBalanceTooLow
+	}
+
+	coinAddr, dposAddr, err := resolveContracts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := loadPool(ctx, coinAddr, dposAddr)
+	if err != nil {
+		return nil, err
+	}
+	amount := stakeForReceipts(&req.Amount.Value, p.value(), p.shares())
+	if common.IsZero(*amount) {
+		return nil, errors.Wrap(ErrInvalidRequest, "amount is too small")
+	}
+
+	parts, err := unbondStake(ctx, dposAddr, req.ValidatorAddress, amount)
+	if err != nil {
+		return nil, err
+	}
+	if err := burn(ctx, owner, &req.Amount.Value); err != nil {
+		return nil, err
+	}
+
+	id, err := nextRedemptionID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	amountPB := &types.BigUInt{Value: *amount}
+	redemption := &Redemption{
+		Id:           id,
+		Owner:        owner.MarshalPB(),
+		Amount:       amountPB,
+		ElectionTime: p.lastElectionTime,
+		Receipts:     req.Amount,
+		Parts:        parts,
+	}
+	if err := ctx.Set(redemptionKey(id), redemption); err != nil {
+		return nil, err
+	}
+	if err := updatePendingTotals(ctx, redemption, false); err != nil {
+		return nil, err
+	}
+
+	if err := emitEvent(ctx, &RedeemEvent{
+		Owner:        owner.MarshalPB(),
+		Validator:    req.ValidatorAddress,
+		RedemptionId: id,
+		Burned:       req.Amount,
+		Amount:       amountPB,
+	}, RedeemEventTopic); err != nil {
+		return nil, err
+	}
+
+	return &RedeemResponse{RedemptionId: id, Amount: amountPB}, nil
+}
+
+// Withdraw transfers the LOOM unbonded by a redemption to the owner of the redemption, this can
+// only be done after the election following the redemption. The burned receipt tokens are valued
+// at the current exchange rate, so if the stake held by the contract was slashed since the
+// redemption the owner receives less than the unbonded amount, and the difference is retained by
+// the contract.
+func (ls *LiquidStaking) Withdraw(ctx contract.Context, req *WithdrawRequest) (*WithdrawResponse, error) {
+	var redemption Redemption
+	if err := ctx.Get(redemptionKey(req.RedemptionId), &redemption); err != nil {
+		if err == contract.ErrNotFound {
+			return nil, ErrRedemptionNotFound
+		}
+		return nil, err
+	}
+
+	owner := loom.UnmarshalAddressPB(redemption.Owner)
+	if owner.Compare(ctx.Message().Sender) != 0 {
+		return nil, ErrNotAuthorized
+	}
+
+	coinAddr, dposAddr, err := resolveContracts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := loadPool(ctx, coinAddr, dposAddr)
+	if err != nil {
+		return nil, err
+	}
+	if p.lastElectionTime <= redemption.ElectionTime {
+		return nil, ErrRedemptionPending
+	}
+
+	amount := stakeForReceipts(&redemption.Receipts.Value, p.value(), p.shares())
+	if amount.Cmp(&redemption.Amount.Value) > 0 {
+		amount = &redemption.Amount.Value
+	}
+
+	ctx.Delete(redemptionKey(req.RedemptionId))
+	if err := updatePendingTotals(ctx, &redemption, true); err != nil {
+		return nil, err
+	}
+	coin := &dposv3.ERC20{Context: ctx, ContractAddress: coinAddr}
+	if err := coin.Transfer(owner, amount); err != nil {
+		return nil, errors.Wrap(err, "failed to transfer LOOM to owner")
+	}
+	return &WithdrawResponse{Amount: &types.BigUInt{Value: *amount}}, nil
+}
+
+// CompoundRewards delegates the rewards claimed by the previous call, and claims the rewards that
+// have accrued to the contract's reward delegations since then. Claimed rewards are returned to
+// the contract in the next election. Anyone can call this method, it doesn't change the exchange
+// rate, but it allows the rewards to be redeemed.
+func (ls *LiquidStaking) CompoundRewards(
+	ctx contract.Context, req *CompoundRewardsRequest,
+) (*CompoundRewardsResponse, error) {
+	coinAddr, dposAddr, err := resolveContracts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := loadPool(ctx, coinAddr, dposAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if common.IsPositive(*p.idle) {
+		validator := req.ValidatorAddress
+		if validator == nil {
+			validators, err := loadValidators(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if len(validators.Validators) == 0 {
+				return nil, errors.Wrap(ErrInvalidRequest, "validator not specified")
+			}
+			validator = validators.Validators[0]
+		}
+		coin := &dposv3.ERC20{Context: ctx, ContractAddress: coinAddr}
+		if err := coin.Approve(dposAddr, p.idle); err != nil {
+			return nil, errors.Wrap(err, "failed to approve DPOS contract")
+		}
+		delegateReq := &dposv3.DelegateRequest{
+			ValidatorAddress: validator,
+			Amount:           &types.BigUInt{Value: *p.idle},
+		}
+		if err := contract.CallMethod(ctx, dposAddr, "Delegate", delegateReq, nil); err != nil {
+			return nil, errors.Wrap(err, "failed to delegate rewards")
+		}
+		if err := addValidator(ctx, validator); err != nil {
+			return nil, err
+		}
+	}
+
+	var claimResp dposv3.ClaimDelegatorRewardsResponse
+	err = contract.CallMethod(
+		ctx, dposAddr, "ClaimRewardsFromAllValidators", &dposv3.ClaimDelegatorRewardsRequest{}, &claimResp,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim rewards")
+	}
+	return &CompoundRewardsResponse{
+		Claimed:   claimResp.Amount,
+		Delegated: &types.BigUInt{Value: *p.idle},
+	}, nil
+}
+
+// ListRedemptions returns the redemptions that haven't been withdrawn yet, optionally filtered by
+// owner.
+func (ls *LiquidStaking) ListRedemptions(
+	ctx contract.StaticContext, req *ListRedemptionsRequest,
+) (*ListRedemptionsResponse, error) {
+	var owner loom.Address
+	if req.Owner != nil {
+		owner = loom.UnmarshalAddressPB(req.Owner)
+	}
+
+	redemptions := []*Redemption{}
+	for _, entry := range ctx.Range(redemptionPrefix) {
+		var redemption Redemption
+		if err := proto.Unmarshal(entry.Value, &redemption); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal redemption")
+		}
+		if req.Owner != nil && owner.Compare(loom.UnmarshalAddressPB(redemption.Owner)) != 0 {
+			continue
+		}
+		redemptions = append(redemptions, &redemption)
+	}
+	return &ListRedemptionsResponse{Redemptions: redemptions}, nil
+}
+
+// GetExchangeRate returns the amount of LOOM backing the receipt tokens & the receipt token supply.
+func (ls *LiquidStaking) GetExchangeRate(
+	ctx contract.StaticContext, req *GetExchangeRateRequest,
+) (*GetExchangeRateResponse, error) {
+	coinAddr, dposAddr, err := resolveContracts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p, err := loadPool(ctx, coinAddr, dposAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &GetExchangeRateResponse{
+		TotalStaked: &types.BigUInt{Value: *p.value()},
+		TotalSupply: &types.BigUInt{Value: *p.shares()},
+	}, nil
+}
+
+// MigrateValidatorAddress is called by the DPOSv3 contract when a validator's key is rotated, which
+// moves the validator to a new address. DPOS has already moved the contract's delegations to the
+// new address, so the contract's validator list & pending redemptions are updated to match.
+func (ls *LiquidStaking) MigrateValidatorAddress(
+	ctx contract.Context, req *dposv3.MigrateValidatorAddressRequest,
+) error {
+	_, dposAddr, err := resolveContracts(ctx)
+	if err != nil {
+		return err
+	}
+	if ctx.Message().Sender.Compare(dposAddr) != 0 {
+		return ErrNotAuthorized
+	}
+	if req.OldAddress == nil || req.NewAddress == nil {
+		return ErrInvalidRequest
+	}
+	oldAddr := loom.UnmarshalAddressPB(req.OldAddress)
+
+	validators, err := loadValidators(ctx)
+	if err != nil {
+		return err
+	}
+	for i, v := range validators.Validators {
+		if oldAddr.Compare(loom.UnmarshalAddressPB(v)) == 0 {
+			validators.Validators[i] = req.NewAddress
+			if err := ctx.Set(validatorsKey, validators); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	for _, entry := range ctx.Range(redemptionPrefix) {
+		var redemption Redemption
+		if err := proto.Unmarshal(entry.Value, &redemption); err != nil {
+			return errors.Wrap(err, "failed to unmarshal redemption")
+		}
+		changed := false
+		for _, part := range redemption.Parts {
+			if oldAddr.Compare(loom.UnmarshalAddressPB(part.Validator)) == 0 {
+				part.Validator = req.NewAddress
+				changed = true
+			}
+		}
+		if changed {
+			if err := ctx.Set(redemptionKey(redemption.Id), &redemption); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SetEVMMirror sets the address of the ERC20 contract that mirrors the receipt token in the EVM,
+// only the contract owner or the Governance contract can change the mirror.
+func (ls *LiquidStaking) SetEVMMirror(ctx contract.Context, req *SetEVMMirrorRequest) error {
+	if !governance.HasPermission(ctx, modifyPerm, []string{ownerRole}) {
+		return ErrNotAuthorized
+	}
+	if req.Contract == nil {
+		return ErrInvalidRequest
+	}
+	return ctx.Set(evmMirrorKey, req.Contract)
+}
+
+func (ls *LiquidStaking) GetEVMMirror(
+	ctx contract.StaticContext, req *GetEVMMirrorRequest,
+) (*GetEVMMirrorResponse, error) {
+	var mirror types.Address
+	if err := ctx.Get(evmMirrorKey, &mirror); err != nil {
+		if err == contract.ErrNotFound {
+			return nil, ErrEVMMirrorNotSet
+		}
+		return nil, err
+	}
+	return &GetEVMMirrorResponse{Contract: &mirror}, nil
+}
+
+// MirrorToEVM locks the given amount of the sender's receipt tokens in the contract, and mints the
+// same amount of ERC20 tokens for the sender in the EVM mirror.
+func (ls *LiquidStaking) MirrorToEVM(ctx contract.Context, req *MirrorRequest) error {
+	if req.Amount == nil || !common.IsPositive(req.Amount.Value) {
+		return ErrInvalidRequest
+	}
+	mirror, err := loadEVMMirror(ctx)
+	if err != nil {
+		return err
+	}
+	sender := ctx.Message().Sender
+	if err := transfer(ctx, sender, ctx.ContractAddress(), &req.Amount.Value); err != nil {
+		return err
+	}
+	return mintOnEVM(ctx, mirror, sender, &req.Amount.Value)
+}
+
+// MirrorFromEVM burns the given amount of the sender's ERC20 tokens in the EVM mirror, and unlocks
+// the same amount of receipt tokens for the sender.
+func (ls *LiquidStaking) MirrorFromEVM(ctx contract.Context, req *MirrorRequest) error {
+	if req.Amount == nil || !common.IsPositive(req.Amount.Value) {
+		return ErrInvalidRequest
+	}
+	mirror, err := loadEVMMirror(ctx)
+	if err != nil {
+		return err
+	}
+	sender := ctx.Message().Sender
+	if err := burnOnEVM(ctx, mirror, sender, &req.Amount.Value); err != nil {
+		return err
+	}
+	return transfer(ctx, ctx.ContractAddress(), sender, &req.Amount.Value)
+}
+
+// pool is a snapshot of the LOOM backing the receipt tokens.
+type pool struct {
+	// LOOM delegated by the contract, including rewards, but excluding LOOM being unbonded for
+	// redemptions.
+	staked *loom.BigUInt
+	// LOOM held by the contract that doesn't belong to any redemption, i.e. claimed rewards that
+	// haven't been delegated yet, and LOOM retained from redemptions after a slash.
+	idle *loom.BigUInt
+	// Totals of the redemptions that haven't been withdrawn yet.
+	pendingAmount   *loom.BigUInt
+	pendingReceipts *loom.BigUInt
+	supply          *loom.BigUInt
+
+	lastElectionTime int64
+}
+
+// Returns the amount of LOOM backing the receipt tokens, including the receipt tokens burned by
+// pending redemptions.
+func (p *pool) value() *loom.BigUInt {
+	total := common.BigZero()
+	total.Add(p.staked, p.idle)
+	total.Add(total, p.pendingAmount)
+	return total
+}
+
+// Returns the amount of receipt tokens in circulation, plus the receipt tokens burned by pending
+// redemptions.
+func (p *pool) shares() *loom.BigUInt {
+	total := common.BigZero()
+	total.Add(p.supply, p.pendingReceipts)
+	return total
+}
+
+func loadPool(ctx contract.StaticContext, coinAddr, dposAddr loom.Address) (*pool, error) {
+	staked, err := TotalStaked(ctx, dposAddr)
+	if err != nil {
+		return nil, err
+	}
+	supply, err := loadTotalSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var stateResp dposv3.GetStateResponse
+	if err := contract.StaticCallMethod(ctx, dposAddr, "GetState", &dposv3.GetStateRequest{}, &stateResp); err != nil {
+		return nil, errors.Wrap(err, "failed to load DPOS state")
+	}
+	coin := &dposv3.ERC20Static{StaticContext: ctx, ContractAddress: coinAddr}
+	balance, err := coin.BalanceOf(ctx.ContractAddress())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load LOOM balance")
+	}
+
+	p := &pool{
+		staked:           staked,
+		idle:             common.BigZero(),
+		pendingAmount:    common.BigZero(),
+		pendingReceipts:  common.BigZero(),
+		supply:           supply,
+		lastElectionTime: stateResp.State.LastElectionTime,
+	}
+	// LOOM unbonded for redemptions is returned to the contract in the election that follows
+	// the redemption
+	returned := common.BigZero()
+	for _, entry := range ctx.Range(pendingTotalsPrefix) {
+		var totals RedemptionTotals
+		if err := proto.Unmarshal(entry.Value, &totals); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal redemption totals")
+		}
+		p.pendingAmount.Add(p.pendingAmount, &totals.Amount.Value)
+		p.pendingReceipts.Add(p.pendingReceipts, &totals.Receipts.Value)
+		if totals.ElectionTime < p.lastElectionTime {
+			returned.Add(returned, &totals.Amount.Value)
+		}
+	}
+	if balance.Cmp(returned) > 0 {
+		p.idle.Sub(balance, returned)
+	}
+	return p, nil
+}
+
+// Adds a redemption to the totals of the pending redemptions, or removes it.
+func updatePendingTotals(ctx contract.Context, redemption *Redemption, remove bool) error {
+	key := pendingTotalsKey(redemption.ElectionTime)
+	totals := RedemptionTotals{
+		ElectionTime: redemption.ElectionTime,
+		Amount:       loom.BigZeroPB(),
+		Receipts:     loom.BigZeroPB(),
+	}
+	if err := ctx.Get(key, &totals); err != nil && err != contract.ErrNotFound {
+		return errors.Wrap(err, "failed to load redemption totals")
+	}
+	amount := common.BigZero()
+	receipts := common.BigZero()
+	if remove {
+		amount.Sub(&totals.Amount.Value, &redemption.Amount.Value)
+		receipts.Sub(&totals.Receipts.Value, &redemption.Receipts.Value)
+	} else {
+		amount.Add(&totals.Amount.Value, &redemption.Amount.Value)
+		receipts.Add(&totals.Receipts.Value, &redemption.Receipts.Value)
+	}
+	if common.IsZero(*amount) && common.IsZero(*receipts) {
+		ctx.Delete(key)
+		return nil
+	}
+	totals.Amount = &types.BigUInt{Value: *amount}
+	totals.Receipts = &types.BigUInt{Value: *receipts}
+	return ctx.Set(key, &totals)
+}
+
+// TotalStaked returns the amount of LOOM the contract has staked with DPOS, including any rewards
+// that have accrued to the contract's reward delegations, but excluding LOOM that's being unbonded
+// for redemptions. Rewards that are being claimed are included since they're returned to the
+// contract.
+func TotalStaked(ctx contract.StaticContext, dposAddr loom.Address) (*loom.BigUInt, error) {
+	validators, err := loadValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	total := common.BigZero()
+	for _, validator := range validators.Validators {
+		delegations, err := loadDelegations(ctx, dposAddr, validator)
+		if err != nil {
+			return nil, err
+		}
+		for _, delegation := range delegations {
+			if delegation.Index == dposv3.REWARD_DELEGATION_INDEX {
+				total.Add(total, &delegation.Amount.Value)
+				continue
+			}
+			total.Add(total, stakedAmount(delegation))
+		}
+	}
+	return total, nil
+}
+
+// Returns the amount of a delegation that will remain staked after the next election.
+func stakedAmount(delegation *dposv3.Delegation) *loom.BigUInt {
+	amount := common.BigZero()
+	amount.Add(amount, &delegation.Amount.Value)
+	switch delegation.State {
+	case dposv3.BONDING:
+		amount.Add(amount, &delegation.UpdateAmount.Value)
+	case dposv3.UNBONDING:
+		amount.Sub(amount, &delegation.UpdateAmount.Value)
+	}
+	return amount
+}
+
+// Returns the amount of receipt tokens that should be minted for the given amount of LOOM.
+func receiptsForStake(amount, totalStaked, supply *loom.BigUInt) (*loom.BigUInt, error) {
+	if common.IsZero(*supply) {
+		return amount, nil
+	}
+	if !common.IsPositive(*totalStaked) {
+		return nil, ErrNothingStaked
+	}
+	receipts := common.BigZero()
+	receipts.Mul(amount, supply)
+	receipts.Div(receipts, totalStaked)
+	return receipts, nil
+}
+
+// Returns the amount of LOOM the given amount of receipt tokens can be redeemed for.
+func stakeForReceipts(receipts, totalStaked, supply *loom.BigUInt) *loom.BigUInt {
+	amount := common.BigZero()
+	if common.IsZero(*supply) || !common.IsPositive(*totalStaked) {
+		return amount
+	}
+	amount.Mul(receipts, totalStaked)
+	amount.Div(amount, supply)
+	return amount
+}
+
+// Unbonds the given amount of LOOM from the bonded & unlocked delegations held by the contract,
+// spreading it across as many delegations as needed. Reward delegations are left alone, rewards
+// are claimed by CompoundRewards.
+func unbondStake(
+	ctx contract.Context, dposAddr loom.Address, validator *types.Address, amount *loom.BigUInt,
+) ([]*RedemptionPart, error) {
+	var validators []*types.Address
+	if validator != nil {
+		validators = []*types.Address{validator}
+	} else {
+		list, err := loadValidators(ctx)
+		if err != nil {
+			return nil, err
+		}
+		validators = list.Validators
+	}
+
+	remaining := common.BigZero()
+	remaining.Add(remaining, amount)
+	now := uint64(ctx.Now().Unix())
+	var parts []*RedemptionPart
+	for _, v := range validators {
+		delegations, err := loadDelegations(ctx, dposAddr, v)
+		if err != nil {
+			return nil, err
+		}
+		for _, delegation := range delegations {
+			if common.IsZero(*remaining) {
+				break
+			}
+			if delegation.Index == dposv3.REWARD_DELEGATION_INDEX ||
+				delegation.State != dposv3.BONDED ||
+				delegation.LockTime > now ||
+				!common.IsPositive(delegation.Amount.Value) {
+				continue
+			}
+			partAmount := common.BigZero()
+			if delegation.Amount.Value.Cmp(remaining) < 0 {
+				partAmount.Add(partAmount, &delegation.Amount.Value)
+			} else {
+				partAmount.Add(partAmount, remaining)
+			}
+			partAmountPB := &types.BigUInt{Value: *partAmount}
+			unbondReq := &dposv3.UnbondRequest{
+				ValidatorAddress: v,
+				Amount:           partAmountPB,
+				Index:            delegation.Index,
+			}
+			if err := contract.CallMethod(ctx, dposAddr, "Unbond", unbondReq, nil); err != nil {
+				return nil, errors.Wrap(err, "failed to unbond LOOM")
+			}
+			parts = append(parts, &RedemptionPart{
+				Validator:       v,
+				DelegationIndex: delegation.Index,
+				Amount:          partAmountPB,
+			})
+			remaining.Sub(remaining, partAmount)
+		}
+	}
+	if !common.IsZero(*remaining) {
+		return nil, ErrNoUnbondableDelegation
+	}
+	return parts, nil
+}
+
+// Returns the delegations the contract holds with the given validator.
+func loadDelegations(
+	ctx contract.StaticContext, dposAddr loom.Address, validator *types.Address,
+) ([]*dposv3.Delegation, error) {
+	var resp dposv3.CheckDelegationResponse
+	req := &dposv3.CheckDelegationRequest{
+		ValidatorAddress: validator,
+		DelegatorAddress: ctx.ContractAddress().MarshalPB(),
+	}
+	if err := contract.StaticCallMethod(ctx, dposAddr, "CheckDelegation", req, &resp); err != nil {
+		return nil, errors.Wrap(err, "failed to check delegations")
+	}
+	return resp.Delegations, nil
+}
+
+func resolveContracts(ctx contract.StaticContext) (coinAddr, dposAddr loom.Address, err error) {
+	coinAddr, err = ctx.Resolve("coin")
+	if err != nil {
+		return coinAddr, dposAddr, errors.Wrap(err, "failed to resolve coin contract")
+	}
+	dposAddr, err = ctx.Resolve("dposV3")
+	if err != nil {
+		return coinAddr, dposAddr, errors.Wrap(err, "failed to resolve DPOS contract")
+	}
+	return coinAddr, dposAddr, nil
+}
+
+func loadValidators(ctx contract.StaticContext) (*ValidatorList, error) {
+	var validators ValidatorList
+	if err := ctx.Get(validatorsKey, &validators); err != nil && err != contract.ErrNotFound {
+		return nil, errors.Wrap(err, "failed to load validators")
+	}
+	return &validators, nil
+}
+
+func addValidator(ctx contract.Context, validator *types.Address) error {
+	validators, err := loadValidators(ctx)
+	if err != nil {
+		return err
+	}
+	addr := loom.UnmarshalAddressPB(validator)
+	for _, v := range validators.Validators {
+		if addr.Compare(loom.UnmarshalAddressPB(v)) == 0 {
+			return nil
+		}
+	}
+	validators.Validators = append(validators.Validators, validator)
+	return ctx.Set(validatorsKey, validators)
+}
+
+func nextRedemptionID(ctx contract.Context) (uint64, error) {
+	var counter RedemptionCounter
+	if err := ctx.Get(redemptionCounterKey, &counter); err != nil && err != contract.ErrNotFound {
+		return 0, err
+	}
+	counter.LastId++
+	if err := ctx.Set(redemptionCounterKey, &counter); err != nil {
+		return 0, err
+	}
+	return counter.LastId, nil
+}
+
+func loadEVMMirror(ctx contract.StaticContext) (loom.Address, error) {
+	var mirror types.Address
+	if err := ctx.Get(evmMirrorKey, &mirror); err != nil {
+		if err == contract.ErrNotFound {
+			return loom.Address{}, ErrEVMMirrorNotSet
+		}
+		return loom.Address{}, err
+	}
+	return loom.UnmarshalAddressPB(&mirror), nil
+}
+
+func emitEvent(ctx contract.Context, event proto.Message, topic string) error {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	ctx.EmitTopics(data, topic)
+	return nil
+}
+
+var Contract plugin.Contract = contract.MakePluginContract(&LiquidStaking{})
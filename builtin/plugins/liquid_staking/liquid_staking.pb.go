@@ -0,0 +1,1169 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/liquid_staking/liquid_staking.proto
+
+package liquid_staking
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/loomnetwork/go-loom/types"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type InitRequest struct {
+	Owner                *types.Address `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitRequest) Reset()         { *m = InitRequest{} }
+func (m *InitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitRequest) ProtoMessage()    {}
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{0}
+}
+func (m *InitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitRequest.Unmarshal(m, b)
+}
+func (m *InitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitRequest.Merge(m, src)
+}
+func (m *InitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitRequest.Size(m)
+}
+func (m *InitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitRequest proto.InternalMessageInfo
+
+func (m *InitRequest) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+type ValidatorList struct {
+	Validators           []*types.Address `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ValidatorList) Reset()         { *m = ValidatorList{} }
+func (m *ValidatorList) String() string { return proto.CompactTextString(m) }
+func (*ValidatorList) ProtoMessage()    {}
+func (*ValidatorList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{1}
+}
+func (m *ValidatorList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorList.Unmarshal(m, b)
+}
+func (m *ValidatorList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorList.Marshal(b, m, deterministic)
+}
+func (m *ValidatorList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorList.Merge(m, src)
+}
+func (m *ValidatorList) XXX_Size() int {
+	return xxx_messageInfo_ValidatorList.Size(m)
+}
+func (m *ValidatorList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorList proto.InternalMessageInfo
+
+func (m *ValidatorList) GetValidators() []*types.Address {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+type RedemptionPart struct {
+	Validator            *types.Address `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	DelegationIndex      uint64         `protobuf:"varint,2,opt,name=delegation_index,json=delegationIndex,proto3" json:"delegation_index,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RedemptionPart) Reset()         { *m = RedemptionPart{} }
+func (m *RedemptionPart) String() string { return proto.CompactTextString(m) }
+func (*RedemptionPart) ProtoMessage()    {}
+func (*RedemptionPart) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{2}
+}
+func (m *RedemptionPart) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedemptionPart.Unmarshal(m, b)
+}
+func (m *RedemptionPart) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedemptionPart.Marshal(b, m, deterministic)
+}
+func (m *RedemptionPart) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionPart.Merge(m, src)
+}
+func (m *RedemptionPart) XXX_Size() int {
+	return xxx_messageInfo_RedemptionPart.Size(m)
+}
+func (m *RedemptionPart) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionPart.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionPart proto.InternalMessageInfo
+
+func (m *RedemptionPart) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *RedemptionPart) GetDelegationIndex() uint64 {
+	if m != nil {
+		return m.DelegationIndex
+	}
+	return 0
+}
+
+func (m *RedemptionPart) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type Redemption struct {
+	Id                   uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner                *types.Address    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount               *types.BigUInt    `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	ElectionTime         int64             `protobuf:"varint,6,opt,name=election_time,json=electionTime,proto3" json:"election_time,omitempty"`
+	Receipts             *types.BigUInt    `protobuf:"bytes,7,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	Parts                []*RedemptionPart `protobuf:"bytes,8,rep,name=parts,proto3" json:"parts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Redemption) Reset()         { *m = Redemption{} }
+func (m *Redemption) String() string { return proto.CompactTextString(m) }
+func (*Redemption) ProtoMessage()    {}
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{3}
+}
+func (m *Redemption) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Redemption.Unmarshal(m, b)
+}
+func (m *Redemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Redemption.Marshal(b, m, deterministic)
+}
+func (m *Redemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Redemption.Merge(m, src)
+}
+func (m *Redemption) XXX_Size() int {
+	return xxx_messageInfo_Redemption.Size(m)
+}
+func (m *Redemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Redemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Redemption proto.InternalMessageInfo
+
+func (m *Redemption) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Redemption) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *Redemption) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Redemption) GetElectionTime() int64 {
+	if m != nil {
+		return m.ElectionTime
+	}
+	return 0
+}
+
+func (m *Redemption) GetReceipts() *types.BigUInt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *Redemption) GetParts() []*RedemptionPart {
+	if m != nil {
+		return m.Parts
+	}
+	return nil
+}
+
+type RedemptionTotals struct {
+	ElectionTime         int64          `protobuf:"varint,1,opt,name=election_time,json=electionTime,proto3" json:"election_time,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Receipts             *types.BigUInt `protobuf:"bytes,3,opt,name=receipts,proto3" json:"receipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RedemptionTotals) Reset()         { *m = RedemptionTotals{} }
+func (m *RedemptionTotals) String() string { return proto.CompactTextString(m) }
+func (*RedemptionTotals) ProtoMessage()    {}
+func (*RedemptionTotals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{4}
+}
+func (m *RedemptionTotals) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedemptionTotals.Unmarshal(m, b)
+}
+func (m *RedemptionTotals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedemptionTotals.Marshal(b, m, deterministic)
+}
+func (m *RedemptionTotals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionTotals.Merge(m, src)
+}
+func (m *RedemptionTotals) XXX_Size() int {
+	return xxx_messageInfo_RedemptionTotals.Size(m)
+}
+func (m *RedemptionTotals) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionTotals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionTotals proto.InternalMessageInfo
+
+func (m *RedemptionTotals) GetElectionTime() int64 {
+	if m != nil {
+		return m.ElectionTime
+	}
+	return 0
+}
+
+func (m *RedemptionTotals) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *RedemptionTotals) GetReceipts() *types.BigUInt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+type RedemptionCounter struct {
+	LastId               uint64   `protobuf:"varint,1,opt,name=last_id,json=lastId,proto3" json:"last_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedemptionCounter) Reset()         { *m = RedemptionCounter{} }
+func (m *RedemptionCounter) String() string { return proto.CompactTextString(m) }
+func (*RedemptionCounter) ProtoMessage()    {}
+func (*RedemptionCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{5}
+}
+func (m *RedemptionCounter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedemptionCounter.Unmarshal(m, b)
+}
+func (m *RedemptionCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedemptionCounter.Marshal(b, m, deterministic)
+}
+func (m *RedemptionCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionCounter.Merge(m, src)
+}
+func (m *RedemptionCounter) XXX_Size() int {
+	return xxx_messageInfo_RedemptionCounter.Size(m)
+}
+func (m *RedemptionCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionCounter proto.InternalMessageInfo
+
+func (m *RedemptionCounter) GetLastId() uint64 {
+	if m != nil {
+		return m.LastId
+	}
+	return 0
+}
+
+type StakeRequest struct {
+	ValidatorAddress     *types.Address `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StakeRequest) Reset()         { *m = StakeRequest{} }
+func (m *StakeRequest) String() string { return proto.CompactTextString(m) }
+func (*StakeRequest) ProtoMessage()    {}
+func (*StakeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{6}
+}
+func (m *StakeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeRequest.Unmarshal(m, b)
+}
+func (m *StakeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeRequest.Marshal(b, m, deterministic)
+}
+func (m *StakeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeRequest.Merge(m, src)
+}
+func (m *StakeRequest) XXX_Size() int {
+	return xxx_messageInfo_StakeRequest.Size(m)
+}
+func (m *StakeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeRequest proto.InternalMessageInfo
+
+func (m *StakeRequest) GetValidatorAddress() *types.Address {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *StakeRequest) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type StakeResponse struct {
+	Minted               *types.BigUInt `protobuf:"bytes,1,opt,name=minted,proto3" json:"minted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StakeResponse) Reset()         { *m = StakeResponse{} }
+func (m *StakeResponse) String() string { return proto.CompactTextString(m) }
+func (*StakeResponse) ProtoMessage()    {}
+func (*StakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{7}
+}
+func (m *StakeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeResponse.Unmarshal(m, b)
+}
+func (m *StakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeResponse.Marshal(b, m, deterministic)
+}
+func (m *StakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeResponse.Merge(m, src)
+}
+func (m *StakeResponse) XXX_Size() int {
+	return xxx_messageInfo_StakeResponse.Size(m)
+}
+func (m *StakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeResponse proto.InternalMessageInfo
+
+func (m *StakeResponse) GetMinted() *types.BigUInt {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+type RedeemRequest struct {
+	ValidatorAddress     *types.Address `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RedeemRequest) Reset()         { *m = RedeemRequest{} }
+func (m *RedeemRequest) String() string { return proto.CompactTextString(m) }
+func (*RedeemRequest) ProtoMessage()    {}
+func (*RedeemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{8}
+}
+func (m *RedeemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeemRequest.Unmarshal(m, b)
+}
+func (m *RedeemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeemRequest.Marshal(b, m, deterministic)
+}
+func (m *RedeemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemRequest.Merge(m, src)
+}
+func (m *RedeemRequest) XXX_Size() int {
+	return xxx_messageInfo_RedeemRequest.Size(m)
+}
+func (m *RedeemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemRequest proto.InternalMessageInfo
+
+func (m *RedeemRequest) GetValidatorAddress() *types.Address {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+func (m *RedeemRequest) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type RedeemResponse struct {
+	RedemptionId         uint64         `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RedeemResponse) Reset()         { *m = RedeemResponse{} }
+func (m *RedeemResponse) String() string { return proto.CompactTextString(m) }
+func (*RedeemResponse) ProtoMessage()    {}
+func (*RedeemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{9}
+}
+func (m *RedeemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeemResponse.Unmarshal(m, b)
+}
+func (m *RedeemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeemResponse.Marshal(b, m, deterministic)
+}
+func (m *RedeemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemResponse.Merge(m, src)
+}
+func (m *RedeemResponse) XXX_Size() int {
+	return xxx_messageInfo_RedeemResponse.Size(m)
+}
+func (m *RedeemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemResponse proto.InternalMessageInfo
+
+func (m *RedeemResponse) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
+}
+
+func (m *RedeemResponse) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type WithdrawRequest struct {
+	RedemptionId         uint64   `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawRequest) Reset()         { *m = WithdrawRequest{} }
+func (m *WithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawRequest) ProtoMessage()    {}
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{10}
+}
+func (m *WithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawRequest.Unmarshal(m, b)
+}
+func (m *WithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawRequest.Marshal(b, m, deterministic)
+}
+func (m *WithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawRequest.Merge(m, src)
+}
+func (m *WithdrawRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawRequest.Size(m)
+}
+func (m *WithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawRequest proto.InternalMessageInfo
+
+func (m *WithdrawRequest) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
+}
+
+type WithdrawResponse struct {
+	Amount               *types.BigUInt `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *WithdrawResponse) Reset()         { *m = WithdrawResponse{} }
+func (m *WithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawResponse) ProtoMessage()    {}
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{11}
+}
+func (m *WithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawResponse.Unmarshal(m, b)
+}
+func (m *WithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawResponse.Marshal(b, m, deterministic)
+}
+func (m *WithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawResponse.Merge(m, src)
+}
+func (m *WithdrawResponse) XXX_Size() int {
+	return xxx_messageInfo_WithdrawResponse.Size(m)
+}
+func (m *WithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawResponse proto.InternalMessageInfo
+
+func (m *WithdrawResponse) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type CompoundRewardsRequest struct {
+	ValidatorAddress     *types.Address `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CompoundRewardsRequest) Reset()         { *m = CompoundRewardsRequest{} }
+func (m *CompoundRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*CompoundRewardsRequest) ProtoMessage()    {}
+func (*CompoundRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{12}
+}
+func (m *CompoundRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompoundRewardsRequest.Unmarshal(m, b)
+}
+func (m *CompoundRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompoundRewardsRequest.Marshal(b, m, deterministic)
+}
+func (m *CompoundRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompoundRewardsRequest.Merge(m, src)
+}
+func (m *CompoundRewardsRequest) XXX_Size() int {
+	return xxx_messageInfo_CompoundRewardsRequest.Size(m)
+}
+func (m *CompoundRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompoundRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompoundRewardsRequest proto.InternalMessageInfo
+
+func (m *CompoundRewardsRequest) GetValidatorAddress() *types.Address {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return nil
+}
+
+type CompoundRewardsResponse struct {
+	Claimed              *types.BigUInt `protobuf:"bytes,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	Delegated            *types.BigUInt `protobuf:"bytes,2,opt,name=delegated,proto3" json:"delegated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CompoundRewardsResponse) Reset()         { *m = CompoundRewardsResponse{} }
+func (m *CompoundRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*CompoundRewardsResponse) ProtoMessage()    {}
+func (*CompoundRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{13}
+}
+func (m *CompoundRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompoundRewardsResponse.Unmarshal(m, b)
+}
+func (m *CompoundRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompoundRewardsResponse.Marshal(b, m, deterministic)
+}
+func (m *CompoundRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompoundRewardsResponse.Merge(m, src)
+}
+func (m *CompoundRewardsResponse) XXX_Size() int {
+	return xxx_messageInfo_CompoundRewardsResponse.Size(m)
+}
+func (m *CompoundRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompoundRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompoundRewardsResponse proto.InternalMessageInfo
+
+func (m *CompoundRewardsResponse) GetClaimed() *types.BigUInt {
+	if m != nil {
+		return m.Claimed
+	}
+	return nil
+}
+
+func (m *CompoundRewardsResponse) GetDelegated() *types.BigUInt {
+	if m != nil {
+		return m.Delegated
+	}
+	return nil
+}
+
+type ListRedemptionsRequest struct {
+	Owner                *types.Address `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListRedemptionsRequest) Reset()         { *m = ListRedemptionsRequest{} }
+func (m *ListRedemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRedemptionsRequest) ProtoMessage()    {}
+func (*ListRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{14}
+}
+func (m *ListRedemptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRedemptionsRequest.Unmarshal(m, b)
+}
+func (m *ListRedemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRedemptionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListRedemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRedemptionsRequest.Merge(m, src)
+}
+func (m *ListRedemptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRedemptionsRequest.Size(m)
+}
+func (m *ListRedemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRedemptionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRedemptionsRequest proto.InternalMessageInfo
+
+func (m *ListRedemptionsRequest) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+type ListRedemptionsResponse struct {
+	Redemptions          []*Redemption `protobuf:"bytes,1,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListRedemptionsResponse) Reset()         { *m = ListRedemptionsResponse{} }
+func (m *ListRedemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRedemptionsResponse) ProtoMessage()    {}
+func (*ListRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{15}
+}
+func (m *ListRedemptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRedemptionsResponse.Unmarshal(m, b)
+}
+func (m *ListRedemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRedemptionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListRedemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRedemptionsResponse.Merge(m, src)
+}
+func (m *ListRedemptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRedemptionsResponse.Size(m)
+}
+func (m *ListRedemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRedemptionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRedemptionsResponse proto.InternalMessageInfo
+
+func (m *ListRedemptionsResponse) GetRedemptions() []*Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+type GetExchangeRateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExchangeRateRequest) Reset()         { *m = GetExchangeRateRequest{} }
+func (m *GetExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeRateRequest) ProtoMessage()    {}
+func (*GetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{16}
+}
+func (m *GetExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeRateRequest.Unmarshal(m, b)
+}
+func (m *GetExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeRateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeRateRequest.Merge(m, src)
+}
+func (m *GetExchangeRateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeRateRequest.Size(m)
+}
+func (m *GetExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangeRateRequest proto.InternalMessageInfo
+
+type GetExchangeRateResponse struct {
+	TotalStaked          *types.BigUInt `protobuf:"bytes,1,opt,name=total_staked,json=totalStaked,proto3" json:"total_staked,omitempty"`
+	TotalSupply          *types.BigUInt `protobuf:"bytes,2,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetExchangeRateResponse) Reset()         { *m = GetExchangeRateResponse{} }
+func (m *GetExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeRateResponse) ProtoMessage()    {}
+func (*GetExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{17}
+}
+func (m *GetExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeRateResponse.Unmarshal(m, b)
+}
+func (m *GetExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeRateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeRateResponse.Merge(m, src)
+}
+func (m *GetExchangeRateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeRateResponse.Size(m)
+}
+func (m *GetExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangeRateResponse proto.InternalMessageInfo
+
+func (m *GetExchangeRateResponse) GetTotalStaked() *types.BigUInt {
+	if m != nil {
+		return m.TotalStaked
+	}
+	return nil
+}
+
+func (m *GetExchangeRateResponse) GetTotalSupply() *types.BigUInt {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return nil
+}
+
+type SetEVMMirrorRequest struct {
+	Contract             *types.Address `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetEVMMirrorRequest) Reset()         { *m = SetEVMMirrorRequest{} }
+func (m *SetEVMMirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*SetEVMMirrorRequest) ProtoMessage()    {}
+func (*SetEVMMirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{18}
+}
+func (m *SetEVMMirrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetEVMMirrorRequest.Unmarshal(m, b)
+}
+func (m *SetEVMMirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetEVMMirrorRequest.Marshal(b, m, deterministic)
+}
+func (m *SetEVMMirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetEVMMirrorRequest.Merge(m, src)
+}
+func (m *SetEVMMirrorRequest) XXX_Size() int {
+	return xxx_messageInfo_SetEVMMirrorRequest.Size(m)
+}
+func (m *SetEVMMirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetEVMMirrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetEVMMirrorRequest proto.InternalMessageInfo
+
+func (m *SetEVMMirrorRequest) GetContract() *types.Address {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+type GetEVMMirrorRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetEVMMirrorRequest) Reset()         { *m = GetEVMMirrorRequest{} }
+func (m *GetEVMMirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEVMMirrorRequest) ProtoMessage()    {}
+func (*GetEVMMirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{19}
+}
+func (m *GetEVMMirrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEVMMirrorRequest.Unmarshal(m, b)
+}
+func (m *GetEVMMirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEVMMirrorRequest.Marshal(b, m, deterministic)
+}
+func (m *GetEVMMirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEVMMirrorRequest.Merge(m, src)
+}
+func (m *GetEVMMirrorRequest) XXX_Size() int {
+	return xxx_messageInfo_GetEVMMirrorRequest.Size(m)
+}
+func (m *GetEVMMirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEVMMirrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEVMMirrorRequest proto.InternalMessageInfo
+
+type GetEVMMirrorResponse struct {
+	Contract             *types.Address `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetEVMMirrorResponse) Reset()         { *m = GetEVMMirrorResponse{} }
+func (m *GetEVMMirrorResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEVMMirrorResponse) ProtoMessage()    {}
+func (*GetEVMMirrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{20}
+}
+func (m *GetEVMMirrorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetEVMMirrorResponse.Unmarshal(m, b)
+}
+func (m *GetEVMMirrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetEVMMirrorResponse.Marshal(b, m, deterministic)
+}
+func (m *GetEVMMirrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEVMMirrorResponse.Merge(m, src)
+}
+func (m *GetEVMMirrorResponse) XXX_Size() int {
+	return xxx_messageInfo_GetEVMMirrorResponse.Size(m)
+}
+func (m *GetEVMMirrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEVMMirrorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEVMMirrorResponse proto.InternalMessageInfo
+
+func (m *GetEVMMirrorResponse) GetContract() *types.Address {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+type MirrorRequest struct {
+	Amount               *types.BigUInt `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *MirrorRequest) Reset()         { *m = MirrorRequest{} }
+func (m *MirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*MirrorRequest) ProtoMessage()    {}
+func (*MirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{21}
+}
+func (m *MirrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MirrorRequest.Unmarshal(m, b)
+}
+func (m *MirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MirrorRequest.Marshal(b, m, deterministic)
+}
+func (m *MirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MirrorRequest.Merge(m, src)
+}
+func (m *MirrorRequest) XXX_Size() int {
+	return xxx_messageInfo_MirrorRequest.Size(m)
+}
+func (m *MirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MirrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MirrorRequest proto.InternalMessageInfo
+
+func (m *MirrorRequest) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type StakeEvent struct {
+	Staker               *types.Address `protobuf:"bytes,1,opt,name=staker,proto3" json:"staker,omitempty"`
+	Validator            *types.Address `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Minted               *types.BigUInt `protobuf:"bytes,4,opt,name=minted,proto3" json:"minted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *StakeEvent) Reset()         { *m = StakeEvent{} }
+func (m *StakeEvent) String() string { return proto.CompactTextString(m) }
+func (*StakeEvent) ProtoMessage()    {}
+func (*StakeEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{22}
+}
+func (m *StakeEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StakeEvent.Unmarshal(m, b)
+}
+func (m *StakeEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StakeEvent.Marshal(b, m, deterministic)
+}
+func (m *StakeEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeEvent.Merge(m, src)
+}
+func (m *StakeEvent) XXX_Size() int {
+	return xxx_messageInfo_StakeEvent.Size(m)
+}
+func (m *StakeEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakeEvent proto.InternalMessageInfo
+
+func (m *StakeEvent) GetStaker() *types.Address {
+	if m != nil {
+		return m.Staker
+	}
+	return nil
+}
+
+func (m *StakeEvent) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *StakeEvent) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *StakeEvent) GetMinted() *types.BigUInt {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+type RedeemEvent struct {
+	Owner                *types.Address `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Validator            *types.Address `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	RedemptionId         uint64         `protobuf:"varint,3,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+	Burned               *types.BigUInt `protobuf:"bytes,4,opt,name=burned,proto3" json:"burned,omitempty"`
+	Amount               *types.BigUInt `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RedeemEvent) Reset()         { *m = RedeemEvent{} }
+func (m *RedeemEvent) String() string { return proto.CompactTextString(m) }
+func (*RedeemEvent) ProtoMessage()    {}
+func (*RedeemEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c850b83c9abdde, []int{23}
+}
+func (m *RedeemEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeemEvent.Unmarshal(m, b)
+}
+func (m *RedeemEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeemEvent.Marshal(b, m, deterministic)
+}
+func (m *RedeemEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemEvent.Merge(m, src)
+}
+func (m *RedeemEvent) XXX_Size() int {
+	return xxx_messageInfo_RedeemEvent.Size(m)
+}
+func (m *RedeemEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemEvent proto.InternalMessageInfo
+
+func (m *RedeemEvent) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *RedeemEvent) GetValidator() *types.Address {
+	if m != nil {
+		return m.Validator
+	}
+	return nil
+}
+
+func (m *RedeemEvent) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
+}
+
+func (m *RedeemEvent) GetBurned() *types.BigUInt {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *RedeemEvent) GetAmount() *types.BigUInt {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InitRequest)(nil), "liquid_staking.InitRequest")
+	proto.RegisterType((*ValidatorList)(nil), "liquid_staking.ValidatorList")
+	proto.RegisterType((*RedemptionPart)(nil), "liquid_staking.RedemptionPart")
+	proto.RegisterType((*Redemption)(nil), "liquid_staking.Redemption")
+	proto.RegisterType((*RedemptionTotals)(nil), "liquid_staking.RedemptionTotals")
+	proto.RegisterType((*RedemptionCounter)(nil), "liquid_staking.RedemptionCounter")
+	proto.RegisterType((*StakeRequest)(nil), "liquid_staking.StakeRequest")
+	proto.RegisterType((*StakeResponse)(nil), "liquid_staking.StakeResponse")
+	proto.RegisterType((*RedeemRequest)(nil), "liquid_staking.RedeemRequest")
+	proto.RegisterType((*RedeemResponse)(nil), "liquid_staking.RedeemResponse")
+	proto.RegisterType((*WithdrawRequest)(nil), "liquid_staking.WithdrawRequest")
+	proto.RegisterType((*WithdrawResponse)(nil), "liquid_staking.WithdrawResponse")
+	proto.RegisterType((*CompoundRewardsRequest)(nil), "liquid_staking.CompoundRewardsRequest")
+	proto.RegisterType((*CompoundRewardsResponse)(nil), "liquid_staking.CompoundRewardsResponse")
+	proto.RegisterType((*ListRedemptionsRequest)(nil), "liquid_staking.ListRedemptionsRequest")
+	proto.RegisterType((*ListRedemptionsResponse)(nil), "liquid_staking.ListRedemptionsResponse")
+	proto.RegisterType((*GetExchangeRateRequest)(nil), "liquid_staking.GetExchangeRateRequest")
+	proto.RegisterType((*GetExchangeRateResponse)(nil), "liquid_staking.GetExchangeRateResponse")
+	proto.RegisterType((*SetEVMMirrorRequest)(nil), "liquid_staking.SetEVMMirrorRequest")
+	proto.RegisterType((*GetEVMMirrorRequest)(nil), "liquid_staking.GetEVMMirrorRequest")
+	proto.RegisterType((*GetEVMMirrorResponse)(nil), "liquid_staking.GetEVMMirrorResponse")
+	proto.RegisterType((*MirrorRequest)(nil), "liquid_staking.MirrorRequest")
+	proto.RegisterType((*StakeEvent)(nil), "liquid_staking.StakeEvent")
+	proto.RegisterType((*RedeemEvent)(nil), "liquid_staking.RedeemEvent")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/liquid_staking/liquid_staking.proto", fileDescriptor_e8c850b83c9abdde)
+}
+
+var fileDescriptor_e8c850b83c9abdde = []byte{
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdb, 0x6e, 0xeb, 0x44,
+	0x14, 0x95, 0x73, 0x6b, 0xd8, 0xb9, 0x9c, 0x1c, 0x1f, 0x68, 0xac, 0xf3, 0x70, 0x14, 0x4d, 0x2b,
+	0x14, 0x04, 0x4d, 0x28, 0x14, 0x04, 0xa2, 0x2f, 0x50, 0x55, 0x55, 0x2a, 0x2a, 0x90, 0x5b, 0xda,
+	0xc7, 0x68, 0xe2, 0x19, 0x39, 0xa3, 0xda, 0x33, 0xee, 0xcc, 0xb8, 0x69, 0x9f, 0x78, 0xe2, 0x27,
+	0xf8, 0x18, 0x7e, 0x88, 0x9f, 0x40, 0xbe, 0xc5, 0x49, 0xec, 0x28, 0x91, 0xd0, 0x79, 0xa9, 0xea,
+	0xb5, 0x6f, 0x6b, 0xaf, 0xd9, 0xb3, 0x27, 0x70, 0xe7, 0x32, 0x3d, 0x0f, 0x67, 0x23, 0x47, 0xf8,
+	0x63, 0x4f, 0x08, 0x9f, 0x53, 0xbd, 0x10, 0xf2, 0x31, 0xfe, 0xdf, 0x99, 0x63, 0xc6, 0xc7, 0xb3,
+	0x90, 0x79, 0x9a, 0xf1, 0x71, 0xe0, 0x85, 0x2e, 0xe3, 0x6a, 0xec, 0xb1, 0xa7, 0x90, 0x91, 0xa9,
+	0xd2, 0xf8, 0x91, 0x71, 0x77, 0xe3, 0x73, 0x14, 0x48, 0xa1, 0x85, 0xd9, 0x5d, 0x47, 0xdf, 0x7f,
+	0xbd, 0xa5, 0x8a, 0x2b, 0x4e, 0xa2, 0xcf, 0xb1, 0x7e, 0x0d, 0xa8, 0x4a, 0xfe, 0x26, 0x19, 0xd0,
+	0x09, 0xb4, 0x26, 0x9c, 0x69, 0x9b, 0x3e, 0x85, 0x54, 0x69, 0xf3, 0x03, 0xd4, 0xc5, 0x82, 0x53,
+	0x69, 0x19, 0x03, 0x63, 0xd8, 0xfa, 0xa6, 0x39, 0xfa, 0x99, 0x10, 0x49, 0x95, 0xb2, 0x13, 0x18,
+	0xfd, 0x08, 0x9d, 0x7b, 0xec, 0x31, 0x82, 0xb5, 0x90, 0xbf, 0x32, 0xa5, 0xcd, 0x21, 0xc0, 0x73,
+	0x06, 0x28, 0xcb, 0x18, 0x54, 0xd7, 0xa2, 0x56, 0x6c, 0xe8, 0x2f, 0x03, 0xba, 0x36, 0x25, 0xd4,
+	0x0f, 0x34, 0x13, 0xfc, 0x77, 0x2c, 0xb5, 0xf9, 0x39, 0x7c, 0xb2, 0x74, 0x28, 0x54, 0xcc, 0x4d,
+	0xe6, 0x17, 0xd0, 0x23, 0xd4, 0xa3, 0x2e, 0x8e, 0x22, 0xa7, 0x8c, 0x13, 0xfa, 0x62, 0x55, 0x06,
+	0xc6, 0xb0, 0x66, 0xbf, 0xc9, 0xf1, 0x49, 0x04, 0x9b, 0x03, 0x68, 0x60, 0x5f, 0x84, 0x5c, 0x5b,
+	0xd5, 0x34, 0xdf, 0x2f, 0xcc, 0xfd, 0x63, 0xc2, 0xb5, 0x9d, 0xe2, 0xe8, 0x5f, 0x03, 0x20, 0xe7,
+	0x61, 0x76, 0xa1, 0xc2, 0x48, 0x5c, 0xbc, 0x66, 0x57, 0x18, 0xc9, 0x15, 0xa8, 0x94, 0x2a, 0xb0,
+	0x52, 0xa0, 0x5e, 0x5e, 0xc0, 0x3c, 0x82, 0x0e, 0xf5, 0xa8, 0x13, 0x73, 0xd5, 0xcc, 0xa7, 0x56,
+	0x63, 0x60, 0x0c, 0xab, 0x76, 0x3b, 0x03, 0xef, 0x98, 0x4f, 0xcd, 0x63, 0x68, 0x4a, 0xea, 0x50,
+	0x16, 0x68, 0x65, 0x1d, 0x6c, 0x24, 0x5a, 0x5a, 0xcc, 0x33, 0xa8, 0x07, 0x58, 0x6a, 0x65, 0x35,
+	0x63, 0x61, 0x3f, 0x8c, 0x36, 0xa6, 0x60, 0x5d, 0x4f, 0x3b, 0x71, 0xbe, 0xae, 0x35, 0xab, 0xbd,
+	0xda, 0x75, 0xad, 0x59, 0xeb, 0xd5, 0xd1, 0x9f, 0xd0, 0xcb, 0x9d, 0xee, 0x84, 0xc6, 0x9e, 0x2a,
+	0x12, 0x34, 0x4a, 0x08, 0xe6, 0x7d, 0x56, 0xb6, 0xf4, 0xb9, 0xda, 0x42, 0x75, 0x5b, 0x0b, 0xe8,
+	0x2b, 0x78, 0x9b, 0x13, 0xb8, 0x88, 0x02, 0xa9, 0x34, 0xfb, 0x70, 0xe0, 0x61, 0xa5, 0xa7, 0x4b,
+	0xe5, 0x1b, 0xd1, 0xe7, 0x84, 0x20, 0x17, 0xda, 0xb7, 0x1a, 0x3f, 0xd2, 0x6c, 0x1e, 0xbf, 0x83,
+	0xb7, 0xcb, 0x31, 0x98, 0xe2, 0xe4, 0x24, 0x0a, 0x93, 0xd2, 0x5b, 0xba, 0xa4, 0xc8, 0x6e, 0xf2,
+	0xe8, 0x14, 0x3a, 0x69, 0x21, 0x15, 0x08, 0xae, 0xe2, 0x7e, 0x7d, 0xc6, 0x35, 0x25, 0x96, 0xb1,
+	0x19, 0x92, 0xe0, 0x68, 0x0e, 0x9d, 0xa8, 0x13, 0xea, 0x7f, 0x74, 0x72, 0x0f, 0xd0, 0xcd, 0x2a,
+	0xa5, 0xec, 0x8e, 0xa0, 0x23, 0x97, 0x2a, 0xe6, 0xb2, 0xb5, 0x73, 0x70, 0x42, 0xf6, 0x48, 0xfc,
+	0x3d, 0xbc, 0x79, 0x60, 0x7a, 0x4e, 0x24, 0x5e, 0x64, 0x4d, 0xec, 0x93, 0x19, 0x9d, 0x41, 0x2f,
+	0x8f, 0xcb, 0x05, 0x4b, 0xab, 0x19, 0x5b, 0xaa, 0xfd, 0x06, 0x87, 0x17, 0xc2, 0x0f, 0x44, 0xc8,
+	0x89, 0x4d, 0x17, 0x58, 0x12, 0xf5, 0xff, 0x94, 0x43, 0x14, 0xfa, 0x85, 0x84, 0x29, 0x1b, 0x04,
+	0x07, 0x8e, 0x87, 0x99, 0x5f, 0x72, 0x7e, 0x99, 0x21, 0x5a, 0x37, 0xe9, 0xba, 0xa0, 0xa4, 0x20,
+	0x51, 0x6e, 0x42, 0x3f, 0xc0, 0x61, 0xb4, 0xdb, 0xf2, 0xb1, 0x55, 0xfb, 0xae, 0xc7, 0x07, 0xe8,
+	0x17, 0x22, 0x53, 0x82, 0xe7, 0xd0, 0xca, 0x25, 0xcd, 0x36, 0xe5, 0xfb, 0xed, 0x17, 0xda, 0x5e,
+	0x75, 0x47, 0x16, 0x1c, 0x5e, 0x51, 0x7d, 0xf9, 0xe2, 0xcc, 0x31, 0x77, 0xa9, 0x8d, 0x75, 0x76,
+	0x43, 0x90, 0x82, 0x7e, 0xc1, 0x92, 0x96, 0xfc, 0x12, 0xda, 0x3a, 0xba, 0xf1, 0x71, 0xf6, 0x12,
+	0x61, 0x5a, 0xb1, 0x35, 0xbe, 0x06, 0x64, 0xc5, 0x39, 0x0c, 0x02, 0xef, 0xd5, 0xaa, 0x94, 0x3b,
+	0xc7, 0x46, 0xf4, 0x13, 0xbc, 0xbb, 0xa5, 0xfa, 0xf2, 0xfe, 0xe6, 0x86, 0x49, 0x29, 0x64, 0x26,
+	0xcf, 0x31, 0x34, 0x1d, 0xc1, 0xb5, 0xc4, 0x8e, 0x2e, 0x28, 0xb4, 0xb4, 0xa0, 0xcf, 0xe0, 0xdd,
+	0x55, 0x31, 0x18, 0x9d, 0xc3, 0xa7, 0xeb, 0x70, 0xda, 0xc5, 0x7e, 0x49, 0x4f, 0xa1, 0xb3, 0xce,
+	0x65, 0xf7, 0x78, 0xfe, 0x6d, 0x00, 0xc4, 0xcd, 0x5f, 0x3e, 0x53, 0x1e, 0x07, 0xc4, 0x3a, 0x15,
+	0x0f, 0x37, 0xc5, 0xd7, 0x9f, 0xab, 0xca, 0xf6, 0xe7, 0x6a, 0xe7, 0x1b, 0xb4, 0xb2, 0x6c, 0x6a,
+	0x5b, 0x96, 0xcd, 0x3f, 0x06, 0xb4, 0x92, 0x1d, 0x90, 0xb0, 0xdb, 0x31, 0x79, 0x7b, 0x73, 0x2b,
+	0x5c, 0xf7, 0x6a, 0xf9, 0x22, 0x99, 0x85, 0x92, 0x97, 0xd1, 0x4b, 0xf0, 0xdd, 0xaf, 0xe0, 0xac,
+	0x11, 0xff, 0xbe, 0xf8, 0xf6, 0xbf, 0x01, 0x00, 0xdb, 0x56, 0xf9, 0xe9, 0xf9, 0x08, 0x00, 0x00,
+}
@@ -0,0 +1,359 @@
+package liquid_staking
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+const chainID = "default"
+
+var (
+	owner   = loom.MustParseAddress("default:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	staker1 = loom.MustParseAddress("default:0xfa4c7920accfd66b86f5fd0e69682a79f762d49e")
+	staker2 = loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+)
+
+func bigUInt(amount int64) *types.BigUInt {
+	return &types.BigUInt{Value: *loom.NewBigUIntFromInt(amount)}
+}
+
+func TestLiquidStaking(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	validator := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(pubKey)}
+
+	pctx := plugin.CreateFakeContext(owner, owner).WithBlock(loom.BlockHeader{
+		ChainID: chainID,
+		Time:    time.Now().Unix(),
+	})
+	advanceTime := func(seconds int64) {
+		pctx.SetTime(pctx.Now().Add(time.Duration(seconds) * time.Second))
+	}
+
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(coinAddr).WithSender(sender))
+	}
+	require.NoError(t, coinContract.Init(coinCtx(owner), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			{Owner: staker1.MarshalPB(), Balance: 1000},
+		},
+	}))
+
+	dposContract := &dposv3.DPOS{}
+	dposAddr := pctx.CreateContract(dposv3.Contract)
+	dposCtx := contractpb.WrapPluginContext(pctx.WithAddress(dposAddr))
+	require.NoError(t, dposContract.Init(dposCtx, &dposv3.InitRequest{
+		Params:         &dposv3.Params{ValidatorCount: 1},
+		Validators:     []*dposv3.Validator{{PubKey: pubKey, Power: 10}},
+		InitCandidates: true,
+	}))
+
+	lsAddr := pctx.CreateContract(Contract)
+	lsCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(lsAddr).WithSender(sender))
+	}
+	ls := &LiquidStaking{}
+	require.Equal(t, ErrOwnerNotSpecified, ls.Init(lsCtx(owner), &InitRequest{}))
+	require.NoError(t, ls.Init(lsCtx(owner), &InitRequest{Owner: owner.MarshalPB()}))
+
+	stakeReq := &StakeRequest{ValidatorAddress: validator.MarshalPB(), Amount: bigUInt(50)}
+	// LOOM transfer hasn't been approved yet
+	_, err = ls.Stake(lsCtx(staker1), stakeReq)
+	require.Error(t, err)
+
+	require.NoError(t, coinContract.Approve(coinCtx(staker1), &coin.ApproveRequest{
+		Spender: lsAddr.MarshalPB(),
+		Amount:  bigUInt(100),
+	}))
+	// each stake creates a separate delegation
+	for i := 0; i < 2; i++ {
+		stakeResp, err := ls.Stake(lsCtx(staker1), stakeReq)
+		require.NoError(t, err)
+		require.Equal(t, int64(50), stakeResp.Minted.Value.Int64())
+	}
+
+	rate, err := ls.GetExchangeRate(lsCtx(staker1), &GetExchangeRateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), rate.TotalStaked.Value.Int64())
+	require.Equal(t, int64(100), rate.TotalSupply.Value.Int64())
+
+	// receipt tokens are transferable
+	require.NoError(t, ls.Transfer(lsCtx(staker1), &TransferRequest{To: staker2.MarshalPB(), Amount: bigUInt(60)}))
+	require.Equal(t, ErrSenderBalanceTooLow, ls.Transfer(lsCtx(staker2), &TransferRequest{
+		To:     staker1.MarshalPB(),
+		Amount: bigUInt(61),
+	}))
+	balance, err := ls.BalanceOf(lsCtx(staker2), &BalanceOfRequest{Owner: staker2.MarshalPB()})
+	require.NoError(t, err)
+	require.Equal(t, int64(60), balance.Balance.Value.Int64())
+
+	// can't redeem more receipt tokens than the sender holds
+	_, err = ls.Redeem(lsCtx(staker2), &RedeemRequest{Amount: bigUInt(61)})
+	require.Equal(t, ErrSenderBalanceTooLow, err)
+
+	// the delegations are still bonding
+	_, err = ls.Redeem(lsCtx(staker2), &RedeemRequest{ValidatorAddress: validator.MarshalPB(), Amount: bigUInt(60)})
+	require.Equal(t, ErrNoUnbondableDelegation, err)
+
+	// wait for the delegation to bond & the locktime to expire
+	advanceTime(1)
+	require.NoError(t, dposv3.Elect(dposCtx))
+	advanceTime(int64(15 * 24 * time.Hour / time.Second))
+	require.NoError(t, dposv3.Elect(dposCtx))
+
+	rate, err = ls.GetExchangeRate(lsCtx(staker1), &GetExchangeRateRequest{})
+	require.NoError(t, err)
+	require.True(t, rate.TotalStaked.Value.Int64() >= 100)
+	require.Equal(t, int64(100), rate.TotalSupply.Value.Int64())
+
+	// the redemption is larger than either delegation, so it's split across both of them
+	redeemResp, err := ls.Redeem(lsCtx(staker2), &RedeemRequest{Amount: bigUInt(60)})
+	require.NoError(t, err)
+	redeemed := redeemResp.Amount.Value.Int64()
+	require.True(t, redeemed >= 60)
+
+	balance, err = ls.BalanceOf(lsCtx(staker2), &BalanceOfRequest{Owner: staker2.MarshalPB()})
+	require.NoError(t, err)
+	require.True(t, balance.Balance.Value.Cmp(loom.NewBigUIntFromInt(0)) == 0)
+
+	list, err := ls.ListRedemptions(lsCtx(staker2), &ListRedemptionsRequest{Owner: staker2.MarshalPB()})
+	require.NoError(t, err)
+	require.Len(t, list.Redemptions, 1)
+	require.Len(t, list.Redemptions[0].Parts, 2)
+
+	// pending redemptions don't change the exchange rate
+	rate2, err := ls.GetExchangeRate(lsCtx(staker1), &GetExchangeRateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, rate.TotalStaked.Value.Int64(), rate2.TotalStaked.Value.Int64())
+	require.Equal(t, rate.TotalSupply.Value.Int64(), rate2.TotalSupply.Value.Int64())
+
+	withdrawReq := &WithdrawRequest{RedemptionId: redeemResp.RedemptionId}
+	_, err = ls.Withdraw(lsCtx(staker2), withdrawReq)
+	require.Equal(t, ErrRedemptionPending, err)
+	_, err = ls.Withdraw(lsCtx(staker1), withdrawReq)
+	require.Equal(t, ErrNotAuthorized, err)
+	_, err = ls.Withdraw(lsCtx(staker2), &WithdrawRequest{RedemptionId: 100})
+	require.Equal(t, ErrRedemptionNotFound, err)
+
+	advanceTime(1)
+	require.NoError(t, dposv3.Elect(dposCtx))
+	withdrawResp, err := ls.Withdraw(lsCtx(staker2), withdrawReq)
+	require.NoError(t, err)
+	require.Equal(t, redeemed, withdrawResp.Amount.Value.Int64())
+
+	loomBalance, err := coinContract.BalanceOf(coinCtx(staker2), &coin.BalanceOfRequest{Owner: staker2.MarshalPB()})
+	require.NoError(t, err)
+	require.Equal(t, redeemed, loomBalance.Balance.Value.Int64())
+
+	list, err = ls.ListRedemptions(lsCtx(staker2), &ListRedemptionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Redemptions, 0)
+
+	supply, err := ls.TotalSupply(lsCtx(staker1), &TotalSupplyRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), supply.TotalSupply.Value.Int64())
+
+	// compounding rewards doesn't change the exchange rate
+	rate, err = ls.GetExchangeRate(lsCtx(staker1), &GetExchangeRateRequest{})
+	require.NoError(t, err)
+	_, err = ls.CompoundRewards(lsCtx(staker1), &CompoundRewardsRequest{})
+	require.NoError(t, err)
+	rate2, err = ls.GetExchangeRate(lsCtx(staker1), &GetExchangeRateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, rate.TotalStaked.Value.Int64(), rate2.TotalStaked.Value.Int64())
+	require.Equal(t, rate.TotalSupply.Value.Int64(), rate2.TotalSupply.Value.Int64())
+
+	mirrorReq := &SetEVMMirrorRequest{Contract: staker2.MarshalPB()}
+	require.Equal(t, ErrNotAuthorized, ls.SetEVMMirror(lsCtx(staker1), mirrorReq))
+	require.NoError(t, ls.SetEVMMirror(lsCtx(owner), mirrorReq))
+	mirror, err := ls.GetEVMMirror(lsCtx(staker1), &GetEVMMirrorRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, loom.UnmarshalAddressPB(mirror.Contract).Compare(staker2))
+}
+
+// Rotating the key of a validator moves its reward history, its votes in the active governance
+// proposals, and the liquid staking positions held with it, to the validator's new address.
+func TestValidatorKeyRotation(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	validator := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(pubKey)}
+
+	pctx := plugin.CreateFakeContext(owner, owner).WithBlock(loom.BlockHeader{
+		ChainID: chainID,
+		Time:    time.Now().Unix(),
+	}).WithValidators([]*loom.Validator{{PubKey: pubKey, Power: 10}})
+	pctx.SetFeature(features.DPOSVersion3_1, true)
+	pctx.SetFeature(features.DPOSVersion3_11, true)
+	pctx.SetFeature(features.DPOSVersion3_13, true)
+	pctx.SetFeature(features.DPOSVersion3_16, true)
+	pctx.SetFeature(features.GovernanceFeature, true)
+	advanceTime := func(seconds int64) {
+		pctx.SetTime(pctx.Now().Add(time.Duration(seconds) * time.Second))
+	}
+	loomAmount := func(amount int64) *types.BigUInt {
+		value := new(big.Int).Mul(big.NewInt(amount), new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil))
+		return &types.BigUInt{Value: *loom.NewBigUInt(value)}
+	}
+
+	coinContract := &coin.Coin{}
+	coinAddr := pctx.CreateContract(coin.Contract)
+	coinCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(coinAddr).WithSender(sender))
+	}
+	require.NoError(t, coinContract.Init(coinCtx(owner), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			{Owner: staker1.MarshalPB(), Balance: 1000},
+			{Owner: validator.MarshalPB(), Balance: 1000},
+		},
+	}))
+
+	dposContract := &dposv3.DPOS{}
+	dposAddr := pctx.CreateContract(dposv3.Contract)
+	dposCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(dposAddr).WithSender(sender))
+	}
+	require.NoError(t, dposContract.Init(dposCtx(owner), &dposv3.InitRequest{
+		Params:         &dposv3.Params{ValidatorCount: 1},
+		Validators:     []*dposv3.Validator{{PubKey: pubKey, Power: 10}},
+		InitCandidates: true,
+	}))
+
+	govAddr := pctx.CreateContract(governance.Contract)
+	govCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(govAddr).WithSender(sender))
+	}
+	gov := &governance.Governance{}
+	require.NoError(t, gov.Init(govCtx(owner), &governance.InitRequest{
+		Params: &governance.Params{VotingPeriod: 100, Quorum: 5000, Threshold: 6000, MinProposerPower: 1},
+	}))
+
+	lsAddr := pctx.CreateContract(Contract)
+	lsCtx := func(sender loom.Address) contractpb.Context {
+		return contractpb.WrapPluginContext(pctx.WithAddress(lsAddr).WithSender(sender))
+	}
+	ls := &LiquidStaking{}
+	require.NoError(t, ls.Init(lsCtx(owner), &InitRequest{Owner: owner.MarshalPB()}))
+
+	// the validator stakes with itself so it's credited rewards, and the liquid staking contract
+	// delegates to it
+	require.NoError(t, coinContract.Approve(coinCtx(validator), &coin.ApproveRequest{
+		Spender: dposAddr.MarshalPB(),
+		Amount:  loomAmount(100),
+	}))
+	require.NoError(t, dposContract.Delegate(dposCtx(validator), &dposv3.DelegateRequest{
+		ValidatorAddress: validator.MarshalPB(),
+		Amount:           loomAmount(100),
+	}))
+	require.NoError(t, coinContract.Approve(coinCtx(staker1), &coin.ApproveRequest{
+		Spender: lsAddr.MarshalPB(),
+		Amount:  loomAmount(500),
+	}))
+	_, err = ls.Stake(lsCtx(staker1), &StakeRequest{ValidatorAddress: validator.MarshalPB(), Amount: loomAmount(500)})
+	require.NoError(t, err)
+
+	advanceTime(1)
+	require.NoError(t, dposv3.Elect(dposCtx(owner)))
+	advanceTime(int64(15 * 24 * time.Hour / time.Second))
+	require.NoError(t, dposv3.Elect(dposCtx(owner)))
+
+	history, err := dposContract.GetDelegatorRewardHistory(dposCtx(owner), &dposv3.GetDelegatorRewardHistoryRequest{
+		Delegator: validator.MarshalPB(),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, history.Records)
+	numRecords := len(history.Records)
+
+	redeemResp, err := ls.Redeem(lsCtx(staker1), &RedeemRequest{Amount: loomAmount(100)})
+	require.NoError(t, err)
+
+	call := &governance.ContractCall{Contract: govAddr.MarshalPB(), Method: "SetParams"}
+	proposeResp, err := gov.Propose(govCtx(validator), &governance.ProposeRequest{Call: call})
+	require.NoError(t, err)
+	proposalID := proposeResp.ProposalId
+	require.NoError(t, gov.Vote(govCtx(validator), &governance.VoteRequest{ProposalId: proposalID, Approve: true}))
+
+	// only DPOS can migrate validator addresses
+	migrateReq := &dposv3.MigrateValidatorAddressRequest{
+		OldAddress: validator.MarshalPB(),
+		NewAddress: staker2.MarshalPB(),
+	}
+	require.Equal(t, ErrNotAuthorized, ls.MigrateValidatorAddress(lsCtx(owner), migrateReq))
+	require.Equal(t, governance.ErrNotAuthorized, gov.MigrateValidatorAddress(govCtx(owner), &governance.MigrateValidatorAddressRequest{
+		OldAddress: validator.MarshalPB(),
+		NewAddress: staker2.MarshalPB(),
+	}))
+
+	rate, err := ls.GetExchangeRate(lsCtx(staker1), &GetExchangeRateRequest{})
+	require.NoError(t, err)
+
+	newPubKey, newPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	newAddr := loom.Address{ChainID: chainID, Local: loom.LocalAddressFromPublicKey(newPubKey)}
+	require.NoError(t, dposContract.RotateValidatorKey(dposCtx(validator), &dposv3.RotateValidatorKeyRequest{
+		NewPubKey: newPubKey,
+		Signature: ed25519.Sign(newPrivKey, dposv3.RotateValidatorKeySignBytes(chainID, validator, newPubKey, 0)),
+	}))
+	advanceTime(1)
+	require.NoError(t, dposv3.Elect(dposCtx(owner)))
+
+	// the reward history has moved to the new address
+	history, err = dposContract.GetDelegatorRewardHistory(dposCtx(owner), &dposv3.GetDelegatorRewardHistoryRequest{
+		Delegator: validator.MarshalPB(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, history.Records)
+	history, err = dposContract.GetDelegatorRewardHistory(dposCtx(owner), &dposv3.GetDelegatorRewardHistoryRequest{
+		Delegator: newAddr.MarshalPB(),
+	})
+	require.NoError(t, err)
+	require.True(t, len(history.Records) >= numRecords)
+
+	// the validator's vote & power in the open proposal have moved to the new address
+	require.Equal(t, governance.ErrAlreadyVoted, gov.Vote(govCtx(newAddr), &governance.VoteRequest{
+		ProposalId: proposalID,
+		Approve:    true,
+	}))
+	// and so has the power the liquid staking contract delegated to the validator, so the
+	// contract's vote overrides the validator's vote
+	require.NoError(t, gov.Vote(govCtx(lsAddr), &governance.VoteRequest{ProposalId: proposalID, Approve: false}))
+	proposal, err := gov.GetProposal(govCtx(owner), &governance.GetProposalRequest{ProposalId: proposalID})
+	require.NoError(t, err)
+	require.Equal(t, 0, loom.UnmarshalAddressPB(proposal.Proposal.Proposer).Compare(newAddr))
+	require.Equal(t, 0, loom.UnmarshalAddressPB(proposal.Proposal.ValidatorPowers[0].Validator).Compare(newAddr))
+	require.Equal(t, 0, loom.UnmarshalAddressPB(proposal.Proposal.Votes[0].Voter).Compare(newAddr))
+	require.Equal(t, 0, loom.UnmarshalAddressPB(proposal.Proposal.Votes[1].DelegatedPower[0].Validator).Compare(newAddr))
+	require.Equal(t, int64(0), proposal.Proposal.ApprovePower)
+	require.Equal(t, int64(10), proposal.Proposal.RejectPower)
+
+	// the liquid staking contract still accounts for the stake it delegated to the validator
+	validators, err := loadValidators(lsCtx(owner))
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 1)
+	require.Equal(t, 0, loom.UnmarshalAddressPB(validators.Validators[0]).Compare(newAddr))
+	rate2, err := ls.GetExchangeRate(lsCtx(staker1), &GetExchangeRateRequest{})
+	require.NoError(t, err)
+	require.True(t, rate2.TotalStaked.Value.Cmp(&rate.TotalStaked.Value) >= 0)
+
+	list, err := ls.ListRedemptions(lsCtx(staker1), &ListRedemptionsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Redemptions, 1)
+	for _, part := range list.Redemptions[0].Parts {
+		require.Equal(t, 0, loom.UnmarshalAddressPB(part.Validator).Compare(newAddr))
+	}
+	_, err = ls.Withdraw(lsCtx(staker1), &WithdrawRequest{RedemptionId: redeemResp.RedemptionId})
+	require.NoError(t, err)
+}
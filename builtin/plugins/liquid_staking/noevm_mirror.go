@@ -0,0 +1,19 @@
+// +build !evm
+
+package liquid_staking
+
+import (
+	loom "github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/pkg/errors"
+)
+
+var errEVMNotSupported = errors.New("[LiquidStaking] EVM mirror is not supported in non-EVM builds")
+
+func mintOnEVM(_ contract.Context, _, _ loom.Address, _ *loom.BigUInt) error {
+	return errEVMNotSupported
+}
+
+func burnOnEVM(_ contract.Context, _, _ loom.Address, _ *loom.BigUInt) error {
+	return errEVMNotSupported
+}
@@ -0,0 +1,272 @@
+package liquid_staking
+
+import (
+	loom "github.com/loomnetwork/go-loom"
+	ctypes "github.com/loomnetwork/go-loom/builtin/types/coin"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/pkg/errors"
+)
+
+// The receipt token implements the same interface as the coin contract, so existing ERC20 tooling
+// for Go contracts can be used with it.
+
+const (
+	TransferEventTopic = "liquid-staking:transfer"
+	ApprovalEventTopic = "liquid-staking:approval"
+)
+
+type (
+	TotalSupplyRequest  = ctypes.TotalSupplyRequest
+	TotalSupplyResponse = ctypes.TotalSupplyResponse
+	BalanceOfRequest    = ctypes.BalanceOfRequest
+	BalanceOfResponse   = ctypes.BalanceOfResponse
+	TransferRequest     = ctypes.TransferRequest
+	TransferEvent       = ctypes.TransferEvent
+	ApproveRequest      = ctypes.ApproveRequest
+	ApprovalEvent       = ctypes.ApprovalEvent
+	AllowanceRequest    = ctypes.AllowanceRequest
+	AllowanceResponse   = ctypes.AllowanceResponse
+	TransferFromRequest = ctypes.TransferFromRequest
+	Allowance           = ctypes.Allowance
+	Account             = ctypes.Account
+	Economy             = ctypes.Economy
+)
+
+var (
+	// ErrSenderBalanceTooLow is returned when an account doesn't have enough receipt tokens.
+	ErrSenderBalanceTooLow = errors.New("[LiquidStaking] sender balance is too low")
+	// ErrAllowanceTooLow is returned when a spender attempts to transfer more than it's allowed to.
+	ErrAllowanceTooLow = errors.New("[LiquidStaking] amount is over spender's limit")
+)
+
+var (
+	economyKey = []byte("economy")
+)
+
+func accountKey(addr loom.Address) []byte {
+	return util.PrefixKey([]byte("account"), addr.Bytes())
+}
+
+func allowanceKey(owner, spender loom.Address) []byte {
+	return util.PrefixKey([]byte("allowance"), owner.Bytes(), spender.Bytes())
+}
+
+func (ls *LiquidStaking) TotalSupply(
+	ctx contract.StaticContext, req *TotalSupplyRequest,
+) (*TotalSupplyResponse, error) {
+	supply, err := loadTotalSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &TotalSupplyResponse{TotalSupply: &types.BigUInt{Value: *supply}}, nil
+}
+
+func (ls *LiquidStaking) BalanceOf(
+	ctx contract.StaticContext, req *BalanceOfRequest,
+) (*BalanceOfResponse, error) {
+	if req.Owner == nil {
+		return nil, ErrInvalidRequest
+	}
+	acct, err := loadAccount(ctx, loom.UnmarshalAddressPB(req.Owner))
+	if err != nil {
+		return nil, err
+	}
+	return &BalanceOfResponse{Balance: acct.Balance}, nil
+}
+
+func (ls *LiquidStaking) Transfer(ctx contract.Context, req *TransferRequest) error {
+	if req.To == nil || req.Amount == nil {
+		return ErrInvalidRequest
+	}
+	return transfer(ctx, ctx.Message().Sender, loom.UnmarshalAddressPB(req.To), &req.Amount.Value)
+}
+
+func (ls *LiquidStaking) Approve(ctx contract.Context, req *ApproveRequest) error {
+	if req.Spender == nil || req.Amount == nil {
+		return ErrInvalidRequest
+	}
+	owner := ctx.Message().Sender
+	spender := loom.UnmarshalAddressPB(req.Spender)
+	allow := &Allowance{
+		Owner:   owner.MarshalPB(),
+		Spender: req.Spender,
+		Amount:  req.Amount,
+	}
+	if err := ctx.Set(allowanceKey(owner, spender), allow); err != nil {
+		return err
+	}
+	return emitEvent(ctx, &ApprovalEvent{
+		From:    owner.MarshalPB(),
+		Spender: req.Spender,
+		Amount:  req.Amount,
+	}, ApprovalEventTopic)
+}
+
+func (ls *LiquidStaking) Allowance(
+	ctx contract.StaticContext, req *AllowanceRequest,
+) (*AllowanceResponse, error) {
+	if req.Owner == nil || req.Spender == nil {
+		return nil, ErrInvalidRequest
+	}
+	allow, err := loadAllowance(ctx, loom.UnmarshalAddressPB(req.Owner), loom.UnmarshalAddressPB(req.Spender))
+	if err != nil {
+		return nil, err
+	}
+	return &AllowanceResponse{Amount: allow.Amount}, nil
+}
+
+func (ls *LiquidStaking) TransferFrom(ctx contract.Context, req *TransferFromRequest) error {
+	if req.From == nil || req.To == nil || req.Amount == nil {
+		return ErrInvalidRequest
+	}
+	from := loom.UnmarshalAddressPB(req.From)
+	spender := ctx.Message().Sender
+	allow, err := loadAllowance(ctx, from, spender)
+	if err != nil {
+		return err
+	}
+	if allow.Amount.Value.Cmp(&req.Amount.Value) < 0 {
+		return ErrAllowanceTooLow
+	}
+	if err := transfer(ctx, from, loom.UnmarshalAddressPB(req.To), &req.Amount.Value); err != nil {
+		return err
+	}
+	remaining := loom.NewBigUIntFromInt(0)
+	remaining.Sub(&allow.Amount.Value, &req.Amount.Value)
+	allow.Amount = &types.BigUInt{Value: *remaining}
+	return ctx.Set(allowanceKey(from, spender), allow)
+}
+
+func transfer(ctx contract.Context, from, to loom.Address, amount *loom.BigUInt) error {
+	fromAccount, err := loadAccount(ctx, from)
+	if err != nil {
+		return err
+	}
+	if fromAccount.Balance.Value.Cmp(amount) < 0 {
+		return ErrSenderBalanceTooLow
+	}
+	fromBalance := loom.NewBigUIntFromInt(0)
+	fromBalance.Sub(&fromAccount.Balance.Value, amount)
+	fromAccount.Balance = &types.BigUInt{Value: *fromBalance}
+	if err := saveAccount(ctx, fromAccount); err != nil {
+		return err
+	}
+
+	toAccount, err := loadAccount(ctx, to)
+	if err != nil {
+		return err
+	}
+	toBalance := loom.NewBigUIntFromInt(0)
+	toBalance.Add(&toAccount.Balance.Value, amount)
+	toAccount.Balance = &types.BigUInt{Value: *toBalance}
+	if err := saveAccount(ctx, toAccount); err != nil {
+		return err
+	}
+
+	return emitTransferEvent(ctx, from, to, amount)
+}
+
+func mint(ctx contract.Context, to loom.Address, amount *loom.BigUInt) error {
+	supply, err := loadTotalSupply(ctx)
+	if err != nil {
+		return err
+	}
+	account, err := loadAccount(ctx, to)
+	if err != nil {
+		return err
+	}
+
+	balance := loom.NewBigUIntFromInt(0)
+	balance.Add(&account.Balance.Value, amount)
+	account.Balance = &types.BigUInt{Value: *balance}
+	if err := saveAccount(ctx, account); err != nil {
+		return err
+	}
+
+	supply.Add(supply, amount)
+	if err := ctx.Set(economyKey, &Economy{TotalSupply: &types.BigUInt{Value: *supply}}); err != nil {
+		return err
+	}
+
+	// Minting events are transfer events with the empty address as `from`
+	return emitTransferEvent(ctx, loom.RootAddress(ctx.Block().ChainID), to, amount)
+}
+
+func burn(ctx contract.Context, from loom.Address, amount *loom.BigUInt) error {
+	supply, err := loadTotalSupply(ctx)
+	if err != nil {
+		return err
+	}
+	account, err := loadAccount(ctx, from)
+	if err != nil {
+		return err
+	}
+	if account.Balance.Value.Cmp(amount) < 0 || supply.Cmp(amount) < 0 {
+		return ErrSenderBalanceTooLow
+	}
+
+	balance := loom.NewBigUIntFromInt(0)
+	balance.Sub(&account.Balance.Value, amount)
+	account.Balance = &types.BigUInt{Value: *balance}
+	if err := saveAccount(ctx, account); err != nil {
+		return err
+	}
+
+	supply.Sub(supply, amount)
+	if err := ctx.Set(economyKey, &Economy{TotalSupply: &types.BigUInt{Value: *supply}}); err != nil {
+		return err
+	}
+
+	// Burn events are transfer events with the empty address as `to`
+	return emitTransferEvent(ctx, from, loom.RootAddress(ctx.Block().ChainID), amount)
+}
+
+func loadTotalSupply(ctx contract.StaticContext) (*loom.BigUInt, error) {
+	var econ Economy
+	if err := ctx.Get(economyKey, &econ); err != nil {
+		if err == contract.ErrNotFound {
+			return loom.NewBigUIntFromInt(0), nil
+		}
+		return nil, err
+	}
+	supply := loom.NewBigUIntFromInt(0)
+	supply.Add(supply, &econ.TotalSupply.Value)
+	return supply, nil
+}
+
+func loadAccount(ctx contract.StaticContext, owner loom.Address) (*Account, error) {
+	acct := &Account{
+		Owner:   owner.MarshalPB(),
+		Balance: loom.BigZeroPB(),
+	}
+	if err := ctx.Get(accountKey(owner), acct); err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+	return acct, nil
+}
+
+func saveAccount(ctx contract.Context, acct *Account) error {
+	return ctx.Set(accountKey(loom.UnmarshalAddressPB(acct.Owner)), acct)
+}
+
+func loadAllowance(ctx contract.StaticContext, owner, spender loom.Address) (*Allowance, error) {
+	allow := &Allowance{
+		Owner:   owner.MarshalPB(),
+		Spender: spender.MarshalPB(),
+		Amount:  loom.BigZeroPB(),
+	}
+	if err := ctx.Get(allowanceKey(owner, spender), allow); err != nil && err != contract.ErrNotFound {
+		return nil, err
+	}
+	return allow, nil
+}
+
+func emitTransferEvent(ctx contract.Context, from, to loom.Address, amount *loom.BigUInt) error {
+	return emitEvent(ctx, &TransferEvent{
+		From:   from.MarshalPB(),
+		To:     to.MarshalPB(),
+		Amount: &types.BigUInt{Value: *amount},
+	}, TransferEventTopic)
+}
@@ -0,0 +1,178 @@
+package multisig
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ed25519"
+)
+
+const (
+	// MaxAccountKeys is the maximum number of keys that can be associated with a multisig account.
+	MaxAccountKeys = 32
+
+	accountPrefix = "acct"
+)
+
+var (
+	// ErrInvalidRequest is a generic error that's returned when something is wrong with the
+	// request message, e.g. missing or invalid fields.
+	ErrInvalidRequest = errors.New("[Multisig] invalid request")
+	// ErrAccountAlreadyExists is returned if an account with the same key set & threshold exists.
+	ErrAccountAlreadyExists = errors.New("[Multisig] account already exists")
+	// ErrAccountNotFound is returned if the requested multisig account doesn't exist.
+	ErrAccountNotFound = errors.New("[Multisig] account not found")
+	// ErrThresholdNotMet is returned when a tx doesn't carry enough valid signatures.
+	ErrThresholdNotMet = errors.New("[Multisig] signature threshold not met")
+)
+
+func accountKey(addr loom.Address) []byte {
+	return util.PrefixKey([]byte(accountPrefix), addr.Bytes())
+}
+
+// Multisig contract stores the key sets of M-of-N multisig accounts. Txs sent from a multisig
+// account are verified by the auth middleware against the key set stored in this contract.
+type Multisig struct {
+}
+
+func (m *Multisig) Meta() (plugin.Meta, error) {
+	return plugin.Meta{
+		Name:    "multisig",
+		Version: "1.0.0",
+	}, nil
+}
+
+func (m *Multisig) Init(ctx contract.Context, req *InitRequest) error {
+	return nil
+}
+
+// CreateAccount registers a new multisig account, anyone can create an account, the address of
+// the account is derived from the threshold & the key set so an account can't be hijacked by
+// registering it with a different key set.
+func (m *Multisig) CreateAccount(
+	ctx contract.Context, req *CreateAccountRequest,
+) (*CreateAccountResponse, error) {
+	pubKeys, err := SortPublicKeys(req.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	if req.Threshold == 0 || int(req.Threshold) > len(pubKeys) {
+		return nil, errors.Wrap(ErrInvalidRequest, "threshold must be between 1 and the number of keys")
+	}
+
+	addr := loom.Address{
+		ChainID: ctx.Block().ChainID,
+		Local:   AccountAddress(req.Threshold, pubKeys),
+	}
+	if ctx.Has(accountKey(addr)) {
+		return nil, ErrAccountAlreadyExists
+	}
+
+	account := &Account{
+		Address:    addr.MarshalPB(),
+		Threshold:  req.Threshold,
+		PublicKeys: pubKeys,
+	}
+	if err := ctx.Set(accountKey(addr), account); err != nil {
+		return nil, errors.Wrap(err, "failed to save account")
+	}
+	return &CreateAccountResponse{Address: addr.MarshalPB()}, nil
+}
+
+func (m *Multisig) GetAccount(ctx contract.StaticContext, req *GetAccountRequest) (*GetAccountResponse, error) {
+	if req.Address == nil {
+		return nil, ErrInvalidRequest
+	}
+	account, err := GetAccount(ctx, loom.UnmarshalAddressPB(req.Address))
+	if err != nil {
+		return nil, err
+	}
+	return &GetAccountResponse{Account: account}, nil
+}
+
+// GetAccount is called by the auth middleware to retrieve the key set of a multisig account.
+func GetAccount(ctx contract.StaticContext, addr loom.Address) (*Account, error) {
+	var account Account
+	if err := ctx.Get(accountKey(addr), &account); err != nil {
+		if err == contract.ErrNotFound {
+			return nil, ErrAccountNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to load account %v", addr)
+	}
+	return &account, nil
+}
+
+// AccountAddress derives the local address of a multisig account from the signature threshold &
+// the sorted public keys of the account members.
+func AccountAddress(threshold uint32, sortedPubKeys [][]byte) loom.LocalAddress {
+	hasher := sha256.New()
+	hasher.Write([]byte("multisig"))
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], threshold)
+	hasher.Write(buf[:])
+	for _, pubKey := range sortedPubKeys {
+		hasher.Write(pubKey)
+	}
+	return loom.LocalAddressFromPublicKey(hasher.Sum(nil))
+}
+
+// VerifySignatures checks that the given signatures of the tx payload were produced by at least
+// threshold distinct members of the account.
+func VerifySignatures(account *Account, payload []byte, sigs []*Signature) error {
+	signed := map[string]bool{}
+	for _, sig := range sigs {
+		if sig == nil || len(sig.PublicKey) != ed25519.PublicKeySize ||
+			len(sig.Signature) != ed25519.SignatureSize {
+			return errors.New("malformed multisig signature")
+		}
+		if !isMember(account, sig.PublicKey) {
+			return errors.Errorf("key %x is not a member of the multisig account", sig.PublicKey)
+		}
+		if !ed25519.Verify(sig.PublicKey, payload, sig.Signature) {
+			return errors.Errorf("invalid signature from key %x", sig.PublicKey)
+		}
+		signed[string(sig.PublicKey)] = true
+	}
+	if uint32(len(signed)) < account.Threshold {
+		return errors.Wrapf(ErrThresholdNotMet, "got %d of %d signatures", len(signed), account.Threshold)
+	}
+	return nil
+}
+
+func isMember(account *Account, pubKey []byte) bool {
+	for _, k := range account.PublicKeys {
+		if bytes.Equal(k, pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// SortPublicKeys returns a sorted copy of the given key set, an error is returned if the key set
+// isn't valid for a multisig account.
+func SortPublicKeys(pubKeys [][]byte) ([][]byte, error) {
+	if len(pubKeys) == 0 || len(pubKeys) > MaxAccountKeys {
+		return nil, errors.Wrapf(ErrInvalidRequest, "number of keys must be between 1 and %d", MaxAccountKeys)
+	}
+	sorted := make([][]byte, len(pubKeys))
+	copy(sorted, pubKeys)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	for i, pubKey := range sorted {
+		if len(pubKey) != ed25519.PublicKeySize {
+			return nil, errors.Wrap(ErrInvalidRequest, "invalid public key length")
+		}
+		if i > 0 && bytes.Equal(sorted[i-1], pubKey) {
+			return nil, errors.Wrap(ErrInvalidRequest, "duplicate public key")
+		}
+	}
+	return sorted, nil
+}
+
+var Contract plugin.Contract = contract.MakePluginContract(&Multisig{})
@@ -0,0 +1,399 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/multisig/multisig.proto
+
+package multisig
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/loomnetwork/go-loom/types"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type InitRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitRequest) Reset()         { *m = InitRequest{} }
+func (m *InitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitRequest) ProtoMessage()    {}
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{0}
+}
+func (m *InitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitRequest.Unmarshal(m, b)
+}
+func (m *InitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitRequest.Merge(m, src)
+}
+func (m *InitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitRequest.Size(m)
+}
+func (m *InitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitRequest proto.InternalMessageInfo
+
+type Account struct {
+	Address              *types.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Threshold            uint32         `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	PublicKeys           [][]byte       `protobuf:"bytes,3,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{1}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetAddress() *types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *Account) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Account) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	Threshold            uint32   `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
+func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountRequest) ProtoMessage()    {}
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{2}
+}
+func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountRequest.Merge(m, src)
+}
+func (m *CreateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountRequest.Size(m)
+}
+func (m *CreateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+
+func (m *CreateAccountRequest) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *CreateAccountRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type CreateAccountResponse struct {
+	Address              *types.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CreateAccountResponse) Reset()         { *m = CreateAccountResponse{} }
+func (m *CreateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountResponse) ProtoMessage()    {}
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{3}
+}
+func (m *CreateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountResponse.Unmarshal(m, b)
+}
+func (m *CreateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountResponse.Merge(m, src)
+}
+func (m *CreateAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountResponse.Size(m)
+}
+func (m *CreateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountResponse proto.InternalMessageInfo
+
+func (m *CreateAccountResponse) GetAddress() *types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type GetAccountRequest struct {
+	Address              *types.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{4}
+}
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountRequest.Size(m)
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetAddress() *types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+type GetAccountResponse struct {
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountResponse) Reset()         { *m = GetAccountResponse{} }
+func (m *GetAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountResponse) ProtoMessage()    {}
+func (*GetAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{5}
+}
+func (m *GetAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountResponse.Unmarshal(m, b)
+}
+func (m *GetAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountResponse.Merge(m, src)
+}
+func (m *GetAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountResponse.Size(m)
+}
+func (m *GetAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountResponse proto.InternalMessageInfo
+
+func (m *GetAccountResponse) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type Signature struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Signature) Reset()         { *m = Signature{} }
+func (m *Signature) String() string { return proto.CompactTextString(m) }
+func (*Signature) ProtoMessage()    {}
+func (*Signature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{6}
+}
+func (m *Signature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Signature.Unmarshal(m, b)
+}
+func (m *Signature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Signature.Marshal(b, m, deterministic)
+}
+func (m *Signature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Signature.Merge(m, src)
+}
+func (m *Signature) XXX_Size() int {
+	return xxx_messageInfo_Signature.Size(m)
+}
+func (m *Signature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Signature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Signature proto.InternalMessageInfo
+
+func (m *Signature) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *Signature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type MultiSignedTx struct {
+	Inner                []byte       `protobuf:"bytes,1,opt,name=inner,proto3" json:"inner,omitempty"`
+	Signatures           []*Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MultiSignedTx) Reset()         { *m = MultiSignedTx{} }
+func (m *MultiSignedTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSignedTx) ProtoMessage()    {}
+func (*MultiSignedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab3ff960ba3c26b0, []int{7}
+}
+func (m *MultiSignedTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSignedTx.Unmarshal(m, b)
+}
+func (m *MultiSignedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSignedTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSignedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSignedTx.Merge(m, src)
+}
+func (m *MultiSignedTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSignedTx.Size(m)
+}
+func (m *MultiSignedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSignedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSignedTx proto.InternalMessageInfo
+
+func (m *MultiSignedTx) GetInner() []byte {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *MultiSignedTx) GetSignatures() []*Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InitRequest)(nil), "multisig.InitRequest")
+	proto.RegisterType((*Account)(nil), "multisig.Account")
+	proto.RegisterType((*CreateAccountRequest)(nil), "multisig.CreateAccountRequest")
+	proto.RegisterType((*CreateAccountResponse)(nil), "multisig.CreateAccountResponse")
+	proto.RegisterType((*GetAccountRequest)(nil), "multisig.GetAccountRequest")
+	proto.RegisterType((*GetAccountResponse)(nil), "multisig.GetAccountResponse")
+	proto.RegisterType((*Signature)(nil), "multisig.Signature")
+	proto.RegisterType((*MultiSignedTx)(nil), "multisig.MultiSignedTx")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/multisig/multisig.proto", fileDescriptor_ab3ff960ba3c26b0)
+}
+
+var fileDescriptor_ab3ff960ba3c26b0 = []byte{
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x4b, 0xeb, 0x40,
+	0x14, 0x25, 0xed, 0x7b, 0xaf, 0xed, 0x4d, 0xbb, 0xe8, 0xbc, 0x0a, 0x41, 0x14, 0x43, 0x56, 0x01,
+	0x31, 0x91, 0x76, 0xe1, 0xc2, 0x55, 0x71, 0x61, 0x45, 0xdc, 0x44, 0xdd, 0xb8, 0x91, 0x7c, 0x5c,
+	0x92, 0xa1, 0xe9, 0x4c, 0xcc, 0xcc, 0xa0, 0xfd, 0xf7, 0x92, 0xef, 0x5a, 0x11, 0xbb, 0x09, 0x73,
+	0x4e, 0xce, 0x39, 0xf7, 0xdc, 0x49, 0x60, 0x15, 0x53, 0x99, 0xa8, 0xc0, 0x09, 0xf9, 0xc6, 0x4d,
+	0x39, 0xdf, 0x30, 0x94, 0xef, 0x3c, 0x5f, 0x97, 0xe7, 0x30, 0xf1, 0x29, 0x73, 0x03, 0x45, 0x53,
+	0x49, 0x99, 0x9b, 0xa5, 0x2a, 0xa6, 0x4c, 0xb8, 0x1b, 0x95, 0x4a, 0x2a, 0x68, 0xdc, 0x1e, 0x9c,
+	0x2c, 0xe7, 0x92, 0x93, 0x61, 0x83, 0x8f, 0x2f, 0x7f, 0xc8, 0x8c, 0xf9, 0x45, 0x01, 0x5d, 0xb9,
+	0xcd, 0x50, 0x54, 0xcf, 0xca, 0x6b, 0x4d, 0x40, 0xbf, 0x63, 0x54, 0x7a, 0xf8, 0xa6, 0x50, 0x48,
+	0x2b, 0x85, 0xc1, 0x32, 0x0c, 0xb9, 0x62, 0x92, 0x58, 0x30, 0xf0, 0xa3, 0x28, 0x47, 0x21, 0x0c,
+	0xcd, 0xd4, 0x6c, 0x7d, 0x3e, 0x74, 0x96, 0x15, 0xf6, 0x9a, 0x17, 0xe4, 0x04, 0x46, 0x32, 0xc9,
+	0x51, 0x24, 0x3c, 0x8d, 0x8c, 0x9e, 0xa9, 0xd9, 0x13, 0xaf, 0x23, 0xc8, 0x19, 0xe8, 0x99, 0x0a,
+	0x52, 0x1a, 0xbe, 0xae, 0x71, 0x2b, 0x8c, 0xbe, 0xd9, 0xb7, 0xc7, 0x1e, 0x54, 0xd4, 0x3d, 0x6e,
+	0x85, 0xf5, 0x0c, 0xb3, 0x9b, 0x1c, 0x7d, 0x89, 0xf5, 0xcc, 0xba, 0xc5, 0xd7, 0x58, 0xed, 0x97,
+	0xd8, 0xde, 0xb7, 0xd8, 0x6b, 0x38, 0xda, 0x8b, 0x15, 0x19, 0x67, 0x02, 0x0f, 0x59, 0xc9, 0xba,
+	0x82, 0xe9, 0x2d, 0xca, 0xbd, 0x42, 0x87, 0x18, 0x97, 0x40, 0x76, 0x8d, 0xf5, 0xc8, 0x73, 0x18,
+	0xf8, 0x15, 0x55, 0x3b, 0xa7, 0x4e, 0xfb, 0xf5, 0x1a, 0x6d, 0xa3, 0xb0, 0x56, 0x30, 0x7a, 0xa4,
+	0x31, 0xf3, 0xa5, 0xca, 0x91, 0x9c, 0x02, 0x74, 0x6b, 0x96, 0xe6, 0xb1, 0x37, 0x6a, 0xb7, 0x2c,
+	0xee, 0x48, 0x34, 0xda, 0xf2, 0xea, 0xc7, 0x5e, 0x47, 0x58, 0x2f, 0x30, 0x79, 0x28, 0xc6, 0x14,
+	0x71, 0x18, 0x3d, 0x7d, 0x90, 0x19, 0xfc, 0xa5, 0x8c, 0x61, 0x5e, 0x07, 0x55, 0x80, 0x2c, 0x00,
+	0x5a, 0x8f, 0x30, 0xfe, 0x98, 0x7d, 0x5b, 0x9f, 0xff, 0xef, 0x0a, 0xb6, 0x65, 0xbc, 0x1d, 0x59,
+	0xf0, 0xaf, 0xfc, 0x73, 0x16, 0x9f, 0x03, 0x00, 0x3d, 0x49, 0xad, 0x01, 0xc1, 0x02, 0x00, 0x00,
+}
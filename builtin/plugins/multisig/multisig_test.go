@@ -0,0 +1,104 @@
+package multisig
+
+import (
+	"testing"
+	"time"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ed25519"
+)
+
+var (
+	addr1 = loom.MustParseAddress("default:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+)
+
+func generateKeys(t *testing.T, n int) ([][]byte, []ed25519.PrivateKey) {
+	var pubKeys [][]byte
+	var privKeys []ed25519.PrivateKey
+	for i := 0; i < n; i++ {
+		pubKey, privKey, err := ed25519.GenerateKey(nil)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+		privKeys = append(privKeys, privKey)
+	}
+	return pubKeys, privKeys
+}
+
+func TestCreateAccount(t *testing.T) {
+	pctx := plugin.CreateFakeContext(addr1, addr1).WithBlock(loom.BlockHeader{
+		ChainID: "default",
+		Time:    time.Now().Unix(),
+	})
+	ctx := contractpb.WrapPluginContext(pctx)
+	contract := &Multisig{}
+	require.NoError(t, contract.Init(ctx, &InitRequest{}))
+
+	pubKeys, _ := generateKeys(t, 3)
+
+	_, err := contract.CreateAccount(ctx, &CreateAccountRequest{Threshold: 4, PublicKeys: pubKeys})
+	require.Error(t, err)
+	_, err = contract.CreateAccount(ctx, &CreateAccountRequest{Threshold: 0, PublicKeys: pubKeys})
+	require.Error(t, err)
+	_, err = contract.CreateAccount(ctx, &CreateAccountRequest{
+		Threshold: 2, PublicKeys: [][]byte{pubKeys[0], pubKeys[0]},
+	})
+	require.Error(t, err)
+
+	resp, err := contract.CreateAccount(ctx, &CreateAccountRequest{Threshold: 2, PublicKeys: pubKeys})
+	require.NoError(t, err)
+	addr := loom.UnmarshalAddressPB(resp.Address)
+	require.Equal(t, "default", addr.ChainID)
+
+	// The address must not depend on the order of the keys
+	sorted, err := SortPublicKeys(pubKeys)
+	require.NoError(t, err)
+	require.Equal(t, AccountAddress(2, sorted), addr.Local)
+	_, err = contract.CreateAccount(ctx, &CreateAccountRequest{
+		Threshold: 2, PublicKeys: [][]byte{pubKeys[2], pubKeys[1], pubKeys[0]},
+	})
+	require.Equal(t, ErrAccountAlreadyExists, err)
+
+	// Different threshold means a different account
+	resp2, err := contract.CreateAccount(ctx, &CreateAccountRequest{Threshold: 3, PublicKeys: pubKeys})
+	require.NoError(t, err)
+	require.NotEqual(t, resp.Address.Local, resp2.Address.Local)
+
+	getResp, err := contract.GetAccount(ctx, &GetAccountRequest{Address: resp.Address})
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), getResp.Account.Threshold)
+	require.Equal(t, sorted, getResp.Account.PublicKeys)
+
+	_, err = GetAccount(ctx, addr1)
+	require.Equal(t, ErrAccountNotFound, err)
+}
+
+func TestVerifySignatures(t *testing.T) {
+	pubKeys, privKeys := generateKeys(t, 3)
+	sorted, err := SortPublicKeys(pubKeys)
+	require.NoError(t, err)
+	account := &Account{Threshold: 2, PublicKeys: sorted}
+	payload := []byte("payload")
+
+	sig := func(i int) *Signature {
+		return &Signature{PublicKey: pubKeys[i], Signature: ed25519.Sign(privKeys[i], payload)}
+	}
+
+	require.NoError(t, VerifySignatures(account, payload, []*Signature{sig(0), sig(2)}))
+	require.NoError(t, VerifySignatures(account, payload, []*Signature{sig(0), sig(1), sig(2)}))
+
+	// the same member signing twice doesn't count towards the threshold
+	err = VerifySignatures(account, payload, []*Signature{sig(1), sig(1)})
+	require.Error(t, err)
+
+	// signature over a different payload
+	bad := &Signature{PublicKey: pubKeys[1], Signature: ed25519.Sign(privKeys[1], []byte("other"))}
+	require.Error(t, VerifySignatures(account, payload, []*Signature{sig(0), bad}))
+
+	// signature from a non-member
+	otherPubKeys, otherPrivKeys := generateKeys(t, 1)
+	outsider := &Signature{PublicKey: otherPubKeys[0], Signature: ed25519.Sign(otherPrivKeys[0], payload)}
+	require.Error(t, VerifySignatures(account, payload, []*Signature{sig(0), outsider}))
+}
@@ -0,0 +1,49 @@
+// Package pagination implements the cursor-based pagination used by the list queries of the builtin
+// contracts. A page is identified by the key of its first item and the maximum number of items it
+// may contain, every page of a list also returns the key the next page starts at.
+package pagination
+
+import (
+	"bytes"
+	"sort"
+)
+
+const (
+	// DefaultPageSize is the number of items returned in a page when no limit is specified.
+	DefaultPageSize = 100
+	// MaxPageSize is the maximum number of items that can be returned in a single page.
+	MaxPageSize = 1000
+)
+
+// PageSize returns the number of items in a page given the limit specified by the caller.
+func PageSize(limit uint32) int {
+	if limit == 0 {
+		return DefaultPageSize
+	}
+	if limit > MaxPageSize {
+		return MaxPageSize
+	}
+	return int(limit)
+}
+
+// Page takes the keys of all the items in a list, and returns the indices of the items in the page
+// that starts at startKey (or the start of the list if startKey is empty) in ascending key order,
+// along with the key of the first item in the next page (nil if there are no more items).
+// The keys must be unique.
+func Page(keys [][]byte, startKey []byte, limit uint32) ([]int, []byte) {
+	indices := make([]int, 0, len(keys))
+	for i, key := range keys {
+		if bytes.Compare(key, startKey) >= 0 {
+			indices = append(indices, i)
+		}
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return bytes.Compare(keys[indices[i]], keys[indices[j]]) < 0
+	})
+
+	size := PageSize(limit)
+	if len(indices) > size {
+		return indices[:size], keys[indices[size]]
+	}
+	return indices, nil
+}
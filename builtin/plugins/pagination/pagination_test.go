@@ -0,0 +1,38 @@
+package pagination
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPage(t *testing.T) {
+	keys := [][]byte{[]byte("c"), []byte("a"), []byte("e"), []byte("b"), []byte("d")}
+
+	indices, next := Page(keys, nil, 2)
+	require.Equal(t, []int{1, 3}, indices)
+	require.Equal(t, []byte("c"), next)
+
+	indices, next = Page(keys, next, 2)
+	require.Equal(t, []int{0, 4}, indices)
+	require.Equal(t, []byte("e"), next)
+
+	indices, next = Page(keys, next, 2)
+	require.Equal(t, []int{2}, indices)
+	require.Nil(t, next)
+
+	// start keys don't have to match an existing key
+	indices, next = Page(keys, []byte("bb"), 0)
+	require.Equal(t, []int{0, 4, 2}, indices)
+	require.Nil(t, next)
+
+	indices, next = Page(nil, nil, 0)
+	require.Len(t, indices, 0)
+	require.Nil(t, next)
+}
+
+func TestPageSize(t *testing.T) {
+	require.Equal(t, DefaultPageSize, PageSize(0))
+	require.Equal(t, 5, PageSize(5))
+	require.Equal(t, MaxPageSize, PageSize(MaxPageSize+1))
+}
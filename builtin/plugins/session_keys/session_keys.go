@@ -0,0 +1,174 @@
+package session_keys
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxScopes is the maximum number of contracts a single session key can be scoped to.
+	MaxScopes = 16
+	// MaxDuration is the maximum number of blocks a session key can be authorized for, roughly
+	// 30 days at one block per second.
+	MaxDuration = 30 * 24 * 60 * 60
+
+	sessionKeyPrefix = "sk"
+)
+
+var (
+	// ErrInvalidRequest is a generic error that's returned when something is wrong with the
+	// request message, e.g. missing or invalid fields.
+	ErrInvalidRequest = errors.New("[SessionKeys] invalid request")
+	// ErrSessionKeyNotFound is returned if the session key hasn't been authorized by the account.
+	ErrSessionKeyNotFound = errors.New("[SessionKeys] session key not found")
+)
+
+func ownerPrefix(owner loom.Address) []byte {
+	return util.PrefixKey([]byte(sessionKeyPrefix), owner.Bytes())
+}
+
+func sessionKeyKey(owner, sessionKey loom.Address) []byte {
+	return util.PrefixKey(ownerPrefix(owner), sessionKey.Bytes())
+}
+
+// SessionKeys contract allows an account to authorize secondary keys that can sign txs on behalf
+// of the account for a limited number of blocks, and only for calls to the listed contracts.
+// Txs signed by a session key must specify the owner of the session key as the sender, so
+// authorizing a key has no effect on txs the key holder sends from their own account.
+type SessionKeys struct {
+}
+
+func (sk *SessionKeys) Meta() (plugin.Meta, error) {
+	return plugin.Meta{
+		Name:    "sessionkeys",
+		Version: "1.0.0",
+	}, nil
+}
+
+func (sk *SessionKeys) Init(ctx contract.Context, req *InitRequest) error {
+	return nil
+}
+
+// AuthorizeSessionKey allows the given session key to sign txs on behalf of the caller. If the
+// caller has already authorized the session key its expiry & scopes will be replaced.
+// NOTE: The caller address must be the address contracts see as the tx sender, so accounts from
+//       other chains must authorize session keys via their mapped DAppChain account.
+func (sk *SessionKeys) AuthorizeSessionKey(ctx contract.Context, req *AuthorizeSessionKeyRequest) error {
+	if req.SessionKey == nil || req.Duration == 0 || len(req.Scopes) == 0 || len(req.Scopes) > MaxScopes {
+		return ErrInvalidRequest
+	}
+	if req.Duration > MaxDuration {
+		return errors.Wrapf(ErrInvalidRequest, "duration can't exceed %d blocks", MaxDuration)
+	}
+	for _, scope := range req.Scopes {
+		if scope.Contract == nil {
+			return ErrInvalidRequest
+		}
+		// A session key must never be able to authorize other session keys.
+		if loom.UnmarshalAddressPB(scope.Contract).Compare(ctx.ContractAddress()) == 0 {
+			return errors.Wrap(ErrInvalidRequest, "session key can't be scoped to the SessionKeys contract")
+		}
+	}
+
+	owner := ctx.Message().Sender
+	sessionAddr := loom.UnmarshalAddressPB(req.SessionKey)
+	if sessionAddr.Compare(owner) == 0 {
+		return errors.Wrap(ErrInvalidRequest, "account can't be its own session key")
+	}
+
+	key := &SessionKey{
+		Address:   sessionAddr.MarshalPB(),
+		Owner:     owner.MarshalPB(),
+		ExpiresAt: uint64(ctx.Block().Height) + req.Duration,
+		Scopes:    req.Scopes,
+	}
+	if err := ctx.Set(sessionKeyKey(owner, sessionAddr), key); err != nil {
+		return errors.Wrap(err, "failed to save session key")
+	}
+	return nil
+}
+
+// RevokeSessionKey revokes a session key previously authorized by the caller.
+func (sk *SessionKeys) RevokeSessionKey(ctx contract.Context, req *RevokeSessionKeyRequest) error {
+	if req.SessionKey == nil {
+		return ErrInvalidRequest
+	}
+	owner := ctx.Message().Sender
+	sessionAddr := loom.UnmarshalAddressPB(req.SessionKey)
+	if !ctx.Has(sessionKeyKey(owner, sessionAddr)) {
+		return ErrSessionKeyNotFound
+	}
+	ctx.Delete(sessionKeyKey(owner, sessionAddr))
+	return nil
+}
+
+func (sk *SessionKeys) GetSessionKey(
+	ctx contract.StaticContext, req *GetSessionKeyRequest,
+) (*GetSessionKeyResponse, error) {
+	if req.Owner == nil || req.SessionKey == nil {
+		return nil, ErrInvalidRequest
+	}
+	key, err := GetSessionKey(ctx, loom.UnmarshalAddressPB(req.Owner), loom.UnmarshalAddressPB(req.SessionKey))
+	if err != nil {
+		return nil, err
+	}
+	return &GetSessionKeyResponse{SessionKey: key}, nil
+}
+
+// ListSessionKeys returns all the session keys authorized by the given account, including any
+// that have already expired.
+func (sk *SessionKeys) ListSessionKeys(
+	ctx contract.StaticContext, req *ListSessionKeysRequest,
+) (*ListSessionKeysResponse, error) {
+	if req.Owner == nil {
+		return nil, ErrInvalidRequest
+	}
+	owner := loom.UnmarshalAddressPB(req.Owner)
+	keys := []*SessionKey{}
+	for _, m := range ctx.Range(ownerPrefix(owner)) {
+		var key SessionKey
+		if err := proto.Unmarshal(m.Value, &key); err != nil {
+			return nil, errors.Wrapf(err, "unmarshal session key %x", m.Key)
+		}
+		keys = append(keys, &key)
+	}
+	return &ListSessionKeysResponse{SessionKeys: keys}, nil
+}
+
+// GetSessionKey is called by the auth middleware to look up the expiry & scopes of a session key.
+func GetSessionKey(ctx contract.StaticContext, owner, sessionKey loom.Address) (*SessionKey, error) {
+	var key SessionKey
+	if err := ctx.Get(sessionKeyKey(owner, sessionKey), &key); err != nil {
+		if err == contract.ErrNotFound {
+			return nil, ErrSessionKeyNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to load session key %v", sessionKey)
+	}
+	return &key, nil
+}
+
+// IsCallAllowed checks if the session key is allowed to call the given contract method.
+func IsCallAllowed(key *SessionKey, contractAddr loom.Address, method string) bool {
+	for _, scope := range key.Scopes {
+		if loom.UnmarshalAddressPB(scope.Contract).Compare(contractAddr) != 0 {
+			continue
+		}
+		if len(scope.Methods) == 0 {
+			return true
+		}
+		for _, m := range scope.Methods {
+			if strings.EqualFold(m, method) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+var Contract plugin.Contract = contract.MakePluginContract(&SessionKeys{})
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/session_keys/session_keys.proto
+
+package session_keys
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/loomnetwork/go-loom/types"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type InitRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitRequest) Reset()         { *m = InitRequest{} }
+func (m *InitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitRequest) ProtoMessage()    {}
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{0}
+}
+func (m *InitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitRequest.Unmarshal(m, b)
+}
+func (m *InitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitRequest.Merge(m, src)
+}
+func (m *InitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitRequest.Size(m)
+}
+func (m *InitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitRequest proto.InternalMessageInfo
+
+type Scope struct {
+	Contract             *types.Address `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Methods              []string       `protobuf:"bytes,2,rep,name=methods,proto3" json:"methods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Scope) Reset()         { *m = Scope{} }
+func (m *Scope) String() string { return proto.CompactTextString(m) }
+func (*Scope) ProtoMessage()    {}
+func (*Scope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{1}
+}
+func (m *Scope) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Scope.Unmarshal(m, b)
+}
+func (m *Scope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Scope.Marshal(b, m, deterministic)
+}
+func (m *Scope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Scope.Merge(m, src)
+}
+func (m *Scope) XXX_Size() int {
+	return xxx_messageInfo_Scope.Size(m)
+}
+func (m *Scope) XXX_DiscardUnknown() {
+	xxx_messageInfo_Scope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Scope proto.InternalMessageInfo
+
+func (m *Scope) GetContract() *types.Address {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *Scope) GetMethods() []string {
+	if m != nil {
+		return m.Methods
+	}
+	return nil
+}
+
+type SessionKey struct {
+	Address              *types.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Owner                *types.Address `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ExpiresAt            uint64         `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Scopes               []*Scope       `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SessionKey) Reset()         { *m = SessionKey{} }
+func (m *SessionKey) String() string { return proto.CompactTextString(m) }
+func (*SessionKey) ProtoMessage()    {}
+func (*SessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{2}
+}
+func (m *SessionKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionKey.Unmarshal(m, b)
+}
+func (m *SessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionKey.Marshal(b, m, deterministic)
+}
+func (m *SessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionKey.Merge(m, src)
+}
+func (m *SessionKey) XXX_Size() int {
+	return xxx_messageInfo_SessionKey.Size(m)
+}
+func (m *SessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionKey proto.InternalMessageInfo
+
+func (m *SessionKey) GetAddress() *types.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *SessionKey) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *SessionKey) GetExpiresAt() uint64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *SessionKey) GetScopes() []*Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+type AuthorizeSessionKeyRequest struct {
+	SessionKey           *types.Address `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	Duration             uint64         `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	Scopes               []*Scope       `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AuthorizeSessionKeyRequest) Reset()         { *m = AuthorizeSessionKeyRequest{} }
+func (m *AuthorizeSessionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthorizeSessionKeyRequest) ProtoMessage()    {}
+func (*AuthorizeSessionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{3}
+}
+func (m *AuthorizeSessionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthorizeSessionKeyRequest.Unmarshal(m, b)
+}
+func (m *AuthorizeSessionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthorizeSessionKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthorizeSessionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizeSessionKeyRequest.Merge(m, src)
+}
+func (m *AuthorizeSessionKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthorizeSessionKeyRequest.Size(m)
+}
+func (m *AuthorizeSessionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizeSessionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizeSessionKeyRequest proto.InternalMessageInfo
+
+func (m *AuthorizeSessionKeyRequest) GetSessionKey() *types.Address {
+	if m != nil {
+		return m.SessionKey
+	}
+	return nil
+}
+
+func (m *AuthorizeSessionKeyRequest) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *AuthorizeSessionKeyRequest) GetScopes() []*Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+type RevokeSessionKeyRequest struct {
+	SessionKey           *types.Address `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RevokeSessionKeyRequest) Reset()         { *m = RevokeSessionKeyRequest{} }
+func (m *RevokeSessionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionKeyRequest) ProtoMessage()    {}
+func (*RevokeSessionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{4}
+}
+func (m *RevokeSessionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeSessionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeSessionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionKeyRequest.Merge(m, src)
+}
+func (m *RevokeSessionKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionKeyRequest.Size(m)
+}
+func (m *RevokeSessionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSessionKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeSessionKeyRequest) GetSessionKey() *types.Address {
+	if m != nil {
+		return m.SessionKey
+	}
+	return nil
+}
+
+type GetSessionKeyRequest struct {
+	Owner                *types.Address `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	SessionKey           *types.Address `protobuf:"bytes,2,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetSessionKeyRequest) Reset()         { *m = GetSessionKeyRequest{} }
+func (m *GetSessionKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSessionKeyRequest) ProtoMessage()    {}
+func (*GetSessionKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{5}
+}
+func (m *GetSessionKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSessionKeyRequest.Unmarshal(m, b)
+}
+func (m *GetSessionKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSessionKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSessionKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSessionKeyRequest.Merge(m, src)
+}
+func (m *GetSessionKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSessionKeyRequest.Size(m)
+}
+func (m *GetSessionKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSessionKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSessionKeyRequest proto.InternalMessageInfo
+
+func (m *GetSessionKeyRequest) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *GetSessionKeyRequest) GetSessionKey() *types.Address {
+	if m != nil {
+		return m.SessionKey
+	}
+	return nil
+}
+
+type GetSessionKeyResponse struct {
+	SessionKey           *SessionKey `protobuf:"bytes,1,opt,name=session_key,json=sessionKey,proto3" json:"session_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetSessionKeyResponse) Reset()         { *m = GetSessionKeyResponse{} }
+func (m *GetSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSessionKeyResponse) ProtoMessage()    {}
+func (*GetSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{6}
+}
+func (m *GetSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSessionKeyResponse.Unmarshal(m, b)
+}
+func (m *GetSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSessionKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSessionKeyResponse.Merge(m, src)
+}
+func (m *GetSessionKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSessionKeyResponse.Size(m)
+}
+func (m *GetSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSessionKeyResponse proto.InternalMessageInfo
+
+func (m *GetSessionKeyResponse) GetSessionKey() *SessionKey {
+	if m != nil {
+		return m.SessionKey
+	}
+	return nil
+}
+
+type ListSessionKeysRequest struct {
+	Owner                *types.Address `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListSessionKeysRequest) Reset()         { *m = ListSessionKeysRequest{} }
+func (m *ListSessionKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSessionKeysRequest) ProtoMessage()    {}
+func (*ListSessionKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{7}
+}
+func (m *ListSessionKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionKeysRequest.Unmarshal(m, b)
+}
+func (m *ListSessionKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSessionKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionKeysRequest.Merge(m, src)
+}
+func (m *ListSessionKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSessionKeysRequest.Size(m)
+}
+func (m *ListSessionKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionKeysRequest proto.InternalMessageInfo
+
+func (m *ListSessionKeysRequest) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+type ListSessionKeysResponse struct {
+	SessionKeys          []*SessionKey `protobuf:"bytes,1,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ListSessionKeysResponse) Reset()         { *m = ListSessionKeysResponse{} }
+func (m *ListSessionKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSessionKeysResponse) ProtoMessage()    {}
+func (*ListSessionKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e092ca279f31325, []int{8}
+}
+func (m *ListSessionKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSessionKeysResponse.Unmarshal(m, b)
+}
+func (m *ListSessionKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSessionKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSessionKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSessionKeysResponse.Merge(m, src)
+}
+func (m *ListSessionKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSessionKeysResponse.Size(m)
+}
+func (m *ListSessionKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSessionKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSessionKeysResponse proto.InternalMessageInfo
+
+func (m *ListSessionKeysResponse) GetSessionKeys() []*SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InitRequest)(nil), "session_keys.InitRequest")
+	proto.RegisterType((*Scope)(nil), "session_keys.Scope")
+	proto.RegisterType((*SessionKey)(nil), "session_keys.SessionKey")
+	proto.RegisterType((*AuthorizeSessionKeyRequest)(nil), "session_keys.AuthorizeSessionKeyRequest")
+	proto.RegisterType((*RevokeSessionKeyRequest)(nil), "session_keys.RevokeSessionKeyRequest")
+	proto.RegisterType((*GetSessionKeyRequest)(nil), "session_keys.GetSessionKeyRequest")
+	proto.RegisterType((*GetSessionKeyResponse)(nil), "session_keys.GetSessionKeyResponse")
+	proto.RegisterType((*ListSessionKeysRequest)(nil), "session_keys.ListSessionKeysRequest")
+	proto.RegisterType((*ListSessionKeysResponse)(nil), "session_keys.ListSessionKeysResponse")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/session_keys/session_keys.proto", fileDescriptor_0e092ca279f31325)
+}
+
+var fileDescriptor_0e092ca279f31325 = []byte{
+	// 401 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x49, 0xd3, 0xdd, 0xed, 0x9e, 0xe8, 0xcd, 0xf8, 0x67, 0x87, 0x82, 0x12, 0x82, 0x17,
+	0x15, 0xb1, 0x91, 0xf5, 0x46, 0xf1, 0xaa, 0x20, 0x2c, 0xa2, 0x17, 0x32, 0x0b, 0xde, 0x2e, 0x69,
+	0x7a, 0x68, 0x86, 0xb6, 0x73, 0xe2, 0x9c, 0x89, 0x6b, 0x7d, 0x0a, 0x1f, 0xc0, 0x87, 0x95, 0x4d,
+	0xb2, 0x4d, 0x9a, 0xac, 0x55, 0xf0, 0x26, 0xe4, 0x9c, 0x99, 0xef, 0x3b, 0xbf, 0xf3, 0xc1, 0xc0,
+	0xe7, 0xa5, 0x76, 0x59, 0x31, 0x9f, 0xa6, 0xb4, 0x89, 0xd7, 0x44, 0x1b, 0x83, 0xee, 0x9a, 0xec,
+	0xaa, 0xfc, 0x4f, 0xb3, 0x44, 0x9b, 0x78, 0x5e, 0xe8, 0xb5, 0xd3, 0x26, 0xce, 0xd7, 0xc5, 0x52,
+	0x1b, 0x8e, 0x19, 0x99, 0x35, 0x99, 0xab, 0x15, 0x6e, 0xf7, 0x8b, 0x69, 0x6e, 0xc9, 0x91, 0xb8,
+	0xd7, 0xee, 0x8d, 0x5f, 0xfd, 0xc1, 0x7f, 0x49, 0x2f, 0x6f, 0xca, 0xd8, 0x6d, 0x73, 0xe4, 0xea,
+	0x5b, 0xe9, 0xa3, 0xfb, 0x10, 0x7c, 0x30, 0xda, 0x29, 0xfc, 0x5a, 0x20, 0xbb, 0xe8, 0x02, 0x8e,
+	0x2e, 0x53, 0xca, 0x51, 0x3c, 0x83, 0x51, 0x4a, 0xc6, 0xd9, 0x24, 0x75, 0xd2, 0x0b, 0xbd, 0x49,
+	0x70, 0x3e, 0x9a, 0xce, 0x16, 0x0b, 0x8b, 0xcc, 0x6a, 0x77, 0x22, 0x24, 0x9c, 0x6c, 0xd0, 0x65,
+	0xb4, 0x60, 0x39, 0x08, 0xfd, 0xc9, 0xa9, 0xba, 0x2d, 0xa3, 0x5f, 0x1e, 0xc0, 0x65, 0x85, 0xf6,
+	0x11, 0xb7, 0x22, 0x82, 0x93, 0xa4, 0x52, 0xf7, 0xdc, 0x6e, 0x0f, 0xc4, 0x53, 0x38, 0xa2, 0x6b,
+	0x83, 0x56, 0x0e, 0x3a, 0x37, 0xaa, 0xb6, 0x78, 0x02, 0x80, 0xdf, 0x73, 0x6d, 0x91, 0xaf, 0x12,
+	0x27, 0xfd, 0xd0, 0x9b, 0x0c, 0xd5, 0x69, 0xdd, 0x99, 0x39, 0xf1, 0x02, 0x8e, 0xf9, 0x06, 0x9d,
+	0xe5, 0x30, 0xf4, 0x27, 0xc1, 0xf9, 0x83, 0xe9, 0x5e, 0x5c, 0xe5, 0x5a, 0xaa, 0xbe, 0x12, 0xfd,
+	0xf4, 0x60, 0x3c, 0x2b, 0x5c, 0x46, 0x56, 0xff, 0xc0, 0x86, 0xb3, 0x8e, 0x41, 0x3c, 0x87, 0xa0,
+	0x25, 0xee, 0x21, 0x03, 0x37, 0x9b, 0x8d, 0x61, 0xb4, 0x28, 0x6c, 0xe2, 0x34, 0x99, 0x12, 0x7c,
+	0xa8, 0x76, 0x75, 0x0b, 0xc9, 0xff, 0x3b, 0xd2, 0x7b, 0x38, 0x53, 0xf8, 0x8d, 0x56, 0xff, 0x85,
+	0x13, 0x25, 0xf0, 0xf0, 0x02, 0x5d, 0xdf, 0x62, 0x17, 0xae, 0x77, 0x77, 0xb8, 0x9d, 0x11, 0x83,
+	0x03, 0x23, 0x14, 0x3c, 0xea, 0x8c, 0xe0, 0x9c, 0x0c, 0xa3, 0x78, 0x7b, 0x17, 0xa6, 0xec, 0xec,
+	0xdc, 0xc8, 0xda, 0x9e, 0x6f, 0xe0, 0xf1, 0x27, 0xcd, 0x2d, 0x53, 0xfe, 0x47, 0xf0, 0xe8, 0x0b,
+	0x9c, 0xf5, 0x94, 0x35, 0xcf, 0x3b, 0xd8, 0x7b, 0x1d, 0xd2, 0x0b, 0xfd, 0x83, 0x40, 0x41, 0x03,
+	0xc4, 0xf3, 0xe3, 0xf2, 0x7d, 0xbc, 0xfe, 0x3d, 0x00, 0x30, 0xd0, 0xfa, 0x0f, 0xb3, 0x03, 0x00,
+	0x00,
+}
@@ -0,0 +1,83 @@
+package session_keys
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	owner      = loom.MustParseAddress("default:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	sessionKey = loom.MustParseAddress("default:0xfa4c7920accfd66b86f5fd0e69682a79f762d49e")
+	contract1  = loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	contract2  = loom.MustParseAddress("default:0x46ecd1f7261e1f4c684e297be3edf03b825e01c4")
+)
+
+func TestAuthorizeSessionKey(t *testing.T) {
+	pctx := plugin.CreateFakeContext(owner, owner).WithBlock(loom.BlockHeader{
+		ChainID: "default",
+		Height:  10,
+		Time:    time.Now().Unix(),
+	})
+	contractAddr := pctx.CreateContract(Contract)
+	ctx := contractpb.WrapPluginContext(pctx.WithAddress(contractAddr))
+	c := &SessionKeys{}
+
+	scopes := []*Scope{
+		{Contract: contract1.MarshalPB()},
+		{Contract: contract2.MarshalPB(), Methods: []string{"Transfer", "0xa9059cbb"}},
+	}
+	require.Error(t, c.AuthorizeSessionKey(ctx, &AuthorizeSessionKeyRequest{
+		SessionKey: sessionKey.MarshalPB(), Duration: 100,
+	}))
+	require.Error(t, c.AuthorizeSessionKey(ctx, &AuthorizeSessionKeyRequest{
+		SessionKey: sessionKey.MarshalPB(), Duration: 100,
+		Scopes: []*Scope{{Contract: contractAddr.MarshalPB()}},
+	}))
+	require.Error(t, c.AuthorizeSessionKey(ctx, &AuthorizeSessionKeyRequest{
+		SessionKey: owner.MarshalPB(), Duration: 100, Scopes: scopes,
+	}))
+	require.Error(t, c.AuthorizeSessionKey(ctx, &AuthorizeSessionKeyRequest{
+		SessionKey: sessionKey.MarshalPB(), Duration: MaxDuration + 1, Scopes: scopes,
+	}))
+	require.Error(t, c.AuthorizeSessionKey(ctx, &AuthorizeSessionKeyRequest{
+		SessionKey: sessionKey.MarshalPB(), Duration: math.MaxUint64, Scopes: scopes,
+	}))
+	require.NoError(t, c.AuthorizeSessionKey(ctx, &AuthorizeSessionKeyRequest{
+		SessionKey: sessionKey.MarshalPB(), Duration: 100, Scopes: scopes,
+	}))
+
+	resp, err := c.GetSessionKey(ctx, &GetSessionKeyRequest{
+		Owner: owner.MarshalPB(), SessionKey: sessionKey.MarshalPB(),
+	})
+	require.NoError(t, err)
+	key := resp.SessionKey
+	require.Equal(t, uint64(110), key.ExpiresAt)
+	require.True(t, IsCallAllowed(key, contract1, "Anything"))
+	require.True(t, IsCallAllowed(key, contract2, "Transfer"))
+	require.True(t, IsCallAllowed(key, contract2, "0xA9059CBB"))
+	require.False(t, IsCallAllowed(key, contract2, "Approve"))
+	require.False(t, IsCallAllowed(key, owner, "Transfer"))
+
+	// the session key is only valid for the account that authorized it
+	_, err = GetSessionKey(ctx, contract1, sessionKey)
+	require.Equal(t, ErrSessionKeyNotFound, err)
+
+	listResp, err := c.ListSessionKeys(ctx, &ListSessionKeysRequest{Owner: owner.MarshalPB()})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.SessionKeys))
+
+	// only the owner can revoke the session key
+	otherCtx := contractpb.WrapPluginContext(pctx.WithAddress(contractAddr).WithSender(contract1))
+	require.Equal(t, ErrSessionKeyNotFound, c.RevokeSessionKey(otherCtx, &RevokeSessionKeyRequest{
+		SessionKey: sessionKey.MarshalPB(),
+	}))
+	require.NoError(t, c.RevokeSessionKey(ctx, &RevokeSessionKeyRequest{SessionKey: sessionKey.MarshalPB()}))
+	_, err = GetSessionKey(ctx, owner, sessionKey)
+	require.Equal(t, ErrSessionKeyNotFound, err)
+}
@@ -0,0 +1,141 @@
+package tx_fees
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	"github.com/loomnetwork/go-loom/plugin"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNotAuthorized indicates that a contract method failed because the caller didn't have
+	// the permission to execute that method.
+	ErrNotAuthorized = errors.New("[TxFees] not authorized")
+	// ErrInvalidRequest is a generic error that's returned when something is wrong with the
+	// request message, e.g. missing or invalid fields.
+	ErrInvalidRequest = errors.New("[TxFees] invalid request")
+	// ErrOwnerNotSpecified returned if init request does not have owner address
+	ErrOwnerNotSpecified = errors.New("[TxFees] owner not specified")
+)
+
+const (
+	ownerRole = "owner"
+)
+
+var (
+	configKey  = []byte("config")
+	modifyPerm = []byte("modp")
+)
+
+// TxFees contract stores the fee schedule that's applied by the tx fee middleware.
+type TxFees struct {
+}
+
+func (tf *TxFees) Meta() (plugin.Meta, error) {
+	return plugin.Meta{
+		Name:    "txfees",
+		Version: "1.0.0",
+	}, nil
+}
+
+func (tf *TxFees) Init(ctx contract.Context, req *InitRequest) error {
+	if req.Owner == nil {
+		return ErrOwnerNotSpecified
+	}
+	ownerAddr := loom.UnmarshalAddressPB(req.Owner)
+	ctx.GrantPermissionTo(ownerAddr, modifyPerm, ownerRole)
+
+	cfg := req.Config
+	if cfg == nil {
+		cfg = &FeeConfig{}
+	}
+	if err := validateFeeConfig(cfg); err != nil {
+		return err
+	}
+	return ctx.Set(configKey, cfg)
+}
+
+// SetFeeConfig replaces the current fee schedule, only the contract owner can change the schedule.
+func (tf *TxFees) SetFeeConfig(ctx contract.Context, req *SetFeeConfigRequest) error {
+	if ok, _ := ctx.HasPermission(modifyPerm, []string{ownerRole}); !ok {
+		return ErrNotAuthorized
+	}
+	if req.Config == nil {
+		return ErrInvalidRequest
+	}
+	if err := validateFeeConfig(req.Config); err != nil {
+		return err
+	}
+	return ctx.Set(configKey, req.Config)
+}
+
+func validateFeeConfig(cfg *FeeConfig) error {
+	switch cfg.Denom {
+	case Denom_COIN:
+	case Denom_ETHCOIN:
+		if cfg.Treasury == nil || loom.UnmarshalAddressPB(cfg.Treasury).IsEmpty() {
+			return errors.Wrap(ErrInvalidRequest, "treasury must be set for ETHCOIN fees")
+		}
+	default:
+		return errors.Wrap(ErrInvalidRequest, "unsupported denomination")
+	}
+	return nil
+}
+
+func (tf *TxFees) GetFeeConfig(ctx contract.StaticContext, req *GetFeeConfigRequest) (*GetFeeConfigResponse, error) {
+	cfg, err := GetFeeConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &GetFeeConfigResponse{Config: cfg}, nil
+}
+
+// GetFeeConfig loads the current fee schedule.
+func GetFeeConfig(ctx contract.StaticContext) (*FeeConfig, error) {
+	var cfg FeeConfig
+	if err := ctx.Get(configKey, &cfg); err != nil {
+		if err == contract.ErrNotFound {
+			return &FeeConfig{}, nil
+		}
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// ComputeFee returns the total fee for a tx that consumed the given amount of gas.
+func ComputeFee(cfg *FeeConfig, gasUsed uint64) *loom.BigUInt {
+	fee := common.BigZero()
+	if cfg.TxFee != nil && cfg.TxFee.Value.Int != nil {
+		fee.Add(fee, &cfg.TxFee.Value)
+	}
+	if cfg.GasPrice != nil && cfg.GasPrice.Value.Int != nil && gasUsed > 0 {
+		gasFee := common.BigZero()
+		gasFee.Mul(&cfg.GasPrice.Value, &loom.BigUInt{new(big.Int).SetUint64(gasUsed)})
+		fee.Add(fee, gasFee)
+	}
+	return fee
+}
+
+// FeePayerSignBytes returns the bytes the fee payer must sign to sponsor a tx. The signature is
+// bound to the chain, the public key of the tx origin, and the tx nonce, so it can't be attached
+// to a tx sent by another account, or replayed on another chain.
+func FeePayerSignBytes(chainID string, originPublicKey []byte, nonce uint64, inner []byte) []byte {
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, nonce)
+	h := sha256.New()
+	h.Write([]byte("loom:tx-fees:sponsor"))
+	for _, field := range [][]byte{[]byte(chainID), originPublicKey, nonceBytes, inner} {
+		lenBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(lenBytes, uint64(len(field)))
+		h.Write(lenBytes)
+		h.Write(field)
+	}
+	return h.Sum(nil)
+}
+
+var Contract plugin.Contract = contract.MakePluginContract(&TxFees{})
@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/tx_fees/tx_fees.proto
+
+package tx_fees
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/loomnetwork/go-loom/types"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Denom int32
+
+const (
+	Denom_COIN    Denom = 0
+	Denom_ETHCOIN Denom = 1
+)
+
+var Denom_name = map[int32]string{
+	0: "COIN",
+	1: "ETHCOIN",
+}
+
+var Denom_value = map[string]int32{
+	"COIN":    0,
+	"ETHCOIN": 1,
+}
+
+func (x Denom) String() string {
+	return proto.EnumName(Denom_name, int32(x))
+}
+
+func (Denom) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{0}
+}
+
+type FeeConfig struct {
+	Denom                Denom          `protobuf:"varint,1,opt,name=denom,proto3,enum=tx_fees.Denom" json:"denom,omitempty"`
+	TxFee                *types.BigUInt `protobuf:"bytes,2,opt,name=tx_fee,json=txFee,proto3" json:"tx_fee,omitempty"`
+	GasPrice             *types.BigUInt `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Treasury             *types.Address `protobuf:"bytes,4,opt,name=treasury,proto3" json:"treasury,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *FeeConfig) Reset()         { *m = FeeConfig{} }
+func (m *FeeConfig) String() string { return proto.CompactTextString(m) }
+func (*FeeConfig) ProtoMessage()    {}
+func (*FeeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{0}
+}
+func (m *FeeConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeConfig.Unmarshal(m, b)
+}
+func (m *FeeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeConfig.Marshal(b, m, deterministic)
+}
+func (m *FeeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeConfig.Merge(m, src)
+}
+func (m *FeeConfig) XXX_Size() int {
+	return xxx_messageInfo_FeeConfig.Size(m)
+}
+func (m *FeeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeConfig proto.InternalMessageInfo
+
+func (m *FeeConfig) GetDenom() Denom {
+	if m != nil {
+		return m.Denom
+	}
+	return Denom_COIN
+}
+
+func (m *FeeConfig) GetTxFee() *types.BigUInt {
+	if m != nil {
+		return m.TxFee
+	}
+	return nil
+}
+
+func (m *FeeConfig) GetGasPrice() *types.BigUInt {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *FeeConfig) GetTreasury() *types.Address {
+	if m != nil {
+		return m.Treasury
+	}
+	return nil
+}
+
+type InitRequest struct {
+	Owner                *types.Address `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Config               *FeeConfig     `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *InitRequest) Reset()         { *m = InitRequest{} }
+func (m *InitRequest) String() string { return proto.CompactTextString(m) }
+func (*InitRequest) ProtoMessage()    {}
+func (*InitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{1}
+}
+func (m *InitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitRequest.Unmarshal(m, b)
+}
+func (m *InitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitRequest.Marshal(b, m, deterministic)
+}
+func (m *InitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitRequest.Merge(m, src)
+}
+func (m *InitRequest) XXX_Size() int {
+	return xxx_messageInfo_InitRequest.Size(m)
+}
+func (m *InitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitRequest proto.InternalMessageInfo
+
+func (m *InitRequest) GetOwner() *types.Address {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *InitRequest) GetConfig() *FeeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetFeeConfigRequest struct {
+	Config               *FeeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetFeeConfigRequest) Reset()         { *m = SetFeeConfigRequest{} }
+func (m *SetFeeConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFeeConfigRequest) ProtoMessage()    {}
+func (*SetFeeConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{2}
+}
+func (m *SetFeeConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFeeConfigRequest.Unmarshal(m, b)
+}
+func (m *SetFeeConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFeeConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *SetFeeConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFeeConfigRequest.Merge(m, src)
+}
+func (m *SetFeeConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_SetFeeConfigRequest.Size(m)
+}
+func (m *SetFeeConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFeeConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFeeConfigRequest proto.InternalMessageInfo
+
+func (m *SetFeeConfigRequest) GetConfig() *FeeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type GetFeeConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFeeConfigRequest) Reset()         { *m = GetFeeConfigRequest{} }
+func (m *GetFeeConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFeeConfigRequest) ProtoMessage()    {}
+func (*GetFeeConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{3}
+}
+func (m *GetFeeConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeConfigRequest.Unmarshal(m, b)
+}
+func (m *GetFeeConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFeeConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeConfigRequest.Merge(m, src)
+}
+func (m *GetFeeConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFeeConfigRequest.Size(m)
+}
+func (m *GetFeeConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeConfigRequest proto.InternalMessageInfo
+
+type GetFeeConfigResponse struct {
+	Config               *FeeConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetFeeConfigResponse) Reset()         { *m = GetFeeConfigResponse{} }
+func (m *GetFeeConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFeeConfigResponse) ProtoMessage()    {}
+func (*GetFeeConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{4}
+}
+func (m *GetFeeConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFeeConfigResponse.Unmarshal(m, b)
+}
+func (m *GetFeeConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFeeConfigResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFeeConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFeeConfigResponse.Merge(m, src)
+}
+func (m *GetFeeConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFeeConfigResponse.Size(m)
+}
+func (m *GetFeeConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFeeConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFeeConfigResponse proto.InternalMessageInfo
+
+func (m *GetFeeConfigResponse) GetConfig() *FeeConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type FeePayerSignature struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeePayerSignature) Reset()         { *m = FeePayerSignature{} }
+func (m *FeePayerSignature) String() string { return proto.CompactTextString(m) }
+func (*FeePayerSignature) ProtoMessage()    {}
+func (*FeePayerSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{5}
+}
+func (m *FeePayerSignature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeePayerSignature.Unmarshal(m, b)
+}
+func (m *FeePayerSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeePayerSignature.Marshal(b, m, deterministic)
+}
+func (m *FeePayerSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayerSignature.Merge(m, src)
+}
+func (m *FeePayerSignature) XXX_Size() int {
+	return xxx_messageInfo_FeePayerSignature.Size(m)
+}
+func (m *FeePayerSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayerSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayerSignature proto.InternalMessageInfo
+
+func (m *FeePayerSignature) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *FeePayerSignature) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+type SponsoredTx struct {
+	Inner                []byte             `protobuf:"bytes,1,opt,name=inner,proto3" json:"inner,omitempty"`
+	Signature            []byte             `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	PublicKey            []byte             `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	FeePayer             *FeePayerSignature `protobuf:"bytes,5,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *SponsoredTx) Reset()         { *m = SponsoredTx{} }
+func (m *SponsoredTx) String() string { return proto.CompactTextString(m) }
+func (*SponsoredTx) ProtoMessage()    {}
+func (*SponsoredTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ac3d1e73e7e0696, []int{6}
+}
+func (m *SponsoredTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SponsoredTx.Unmarshal(m, b)
+}
+func (m *SponsoredTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SponsoredTx.Marshal(b, m, deterministic)
+}
+func (m *SponsoredTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsoredTx.Merge(m, src)
+}
+func (m *SponsoredTx) XXX_Size() int {
+	return xxx_messageInfo_SponsoredTx.Size(m)
+}
+func (m *SponsoredTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsoredTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsoredTx proto.InternalMessageInfo
+
+func (m *SponsoredTx) GetInner() []byte {
+	if m != nil {
+		return m.Inner
+	}
+	return nil
+}
+
+func (m *SponsoredTx) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *SponsoredTx) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *SponsoredTx) GetFeePayer() *FeePayerSignature {
+	if m != nil {
+		return m.FeePayer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("tx_fees.Denom", Denom_name, Denom_value)
+	proto.RegisterType((*FeeConfig)(nil), "tx_fees.FeeConfig")
+	proto.RegisterType((*InitRequest)(nil), "tx_fees.InitRequest")
+	proto.RegisterType((*SetFeeConfigRequest)(nil), "tx_fees.SetFeeConfigRequest")
+	proto.RegisterType((*GetFeeConfigRequest)(nil), "tx_fees.GetFeeConfigRequest")
+	proto.RegisterType((*GetFeeConfigResponse)(nil), "tx_fees.GetFeeConfigResponse")
+	proto.RegisterType((*FeePayerSignature)(nil), "tx_fees.FeePayerSignature")
+	proto.RegisterType((*SponsoredTx)(nil), "tx_fees.SponsoredTx")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/tx_fees/tx_fees.proto", fileDescriptor_7ac3d1e73e7e0696)
+}
+
+var fileDescriptor_7ac3d1e73e7e0696 = []byte{
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6f, 0xd3, 0x30,
+	0x14, 0xc6, 0x31, 0x5b, 0xba, 0xe4, 0xb5, 0x9a, 0xc0, 0x1b, 0x52, 0x34, 0xc1, 0x98, 0xa2, 0x21,
+	0x4d, 0x93, 0x68, 0xd0, 0x38, 0x70, 0xde, 0x06, 0x85, 0x0a, 0x09, 0x2a, 0x77, 0x1c, 0x38, 0x55,
+	0x69, 0xfa, 0xea, 0x59, 0x6b, 0xed, 0x60, 0x3b, 0x5a, 0xf3, 0xa7, 0x70, 0xe2, 0x5f, 0x45, 0x71,
+	0xd2, 0x94, 0x06, 0x01, 0xe2, 0x92, 0xf8, 0xfd, 0xde, 0xf3, 0xe7, 0xef, 0xb3, 0x0c, 0x03, 0x2e,
+	0xec, 0x6d, 0x3e, 0xed, 0xa7, 0x6a, 0x19, 0x2f, 0x94, 0x5a, 0x4a, 0xb4, 0xf7, 0x4a, 0xdf, 0xb9,
+	0x75, 0x7a, 0x9b, 0x08, 0x19, 0x4f, 0x73, 0xb1, 0xb0, 0x42, 0xc6, 0xd9, 0x22, 0xe7, 0x42, 0x9a,
+	0xd8, 0xae, 0x26, 0x73, 0xc4, 0xe6, 0xdf, 0xcf, 0xb4, 0xb2, 0x8a, 0xee, 0xd5, 0xe5, 0xd1, 0xab,
+	0x3f, 0x08, 0x72, 0xf5, 0xb2, 0x2c, 0x63, 0x5b, 0x64, 0x68, 0xaa, 0x6f, 0xb5, 0x35, 0xfa, 0x41,
+	0x20, 0x18, 0x20, 0x5e, 0x2b, 0x39, 0x17, 0x9c, 0x9e, 0x82, 0x37, 0x43, 0xa9, 0x96, 0x21, 0x39,
+	0x21, 0x67, 0xfb, 0x17, 0xfb, 0xfd, 0xf5, 0x39, 0x6f, 0x4b, 0xca, 0xaa, 0x26, 0x7d, 0x0e, 0x9d,
+	0x8a, 0x87, 0x0f, 0x4f, 0xc8, 0x59, 0xf7, 0xc2, 0xef, 0x5f, 0x09, 0xfe, 0x65, 0x28, 0x2d, 0xf3,
+	0xec, 0x6a, 0x80, 0x48, 0x5f, 0x40, 0xc0, 0x13, 0x33, 0xc9, 0xb4, 0x48, 0x31, 0xdc, 0x69, 0xcd,
+	0xf8, 0x3c, 0x31, 0xa3, 0xb2, 0x43, 0x4f, 0xc1, 0xb7, 0x1a, 0x13, 0x93, 0xeb, 0x22, 0xdc, 0xad,
+	0xa7, 0x2e, 0x67, 0x33, 0x8d, 0xc6, 0xb0, 0xa6, 0x13, 0x7d, 0x85, 0xee, 0x50, 0x0a, 0xcb, 0xf0,
+	0x5b, 0x8e, 0xc6, 0xd2, 0x63, 0xf0, 0xd4, 0xbd, 0x44, 0x1d, 0x92, 0xd6, 0x8e, 0x0a, 0xd3, 0x73,
+	0xe8, 0xa4, 0x2e, 0x4c, 0x6d, 0x8e, 0x36, 0x19, 0x9a, 0x98, 0xac, 0x9e, 0x88, 0x2e, 0xe1, 0x60,
+	0x8c, 0x76, 0xc3, 0xeb, 0x23, 0x36, 0x12, 0xe4, 0x9f, 0x12, 0x4f, 0xe0, 0xe0, 0xfd, 0xef, 0x12,
+	0xd1, 0x15, 0x1c, 0x6e, 0x63, 0x93, 0x29, 0x69, 0xf0, 0xbf, 0xa4, 0x47, 0xf0, 0x78, 0x80, 0x38,
+	0x4a, 0x0a, 0xd4, 0x63, 0xc1, 0x65, 0x62, 0x73, 0x8d, 0xf4, 0x19, 0x40, 0x96, 0x4f, 0x17, 0x22,
+	0x9d, 0xdc, 0x61, 0xe1, 0x44, 0x7a, 0x2c, 0xa8, 0xc8, 0x47, 0x2c, 0xe8, 0x53, 0x08, 0xcc, 0x7a,
+	0xd6, 0x5d, 0x40, 0x8f, 0x6d, 0x40, 0xf4, 0x9d, 0x40, 0x77, 0x5c, 0x1a, 0x51, 0x1a, 0x67, 0x37,
+	0x2b, 0x7a, 0x08, 0x9e, 0x90, 0xeb, 0xbb, 0xec, 0xb1, 0xaa, 0xf8, 0xbb, 0x46, 0xcb, 0xc0, 0x4e,
+	0xdb, 0xc0, 0x1b, 0x08, 0xe6, 0x88, 0x93, 0xac, 0x74, 0x1d, 0x7a, 0x2e, 0xe3, 0xd1, 0xaf, 0x19,
+	0xb7, 0xe3, 0x30, 0x7f, 0x5e, 0xa3, 0xf3, 0x63, 0xf0, 0xdc, 0x23, 0xa3, 0x3e, 0xec, 0x5e, 0x7f,
+	0x1e, 0x7e, 0x7a, 0xf4, 0x80, 0x76, 0x61, 0xef, 0xdd, 0xcd, 0x07, 0x57, 0x90, 0x69, 0xc7, 0xbd,
+	0xd7, 0xd7, 0x3f, 0x07, 0x00, 0x44, 0x7d, 0x92, 0x72, 0x34, 0x03, 0x00, 0x00,
+}
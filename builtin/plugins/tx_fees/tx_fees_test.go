@@ -0,0 +1,51 @@
+package tx_fees
+
+import (
+	"testing"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	owner = loom.MustParseAddress("default:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	guest = loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+)
+
+func TestSetFeeConfig(t *testing.T) {
+	pctx := plugin.CreateFakeContext(owner, owner)
+	contractAddr := pctx.CreateContract(Contract)
+	ctx := contractpb.WrapPluginContext(pctx.WithAddress(contractAddr))
+	c := &TxFees{}
+
+	require.Equal(t, ErrOwnerNotSpecified, c.Init(ctx, &InitRequest{}))
+	require.NoError(t, c.Init(ctx, &InitRequest{Owner: owner.MarshalPB()}))
+
+	resp, err := c.GetFeeConfig(ctx, &GetFeeConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ComputeFee(resp.Config, 1000).Int64())
+
+	cfg := &FeeConfig{
+		Denom:    Denom_ETHCOIN,
+		TxFee:    &types.BigUInt{Value: *loom.NewBigUIntFromInt(100)},
+		GasPrice: &types.BigUInt{Value: *loom.NewBigUIntFromInt(3)},
+	}
+	guestCtx := contractpb.WrapPluginContext(pctx.WithAddress(contractAddr).WithSender(guest))
+	require.Equal(t, ErrNotAuthorized, c.SetFeeConfig(guestCtx, &SetFeeConfigRequest{Config: cfg}))
+	require.Error(t, c.SetFeeConfig(ctx, &SetFeeConfigRequest{}))
+	require.Error(t, c.SetFeeConfig(ctx, &SetFeeConfigRequest{Config: &FeeConfig{Denom: Denom(5)}}))
+	// ETHCOIN fees can't be distributed by DPOS so they must go to a treasury
+	require.Error(t, c.SetFeeConfig(ctx, &SetFeeConfigRequest{Config: cfg}))
+	cfg.Treasury = guest.MarshalPB()
+	require.NoError(t, c.SetFeeConfig(ctx, &SetFeeConfigRequest{Config: cfg}))
+
+	resp, err = c.GetFeeConfig(ctx, &GetFeeConfigRequest{})
+	require.NoError(t, err)
+	require.Equal(t, Denom_ETHCOIN, resp.Config.Denom)
+	require.Equal(t, 0, loom.UnmarshalAddressPB(resp.Config.Treasury).Compare(guest))
+	require.Equal(t, int64(100), ComputeFee(resp.Config, 0).Int64())
+	require.Equal(t, int64(100+3*1000), ComputeFee(resp.Config, 1000).Int64())
+}
@@ -3,6 +3,8 @@ package user_deployer_whitelist
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
+	"strings"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/loomnetwork/go-loom"
@@ -63,6 +65,8 @@ var (
 	ErrInvalidBlockRange = errors.New("[UserDeployerWhitelist] block range must be greater than zero")
 	// ErrInvalidMaxTxs indicates the specified max txs count is invalid
 	ErrInvalidMaxTxs = errors.New("[UserDeployerWhitelist] max txs must be greater than zero")
+	// ErrInvalidMethod indicates the method specified in a method tx limit is invalid
+	ErrInvalidMethod = errors.New("[UserDeployerWhitelist] method must be a 4-byte EVM function selector")
 )
 
 const (
@@ -72,6 +76,8 @@ const (
 	//This state stores deployers corresponding to the user
 	userStatePrefix = "us"
 	tierKeyPrefix   = "ti"
+	//This state stores the per-method tx limits & gas budget of each tier
+	tierLimitsKeyPrefix = "tl"
 )
 
 var (
@@ -95,6 +101,12 @@ func tierKey(tierID TierID) []byte {
 	return util.PrefixKey([]byte(tierKeyPrefix), buf.Bytes())
 }
 
+func tierLimitsKey(tierID TierID) []byte {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, tierID)
+	return util.PrefixKey([]byte(tierLimitsKeyPrefix), buf.Bytes())
+}
+
 func (uw *UserDeployerWhitelist) Meta() (plugin.Meta, error) {
 	return plugin.Meta{
 		Name:    "user-deployer-whitelist",
@@ -428,13 +440,23 @@ func (uw *UserDeployerWhitelist) GetTierInfo(
 
 // SetTierInfo sets the details of a tier.
 func (uw *UserDeployerWhitelist) SetTierInfo(ctx contract.Context, req *SetTierInfoRequest) error {
-	if req.Fee == nil || req.Fee.Value.Cmp(loom.NewBigUIntFromInt(0)) == 0 {
-		return ErrInvalidWhitelistingFee
-	}
 	if ok, _ := ctx.HasPermission(modifyPerm, []string{ownerRole}); !ok {
 		return ErrNotAuthorized
 	}
+	tier, err := newTier(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Set(tierKey(req.TierID), tier); err != nil {
+		return errors.Wrap(err, "Failed to modify TierInfo")
+	}
+	return nil
+}
 
+func newTier(ctx contract.Context, req *SetTierInfoRequest) (*Tier, error) {
+	if req.Fee == nil || req.Fee.Value.Cmp(loom.NewBigUIntFromInt(0)) == 0 {
+		return nil, ErrInvalidWhitelistingFee
+	}
 	tier := &Tier{
 		TierID: req.TierID,
 		Fee:    req.Fee,
@@ -442,21 +464,130 @@ func (uw *UserDeployerWhitelist) SetTierInfo(ctx contract.Context, req *SetTierI
 	}
 	if ctx.FeatureEnabled(features.UserDeployerWhitelistVersion1_1Feature, false) {
 		if req.BlockRange == 0 {
-			return ErrInvalidBlockRange
+			return nil, ErrInvalidBlockRange
 		}
 		if req.MaxTxs == 0 {
-			return ErrInvalidMaxTxs
+			return nil, ErrInvalidMaxTxs
 		}
 
 		tier.BlockRange = req.BlockRange
 		tier.MaxTxs = req.MaxTxs
 	}
-	if err := ctx.Set(tierKey(req.TierID), tier); err != nil {
+	return tier, nil
+}
+
+// GetTierLimits returns the per-method tx limits & gas budget of a specific tier.
+func (uw *UserDeployerWhitelist) GetTierLimits(
+	ctx contract.StaticContext, req *GetTierLimitsRequest,
+) (*GetTierLimitsResponse, error) {
+	limits, err := GetTierLimits(ctx, TierID(req.TierId))
+	if err != nil {
+		return nil, err
+	}
+	return &GetTierLimitsResponse{
+		Limits: limits,
+	}, nil
+}
+
+// SetTierLimits replaces the per-method tx limits & gas budget of an existing tier.
+func (uw *UserDeployerWhitelist) SetTierLimits(ctx contract.Context, req *SetTierLimitsRequest) error {
+	if !ctx.FeatureEnabled(features.UserDeployerWhitelistVersion1_3Feature, false) {
+		return errors.New("[UserDeployerWhitelist] tier limits not enabled")
+	}
+	if ok, _ := ctx.HasPermission(modifyPerm, []string{ownerRole}); !ok {
+		return ErrNotAuthorized
+	}
+
+	tierID := TierID(req.TierId)
+	if !ctx.Has(tierKey(tierID)) {
+		return ErrInvalidTier
+	}
+	limits, err := newTierLimits(req.TierId, req.MethodLimits, req.GasBudget)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Set(tierLimitsKey(tierID), limits); err != nil {
+		return errors.Wrap(err, "Failed to modify TierLimits")
+	}
+	return nil
+}
+
+// SetTier sets the details of a tier and replaces its per-method tx limits & gas budget. Either
+// both are updated, or neither is.
+func (uw *UserDeployerWhitelist) SetTier(ctx contract.Context, req *SetTierRequest) error {
+	if !ctx.FeatureEnabled(features.UserDeployerWhitelistVersion1_3Feature, false) {
+		return errors.New("[UserDeployerWhitelist] tier limits not enabled")
+	}
+	if ok, _ := ctx.HasPermission(modifyPerm, []string{ownerRole}); !ok {
+		return ErrNotAuthorized
+	}
+
+	tierID := TierID(req.TierId)
+	tier, err := newTier(ctx, &SetTierInfoRequest{
+		TierID:     tierID,
+		Fee:        req.Fee,
+		Name:       req.Name,
+		BlockRange: req.BlockRange,
+		MaxTxs:     req.MaxTxs,
+	})
+	if err != nil {
+		return err
+	}
+	limits, err := newTierLimits(req.TierId, req.MethodLimits, req.GasBudget)
+	if err != nil {
+		return err
+	}
+	if err := ctx.Set(tierKey(tierID), tier); err != nil {
 		return errors.Wrap(err, "Failed to modify TierInfo")
 	}
+	if err := ctx.Set(tierLimitsKey(tierID), limits); err != nil {
+		return errors.Wrap(err, "Failed to modify TierLimits")
+	}
 	return nil
 }
 
+func newTierLimits(tierID int32, methodLimits []*MethodTxLimit, gasBudget uint64) (*TierLimits, error) {
+	seen := make(map[string]bool)
+	for _, limit := range methodLimits {
+		if limit == nil || !isValidMethod(limit.Method) {
+			return nil, ErrInvalidMethod
+		}
+		if limit.MaxTxs == 0 {
+			return nil, ErrInvalidMaxTxs
+		}
+		method := NormalizeMethod(limit.Method)
+		if seen[method] {
+			return nil, errors.Wrapf(ErrInvalidRequest, "duplicate limit for method %s", limit.Method)
+		}
+		seen[method] = true
+		limit.Method = method
+	}
+	return &TierLimits{
+		TierId:       tierID,
+		MethodLimits: methodLimits,
+		GasBudget:    gasBudget,
+	}, nil
+}
+
+// NormalizeMethod converts EVM function selectors to lowercase so they can be compared with the
+// selectors extracted from txs.
+func NormalizeMethod(method string) string {
+	if strings.HasPrefix(method, "0x") || strings.HasPrefix(method, "0X") {
+		return "0x" + strings.ToLower(method[2:])
+	}
+	return method
+}
+
+// isValidMethod returns true if the given method is a 4-byte EVM function selector, Go contract
+// calls aren't tracked by the contract tx limiter so Go contract methods can't be limited.
+func isValidMethod(method string) bool {
+	if !strings.HasPrefix(method, "0x") && !strings.HasPrefix(method, "0X") {
+		return false
+	}
+	selector, err := hex.DecodeString(method[2:])
+	return err == nil && len(selector) == 4
+}
+
 // RecordEVMContractDeployment is called by the EVMDeployRecorderPostCommitMiddleware after an EVM
 // contract is successfully deployed to record the deployment in the UserDeployerWhitelist contract.
 func RecordEVMContractDeployment(ctx contract.Context, deployerAddress, contractAddr loom.Address) error {
@@ -503,6 +634,32 @@ func GetTierInfo(ctx contract.StaticContext, tierID udwtypes.TierID) (udwtypes.T
 	return tier, nil
 }
 
+// GetTierLimits standalone function to get the per-method tx limits & gas budget of a tier, returns
+// empty limits if none have been set for the tier.
+func GetTierLimits(ctx contract.StaticContext, tierID TierID) (*TierLimits, error) {
+	var limits TierLimits
+	if err := ctx.Get(tierLimitsKey(tierID), &limits); err != nil {
+		if err == contract.ErrNotFound {
+			return &TierLimits{TierId: int32(tierID)}, nil
+		}
+		return nil, errors.Wrap(err, "Failed to get Tier Limits")
+	}
+	return &limits, nil
+}
+
+// GetTierLimitsMap returns the per-method tx limits & gas budgets of all the tiers that have them.
+func GetTierLimitsMap(ctx contract.StaticContext) (map[TierID]*TierLimits, error) {
+	limitsMap := make(map[TierID]*TierLimits)
+	for _, rangeEntry := range ctx.Range([]byte(tierLimitsKeyPrefix)) {
+		var limits TierLimits
+		if err := proto.Unmarshal(rangeEntry.Value, &limits); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal tier limits")
+		}
+		limitsMap[TierID(limits.TierId)] = &limits
+	}
+	return limitsMap, nil
+}
+
 type ContractInfo struct {
 	ContractToTierMap         map[string]TierID
 	InactiveDeployerContracts map[string]bool
@@ -0,0 +1,380 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: github.com/loomnetwork/loomchain/builtin/plugins/user_deployer_whitelist/user_deployer_whitelist.proto
+
+package user_deployer_whitelist
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/loomnetwork/go-loom/types"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MethodTxLimit struct {
+	Method               string   `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	MaxTxs               uint64   `protobuf:"varint,2,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MethodTxLimit) Reset()         { *m = MethodTxLimit{} }
+func (m *MethodTxLimit) String() string { return proto.CompactTextString(m) }
+func (*MethodTxLimit) ProtoMessage()    {}
+func (*MethodTxLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b4d87de6dc2944f, []int{0}
+}
+func (m *MethodTxLimit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MethodTxLimit.Unmarshal(m, b)
+}
+func (m *MethodTxLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MethodTxLimit.Marshal(b, m, deterministic)
+}
+func (m *MethodTxLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodTxLimit.Merge(m, src)
+}
+func (m *MethodTxLimit) XXX_Size() int {
+	return xxx_messageInfo_MethodTxLimit.Size(m)
+}
+func (m *MethodTxLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodTxLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodTxLimit proto.InternalMessageInfo
+
+func (m *MethodTxLimit) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MethodTxLimit) GetMaxTxs() uint64 {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return 0
+}
+
+type TierLimits struct {
+	TierId               int32            `protobuf:"varint,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	MethodLimits         []*MethodTxLimit `protobuf:"bytes,2,rep,name=method_limits,json=methodLimits,proto3" json:"method_limits,omitempty"`
+	GasBudget            uint64           `protobuf:"varint,3,opt,name=gas_budget,json=gasBudget,proto3" json:"gas_budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TierLimits) Reset()         { *m = TierLimits{} }
+func (m *TierLimits) String() string { return proto.CompactTextString(m) }
+func (*TierLimits) ProtoMessage()    {}
+func (*TierLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b4d87de6dc2944f, []int{1}
+}
+func (m *TierLimits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TierLimits.Unmarshal(m, b)
+}
+func (m *TierLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TierLimits.Marshal(b, m, deterministic)
+}
+func (m *TierLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TierLimits.Merge(m, src)
+}
+func (m *TierLimits) XXX_Size() int {
+	return xxx_messageInfo_TierLimits.Size(m)
+}
+func (m *TierLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_TierLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TierLimits proto.InternalMessageInfo
+
+func (m *TierLimits) GetTierId() int32 {
+	if m != nil {
+		return m.TierId
+	}
+	return 0
+}
+
+func (m *TierLimits) GetMethodLimits() []*MethodTxLimit {
+	if m != nil {
+		return m.MethodLimits
+	}
+	return nil
+}
+
+func (m *TierLimits) GetGasBudget() uint64 {
+	if m != nil {
+		return m.GasBudget
+	}
+	return 0
+}
+
+type SetTierLimitsRequest struct {
+	TierId               int32            `protobuf:"varint,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	MethodLimits         []*MethodTxLimit `protobuf:"bytes,2,rep,name=method_limits,json=methodLimits,proto3" json:"method_limits,omitempty"`
+	GasBudget            uint64           `protobuf:"varint,3,opt,name=gas_budget,json=gasBudget,proto3" json:"gas_budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetTierLimitsRequest) Reset()         { *m = SetTierLimitsRequest{} }
+func (m *SetTierLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTierLimitsRequest) ProtoMessage()    {}
+func (*SetTierLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b4d87de6dc2944f, []int{2}
+}
+func (m *SetTierLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTierLimitsRequest.Unmarshal(m, b)
+}
+func (m *SetTierLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTierLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetTierLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTierLimitsRequest.Merge(m, src)
+}
+func (m *SetTierLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTierLimitsRequest.Size(m)
+}
+func (m *SetTierLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTierLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTierLimitsRequest proto.InternalMessageInfo
+
+func (m *SetTierLimitsRequest) GetTierId() int32 {
+	if m != nil {
+		return m.TierId
+	}
+	return 0
+}
+
+func (m *SetTierLimitsRequest) GetMethodLimits() []*MethodTxLimit {
+	if m != nil {
+		return m.MethodLimits
+	}
+	return nil
+}
+
+func (m *SetTierLimitsRequest) GetGasBudget() uint64 {
+	if m != nil {
+		return m.GasBudget
+	}
+	return 0
+}
+
+type SetTierRequest struct {
+	TierId               int32            `protobuf:"varint,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	Name                 string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Fee                  *types.BigUInt   `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	BlockRange           uint64           `protobuf:"varint,4,opt,name=block_range,json=blockRange,proto3" json:"block_range,omitempty"`
+	MaxTxs               uint64           `protobuf:"varint,5,opt,name=max_txs,json=maxTxs,proto3" json:"max_txs,omitempty"`
+	MethodLimits         []*MethodTxLimit `protobuf:"bytes,6,rep,name=method_limits,json=methodLimits,proto3" json:"method_limits,omitempty"`
+	GasBudget            uint64           `protobuf:"varint,7,opt,name=gas_budget,json=gasBudget,proto3" json:"gas_budget,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetTierRequest) Reset()         { *m = SetTierRequest{} }
+func (m *SetTierRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTierRequest) ProtoMessage()    {}
+func (*SetTierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b4d87de6dc2944f, []int{3}
+}
+func (m *SetTierRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTierRequest.Unmarshal(m, b)
+}
+func (m *SetTierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTierRequest.Marshal(b, m, deterministic)
+}
+func (m *SetTierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTierRequest.Merge(m, src)
+}
+func (m *SetTierRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTierRequest.Size(m)
+}
+func (m *SetTierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTierRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTierRequest proto.InternalMessageInfo
+
+func (m *SetTierRequest) GetTierId() int32 {
+	if m != nil {
+		return m.TierId
+	}
+	return 0
+}
+
+func (m *SetTierRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetTierRequest) GetFee() *types.BigUInt {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+func (m *SetTierRequest) GetBlockRange() uint64 {
+	if m != nil {
+		return m.BlockRange
+	}
+	return 0
+}
+
+func (m *SetTierRequest) GetMaxTxs() uint64 {
+	if m != nil {
+		return m.MaxTxs
+	}
+	return 0
+}
+
+func (m *SetTierRequest) GetMethodLimits() []*MethodTxLimit {
+	if m != nil {
+		return m.MethodLimits
+	}
+	return nil
+}
+
+func (m *SetTierRequest) GetGasBudget() uint64 {
+	if m != nil {
+		return m.GasBudget
+	}
+	return 0
+}
+
+type GetTierLimitsRequest struct {
+	TierId               int32    `protobuf:"varint,1,opt,name=tier_id,json=tierId,proto3" json:"tier_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTierLimitsRequest) Reset()         { *m = GetTierLimitsRequest{} }
+func (m *GetTierLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTierLimitsRequest) ProtoMessage()    {}
+func (*GetTierLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b4d87de6dc2944f, []int{4}
+}
+func (m *GetTierLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTierLimitsRequest.Unmarshal(m, b)
+}
+func (m *GetTierLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTierLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTierLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTierLimitsRequest.Merge(m, src)
+}
+func (m *GetTierLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTierLimitsRequest.Size(m)
+}
+func (m *GetTierLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTierLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTierLimitsRequest proto.InternalMessageInfo
+
+func (m *GetTierLimitsRequest) GetTierId() int32 {
+	if m != nil {
+		return m.TierId
+	}
+	return 0
+}
+
+type GetTierLimitsResponse struct {
+	Limits               *TierLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetTierLimitsResponse) Reset()         { *m = GetTierLimitsResponse{} }
+func (m *GetTierLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTierLimitsResponse) ProtoMessage()    {}
+func (*GetTierLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b4d87de6dc2944f, []int{5}
+}
+func (m *GetTierLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTierLimitsResponse.Unmarshal(m, b)
+}
+func (m *GetTierLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTierLimitsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTierLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTierLimitsResponse.Merge(m, src)
+}
+func (m *GetTierLimitsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTierLimitsResponse.Size(m)
+}
+func (m *GetTierLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTierLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTierLimitsResponse proto.InternalMessageInfo
+
+func (m *GetTierLimitsResponse) GetLimits() *TierLimits {
+	if m != nil {
+		return m.Limits
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MethodTxLimit)(nil), "user_deployer_whitelist.MethodTxLimit")
+	proto.RegisterType((*TierLimits)(nil), "user_deployer_whitelist.TierLimits")
+	proto.RegisterType((*SetTierLimitsRequest)(nil), "user_deployer_whitelist.SetTierLimitsRequest")
+	proto.RegisterType((*SetTierRequest)(nil), "user_deployer_whitelist.SetTierRequest")
+	proto.RegisterType((*GetTierLimitsRequest)(nil), "user_deployer_whitelist.GetTierLimitsRequest")
+	proto.RegisterType((*GetTierLimitsResponse)(nil), "user_deployer_whitelist.GetTierLimitsResponse")
+}
+
+func init() {
+	proto.RegisterFile("github.com/loomnetwork/loomchain/builtin/plugins/user_deployer_whitelist/user_deployer_whitelist.proto", fileDescriptor_3b4d87de6dc2944f)
+}
+
+var fileDescriptor_3b4d87de6dc2944f = []byte{
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x93, 0x4f, 0xab, 0xd3, 0x40,
+	0x14, 0xc5, 0x49, 0xff, 0xa4, 0xf6, 0xd6, 0xba, 0x18, 0xaa, 0x0d, 0x05, 0xb1, 0x44, 0x90, 0x6e,
+	0x4c, 0xa4, 0x2e, 0xdd, 0x48, 0x37, 0x52, 0xd4, 0xcd, 0x18, 0xd7, 0x21, 0x69, 0x6e, 0x27, 0x43,
+	0x93, 0x99, 0x98, 0x99, 0xd0, 0xf4, 0x63, 0xb8, 0xf6, 0xc3, 0x2a, 0x99, 0x04, 0xac, 0x95, 0xf0,
+	0x78, 0xf0, 0x16, 0x6f, 0x13, 0xe6, 0x9e, 0xcc, 0xb9, 0xf7, 0x97, 0x73, 0x09, 0x1c, 0x19, 0xd7,
+	0x69, 0x15, 0x7b, 0x07, 0x99, 0xfb, 0x99, 0x94, 0xb9, 0x40, 0x7d, 0x96, 0xe5, 0xc9, 0x9c, 0x0f,
+	0x69, 0xc4, 0x85, 0x1f, 0x57, 0x3c, 0xd3, 0x5c, 0xf8, 0x45, 0x56, 0x31, 0x2e, 0x94, 0x5f, 0x29,
+	0x2c, 0xc3, 0x04, 0x8b, 0x4c, 0x5e, 0xb0, 0x0c, 0xcf, 0x29, 0xd7, 0x98, 0x71, 0xa5, 0xfb, 0x74,
+	0xaf, 0x28, 0xa5, 0x96, 0x64, 0xd9, 0xf3, 0x7a, 0xf5, 0xae, 0x07, 0x80, 0xc9, 0xb7, 0x4d, 0xe9,
+	0xeb, 0x4b, 0x81, 0xaa, 0x7d, 0xb6, 0xad, 0xdc, 0x8f, 0x30, 0xff, 0x8a, 0x3a, 0x95, 0x49, 0x50,
+	0x7f, 0xe1, 0x39, 0xd7, 0xe4, 0x05, 0xd8, 0xb9, 0x11, 0x1c, 0x6b, 0x6d, 0x6d, 0xa6, 0xb4, 0xab,
+	0xc8, 0x12, 0x26, 0x79, 0x54, 0x87, 0xba, 0x56, 0xce, 0x60, 0x6d, 0x6d, 0x46, 0xd4, 0xce, 0xa3,
+	0x3a, 0xa8, 0x95, 0xfb, 0xd3, 0x02, 0x08, 0x38, 0x96, 0xc6, 0xae, 0x9a, 0x7b, 0x9a, 0x63, 0x19,
+	0xf2, 0xb6, 0xc1, 0x98, 0xda, 0x4d, 0xb9, 0x4f, 0xc8, 0x67, 0x98, 0xb7, 0xad, 0xc2, 0xcc, 0xdc,
+	0x74, 0x06, 0xeb, 0xe1, 0x66, 0xb6, 0x7d, 0xe3, 0xf5, 0x7d, 0xeb, 0x3f, 0x5c, 0xf4, 0x69, 0x6b,
+	0xee, 0xa6, 0xbc, 0x04, 0x60, 0x91, 0x0a, 0xe3, 0x2a, 0x61, 0xa8, 0x9d, 0xa1, 0x01, 0x9a, 0xb2,
+	0x48, 0xed, 0x8c, 0xe0, 0xfe, 0xb2, 0x60, 0xf1, 0x0d, 0xf5, 0x5f, 0x2c, 0x8a, 0x3f, 0x2a, 0x54,
+	0xfa, 0x71, 0xd0, 0xfd, 0xb6, 0xe0, 0x59, 0x47, 0x77, 0x27, 0x17, 0x81, 0x91, 0x88, 0x72, 0x34,
+	0x99, 0x4f, 0xa9, 0x39, 0x93, 0x15, 0x0c, 0x8f, 0x88, 0xa6, 0xef, 0x6c, 0xfb, 0xc4, 0xdb, 0x71,
+	0xf6, 0x7d, 0x2f, 0x34, 0x6d, 0x44, 0xf2, 0x0a, 0x66, 0x71, 0x26, 0x0f, 0xa7, 0xb0, 0x8c, 0x04,
+	0x43, 0x67, 0x64, 0x66, 0x83, 0x91, 0x68, 0xa3, 0x5c, 0xef, 0x71, 0x7c, 0xbd, 0xc7, 0xff, 0x13,
+	0xb0, 0x1f, 0x2c, 0x81, 0xc9, 0x6d, 0x02, 0x3e, 0x2c, 0x3e, 0xdd, 0x67, 0x3d, 0x6e, 0x00, 0xcf,
+	0x6f, 0x0c, 0xaa, 0x90, 0x42, 0x21, 0xf9, 0x00, 0x76, 0x87, 0x6b, 0x99, 0x38, 0x5e, 0xf7, 0xe2,
+	0x5e, 0x99, 0x3b, 0x4b, 0x6c, 0x9b, 0x7f, 0xe0, 0xfd, 0x9f, 0x01, 0x00, 0x97, 0x2b, 0xac, 0x3b,
+	0xb8, 0x03, 0x00, 0x00,
+}
@@ -257,6 +257,89 @@ func sciNot(m, n int64) *loom.BigUInt {
 	return ret
 }
 
+func TestTierLimits(t *testing.T) {
+	pctx := createCtx()
+	deployerContract := &UserDeployerWhitelist{}
+	deployerCtx := pctx.WithAddress(pctx.CreateContract(Contract))
+	require.NoError(t, deployerContract.Init(contractpb.WrapPluginContext(deployerCtx), &InitRequest{
+		Owner: addr4.MarshalPB(),
+		TierInfo: []*udwtypes.TierInfo{
+			{TierID: udwtypes.TierID_DEFAULT, Fee: 100, Name: "Tier1", BlockRange: 10, MaxTxs: 20},
+		},
+	}))
+	ownerCtx := contractpb.WrapPluginContext(deployerCtx.WithSender(addr4))
+
+	req := &SetTierLimitsRequest{
+		TierId: int32(udwtypes.TierID_DEFAULT),
+		MethodLimits: []*MethodTxLimit{
+			{Method: "0xA9059CBB", MaxTxs: 5},
+			{Method: "0x60fe47b1", MaxTxs: 1},
+		},
+		GasBudget: 1000000,
+	}
+	require.Error(t, deployerContract.SetTierLimits(ownerCtx, req), "feature not enabled")
+	pctx.SetFeature(features.UserDeployerWhitelistVersion1_3Feature, true)
+
+	require.Equal(t, ErrNotAuthorized,
+		deployerContract.SetTierLimits(contractpb.WrapPluginContext(deployerCtx.WithSender(addr5)), req))
+	require.Equal(t, ErrInvalidTier, deployerContract.SetTierLimits(ownerCtx, &SetTierLimitsRequest{TierId: 5}))
+	require.Equal(t, ErrInvalidMethod, deployerContract.SetTierLimits(ownerCtx, &SetTierLimitsRequest{
+		MethodLimits: []*MethodTxLimit{{Method: "0xa9059c", MaxTxs: 5}},
+	}))
+	// only EVM contract methods can be limited
+	require.Equal(t, ErrInvalidMethod, deployerContract.SetTierLimits(ownerCtx, &SetTierLimitsRequest{
+		MethodLimits: []*MethodTxLimit{{Method: "SetValue", MaxTxs: 5}},
+	}))
+	require.Equal(t, ErrInvalidMaxTxs, deployerContract.SetTierLimits(ownerCtx, &SetTierLimitsRequest{
+		MethodLimits: []*MethodTxLimit{{Method: "0x60fe47b1"}},
+	}))
+	require.Error(t, deployerContract.SetTierLimits(ownerCtx, &SetTierLimitsRequest{
+		MethodLimits: []*MethodTxLimit{{Method: "0xa9059cbb", MaxTxs: 5}, {Method: "0xA9059CBB", MaxTxs: 2}},
+	}), "duplicate method limits")
+
+	resp, err := deployerContract.GetTierLimits(ownerCtx, &GetTierLimitsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Limits.MethodLimits, 0)
+
+	require.NoError(t, deployerContract.SetTierLimits(ownerCtx, req))
+	resp, err = deployerContract.GetTierLimits(ownerCtx, &GetTierLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000000), resp.Limits.GasBudget)
+	require.Len(t, resp.Limits.MethodLimits, 2)
+	require.Equal(t, "0xa9059cbb", resp.Limits.MethodLimits[0].Method)
+	require.Equal(t, "0x60fe47b1", resp.Limits.MethodLimits[1].Method)
+
+	limitsMap, err := GetTierLimitsMap(ownerCtx)
+	require.NoError(t, err)
+	require.Len(t, limitsMap, 1)
+	require.Equal(t, uint64(1000000), limitsMap[udwtypes.TierID_DEFAULT].GasBudget)
+
+	// SetTier doesn't modify the tier info if the limits are invalid
+	setTierReq := &SetTierRequest{
+		TierId:       int32(udwtypes.TierID_DEFAULT),
+		Name:         "Tier2",
+		Fee:          &types.BigUInt{Value: *loom.NewBigUIntFromInt(200)},
+		BlockRange:   10,
+		MaxTxs:       20,
+		MethodLimits: []*MethodTxLimit{{Method: "SetValue", MaxTxs: 1}},
+	}
+	require.Equal(t, ErrInvalidMethod, deployerContract.SetTier(ownerCtx, setTierReq))
+	tierResp, err := deployerContract.GetTierInfo(ownerCtx, &GetTierInfoRequest{TierID: udwtypes.TierID_DEFAULT})
+	require.NoError(t, err)
+	require.Equal(t, "Tier1", tierResp.Tier.Name)
+
+	setTierReq.MethodLimits = []*MethodTxLimit{{Method: "0x60fe47b1", MaxTxs: 2}}
+	require.NoError(t, deployerContract.SetTier(ownerCtx, setTierReq))
+	tierResp, err = deployerContract.GetTierInfo(ownerCtx, &GetTierInfoRequest{TierID: udwtypes.TierID_DEFAULT})
+	require.NoError(t, err)
+	require.Equal(t, "Tier2", tierResp.Tier.Name)
+	resp, err = deployerContract.GetTierLimits(ownerCtx, &GetTierLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), resp.Limits.GasBudget)
+	require.Len(t, resp.Limits.MethodLimits, 1)
+	require.Equal(t, uint64(2), resp.Limits.MethodLimits[0].MaxTxs)
+}
+
 func createCtx() *plugin.FakeContext {
 	return plugin.CreateFakeContext(loom.Address{}, loom.Address{}).WithBlock(loom.BlockHeader{
 		ChainID: "default",
@@ -133,36 +133,58 @@ func GetMapping() *cobra.Command {
 
 func ListMappingCmd() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var page pageFlags
 	cmd := &cobra.Command{
 		Use:   "list-mappings",
 		Short: "list user account mappings",
 		Args:  cobra.MinimumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if page.Paged() {
+				startKey, err := page.StartKeyBytes()
+				if err != nil {
+					return err
+				}
+				var resp address_mapper.ListMappingPageResponse
+				err = cli.StaticCallContractWithFlags(&flags, AddressMapperName, "ListMappingPage",
+					&address_mapper.ListMappingPageRequest{StartKey: startKey, Limit: page.Limit}, &resp)
+				if err != nil {
+					return errors.Wrap(err, "static call contract")
+				}
+				printMappings(resp.Mappings)
+				printNextPageKey(resp.NextKey)
+				return nil
+			}
+
 			var resp address_mapper.ListMappingResponse
 			err := cli.StaticCallContractWithFlags(&flags, AddressMapperName, "ListMapping",
 				&address_mapper.ListMappingRequest{}, &resp)
 			if err != nil {
 				return errors.Wrap(err, "static call contract")
 			}
-			type maxLength struct {
-				From int
-				To   int
-			}
-			ml := maxLength{From: 50, To: 50}
-
-			fmt.Printf("%-*s | %-*s \n", ml.From, "From", ml.To, "To")
-			for _, value := range resp.Mappings {
-				fmt.Printf("%-*s | %-*s\n",
-					ml.From, loom.UnmarshalAddressPB(value.From).String(),
-					ml.To, loom.UnmarshalAddressPB(value.To).String())
-			}
+			printMappings(resp.Mappings)
 			return nil
 		},
 	}
+	addPageFlags(cmd.Flags(), &page)
 	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
 	return cmd
 }
 
+func printMappings(mappings []*amtypes.AddressMapperMapping) {
+	type maxLength struct {
+		From int
+		To   int
+	}
+	ml := maxLength{From: 50, To: 50}
+
+	fmt.Printf("%-*s | %-*s \n", ml.From, "From", ml.To, "To")
+	for _, value := range mappings {
+		fmt.Printf("%-*s | %-*s\n",
+			ml.From, loom.UnmarshalAddressPB(value.From).String(),
+			ml.To, loom.UnmarshalAddressPB(value.To).String())
+	}
+}
+
 func NewAddressMapperCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "addressmapper <command>",
@@ -16,6 +16,7 @@ import (
 	"github.com/loomnetwork/go-loom/client"
 	"github.com/loomnetwork/go-loom/config"
 	plugintypes "github.com/loomnetwork/go-loom/plugin/types"
+	ccplugin "github.com/loomnetwork/loomchain/builtin/plugins/chainconfig"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/go-amino"
@@ -38,6 +39,8 @@ func NewChainCfgCommand() *cobra.Command {
 		GetFeatureCmd(),
 		SetParamsCmd(),
 		GetParamsCmd(),
+		SetBlockTxLimitsCmd(),
+		GetBlockTxLimitsCmd(),
 		ListFeaturesCmd(),
 		FeatureEnabledCmd(),
 		RemoveFeatureCmd(),
@@ -185,6 +188,64 @@ func GetParamsCmd() *cobra.Command {
 	return cmd
 }
 
+const setBlockTxLimitsCmdExample = `
+loom chain-cfg set-block-tx-limits --block-range 10 --max-txs 2
+`
+
+func SetBlockTxLimitsCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	blockRange := uint64(0)
+	maxTxs := uint64(0)
+	cmd := &cobra.Command{
+		Use:     "set-block-tx-limits",
+		Short:   "Set the max number of txs an account can send within a block range, zero disables the limit",
+		Example: setBlockTxLimitsCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := &ccplugin.SetBlockTxLimitsRequest{
+				Limits: &ccplugin.BlockTxLimits{
+					BlockRange:     blockRange,
+					MaxTxsPerRange: maxTxs,
+				},
+			}
+			return cli.CallContractWithFlags(&flags, chainConfigContractName, "SetBlockTxLimits", request, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	cmdFlags := cmd.Flags()
+	cmdFlags.Uint64Var(&blockRange, "block-range", 0, "Number of blocks in each range")
+	cmdFlags.Uint64Var(&maxTxs, "max-txs", 0, "Max number of txs an account can send within a range")
+	return cmd
+}
+
+const getBlockTxLimitsCmdExample = `
+loom chain-cfg get-block-tx-limits
+`
+
+func GetBlockTxLimitsCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "get-block-tx-limits",
+		Short:   "Get the block tx limits from chainconfig",
+		Example: getBlockTxLimitsCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp ccplugin.GetBlockTxLimitsResponse
+			err := cli.StaticCallContractWithFlags(&flags, chainConfigContractName, "GetBlockTxLimits",
+				&ccplugin.GetBlockTxLimitsRequest{}, &resp)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
 const getFeatureCmdExample = `
 loom chain-cfg get-feature hardfork
 `
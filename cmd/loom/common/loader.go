@@ -8,9 +8,14 @@ import (
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv2"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
 	"github.com/loomnetwork/loomchain/builtin/plugins/ethcoin"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
 	"github.com/loomnetwork/loomchain/builtin/plugins/karma"
+	"github.com/loomnetwork/loomchain/builtin/plugins/liquid_staking"
+	"github.com/loomnetwork/loomchain/builtin/plugins/multisig"
 	"github.com/loomnetwork/loomchain/builtin/plugins/plasma_cash"
 	"github.com/loomnetwork/loomchain/builtin/plugins/sample_go_contract"
+	"github.com/loomnetwork/loomchain/builtin/plugins/session_keys"
+	"github.com/loomnetwork/loomchain/builtin/plugins/tx_fees"
 	"github.com/loomnetwork/loomchain/builtin/plugins/user_deployer_whitelist"
 	"github.com/loomnetwork/loomchain/cmd/loom/replay"
 	"github.com/loomnetwork/loomchain/config"
@@ -50,6 +55,21 @@ func NewDefaultContractsLoader(cfg *config.Config) plugin.Loader {
 	if cfg.UserDeployerWhitelist.ContractEnabled {
 		contracts = append(contracts, user_deployer_whitelist.Contract)
 	}
+	if cfg.Multisig.ContractEnabled {
+		contracts = append(contracts, multisig.Contract)
+	}
+	if cfg.SessionKeys.ContractEnabled {
+		contracts = append(contracts, session_keys.Contract)
+	}
+	if cfg.TxFees.ContractEnabled {
+		contracts = append(contracts, tx_fees.Contract)
+	}
+	if cfg.Governance.ContractEnabled {
+		contracts = append(contracts, governance.Contract)
+	}
+	if cfg.LiquidStaking.ContractEnabled {
+		contracts = append(contracts, liquid_staking.Contract)
+	}
 
 	if cfg.AddressMapperContractEnabled() {
 		contracts = append(contracts, address_mapper.Contract)
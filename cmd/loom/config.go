@@ -16,7 +16,10 @@ import (
 	"github.com/loomnetwork/loomchain/builtin/plugins/chainconfig"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv2"
 	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
 	"github.com/loomnetwork/loomchain/builtin/plugins/karma"
+	"github.com/loomnetwork/loomchain/builtin/plugins/liquid_staking"
+	"github.com/loomnetwork/loomchain/builtin/plugins/tx_fees"
 	"github.com/loomnetwork/loomchain/config"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/plugin"
@@ -288,6 +291,85 @@ func defaultGenesis(cfg *config.Config, validator *loom.Validator) (*config.Gene
 		})
 	}
 
+	if cfg.Multisig.ContractEnabled {
+		contracts = append(contracts,
+			config.ContractConfig{
+				VMTypeName: "plugin",
+				Format:     "plugin",
+				Name:       "multisig",
+				Location:   "multisig:1.0.0",
+			})
+	}
+
+	if cfg.SessionKeys.ContractEnabled {
+		contracts = append(contracts,
+			config.ContractConfig{
+				VMTypeName: "plugin",
+				Format:     "plugin",
+				Name:       "sessionkeys",
+				Location:   "sessionkeys:1.0.0",
+			})
+	}
+
+	if cfg.TxFees.ContractEnabled {
+		txFeesInit, err := marshalInit(&tx_fees.InitRequest{
+			Owner:  contractOwner,
+			Config: &tx_fees.FeeConfig{Denom: tx_fees.Denom_COIN},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, config.ContractConfig{
+			VMTypeName: "plugin",
+			Format:     "plugin",
+			Name:       "txfees",
+			Location:   "txfees:1.0.0",
+			Init:       txFeesInit,
+		})
+	}
+
+	if cfg.Governance.ContractEnabled {
+		governanceInit, err := marshalInit(&governance.InitRequest{
+			Params: &governance.Params{
+				VotingPeriod: 259200, // three days
+				Timelock:     86400,  // one day
+				Quorum:       5000,   // 50%
+				Threshold:    6667,   // 66.67%
+				// 100K LOOM of weighted stake, the validator power is the weighted stake / 10^12
+				MinProposerPower: 100000000000,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, config.ContractConfig{
+			VMTypeName: "plugin",
+			Format:     "plugin",
+			Name:       governance.ContractName,
+			Location:   "governance:1.0.0",
+			Init:       governanceInit,
+		})
+	}
+
+	if cfg.LiquidStaking.ContractEnabled {
+		liquidStakingInit, err := marshalInit(&liquid_staking.InitRequest{
+			Owner: contractOwner,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, config.ContractConfig{
+			VMTypeName: "plugin",
+			Format:     "plugin",
+			Name:       liquid_staking.ContractName,
+			Location:   "liquid-staking:1.0.0",
+			Init:       liquidStakingInit,
+		})
+	}
+
 	if cfg.Karma.Enabled {
 		karmaInitRequest := ktypes.KarmaInitRequest{
 			Sources: []*ktypes.KarmaSourceReward{
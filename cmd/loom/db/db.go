@@ -19,6 +19,7 @@ func NewDBCommand() *cobra.Command {
 		newDumpEVMStateFromEvmDB(),
 		newGetEvmHeightCommand(),
 		newGetAppHeightCommand(),
+		newReceiptsCommand(),
 	)
 	return cmd
 }
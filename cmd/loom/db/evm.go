@@ -65,6 +65,7 @@ func newDumpEVMStateCommand() *cobra.Command {
 				eventHandler,
 				cfg.EVMPersistentTxReceiptsMax,
 				nil,
+				nil,
 			)
 
 			// TODO: This should use snapshot obtained from appStore.ReadOnlyState()
@@ -178,6 +179,7 @@ func newDumpEVMStateMultiWriterAppStoreCommand() *cobra.Command {
 				eventHandler,
 				cfg.EVMPersistentTxReceiptsMax,
 				nil,
+				nil,
 			)
 
 			// TODO: This should use snapshot obtained from appStore.ReadOnlyState()
@@ -0,0 +1,179 @@
+// +build evm
+
+package db
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/receipts/leveldb"
+	evmaux "github.com/loomnetwork/loomchain/store/evm_aux"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	goutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+func newReceiptsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipts",
+		Short: "EVM tx receipts maintenance",
+	}
+	cmd.AddCommand(
+		newExportReceiptsCommand(),
+	)
+	return cmd
+}
+
+func newExportReceiptsCommand() *cobra.Command {
+	var outDir string
+	var receiptsPerFile int
+	var keepArchived bool
+
+	cmd := &cobra.Command{
+		Use:   "export [path/to/receipts_db]",
+		Short: "Exports receipts evicted from receipts_db to gzip compressed archive files",
+		Long: `Exports receipts evicted from receipts_db to gzip compressed archive files.
+
+Evicted receipts are only archived if ReceiptRetention.ArchiveEvicted is enabled in loom.yml.
+Each archive file contains a sequence of EvmTxReceipt protobufs, each prefixed by its length
+encoded as a uvarint. The node must be stopped while this command is running.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dbPath := evmaux.EvmAuxDBName
+			if len(args) > 0 {
+				dbPath = args[0]
+			}
+			if receiptsPerFile <= 0 {
+				return errors.New("receipts-per-file must be greater than zero")
+			}
+			if err := os.MkdirAll(outDir, 0755); err != nil {
+				return errors.Wrapf(err, "failed to create %s", outDir)
+			}
+
+			db, err := goleveldb.OpenFile(dbPath, nil)
+			if err != nil {
+				return errors.Wrapf(err, "failed to open %s", dbPath)
+			}
+			defer db.Close()
+
+			numExported := 0
+			var lastKey []byte
+			for {
+				n, key, err := exportReceiptsBatch(
+					db, outDir, receiptsPerFile, keepArchived, numExported, lastKey,
+				)
+				if err != nil {
+					return err
+				}
+				numExported += n
+				lastKey = key
+				if n < receiptsPerFile {
+					break
+				}
+			}
+			fmt.Printf("Exported %d receipts to %s\n", numExported, outDir)
+			return nil
+		},
+	}
+
+	cmdFlags := cmd.Flags()
+	cmdFlags.StringVar(&outDir, "out-dir", "./receipts-archive", "Directory to write archive files to")
+	cmdFlags.IntVar(&receiptsPerFile, "receipts-per-file", 10000, "Max number of receipts per archive file")
+	cmdFlags.BoolVar(&keepArchived, "keep", false, "Don't delete exported receipts from receipts_db")
+	return cmd
+}
+
+// exportReceiptsBatch writes up to maxReceipts archived receipts that follow afterKey to a new
+// archive file, and returns the number of receipts that were exported along with the key of the
+// last exported receipt. If afterKey is nil the export starts from the first archived receipt.
+func exportReceiptsBatch(
+	db *goleveldb.DB, outDir string, maxReceipts int, keepArchived bool, offset int, afterKey []byte,
+) (int, []byte, error) {
+	// Live receipts are stored under bare tx hashes in the same DB, so the range must include the
+	// separator that follows the prefix in archive keys, otherwise it'd also match any tx hash that
+	// happens to start with the prefix.
+	prefix := util.PrefixKey(leveldb.EvictedReceiptPrefix, nil)
+	start := prefix
+	if afterKey != nil {
+		// the smallest key that sorts after afterKey
+		start = append(append([]byte{}, afterKey...), 0)
+	}
+	iter := db.NewIterator(&goutil.Range{Start: start, Limit: util.PrefixRangeEnd(prefix)}, nil)
+	defer iter.Release()
+
+	var receipts []*types.EvmTxReceipt
+	var keys [][]byte
+	for len(receipts) < maxReceipts && iter.Next() {
+		var receipt types.EvmTxReceipt
+		if err := proto.Unmarshal(iter.Value(), &receipt); err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to unmarshal receipt %x", iter.Key())
+		}
+		receipts = append(receipts, &receipt)
+		keys = append(keys, append([]byte{}, iter.Key()...))
+	}
+	if err := iter.Error(); err != nil {
+		return 0, nil, err
+	}
+	if len(receipts) == 0 {
+		return 0, nil, nil
+	}
+
+	fileName := filepath.Join(outDir, fmt.Sprintf(
+		"receipts-%d-%d-%d.gz",
+		receipts[0].BlockNumber, receipts[len(receipts)-1].BlockNumber, offset,
+	))
+	if err := writeReceiptsArchive(fileName, receipts); err != nil {
+		return 0, nil, err
+	}
+
+	if !keepArchived {
+		batch := new(goleveldb.Batch)
+		for _, key := range keys {
+			batch.Delete(key)
+		}
+		if err := db.Write(batch, nil); err != nil {
+			return 0, nil, errors.Wrap(err, "failed to delete exported receipts")
+		}
+	}
+	return len(receipts), keys[len(keys)-1], nil
+}
+
+func writeReceiptsArchive(fileName string, receipts []*types.EvmTxReceipt) error {
+	f, err := os.Create(fileName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", fileName)
+	}
+	defer f.Close()
+
+	zw := gzip.NewWriter(f)
+	w := bufio.NewWriter(zw)
+	lenBuf := make([]byte, binary.MaxVarintLen64)
+	for _, receipt := range receipts {
+		receiptBytes, err := proto.Marshal(receipt)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal receipt")
+		}
+		n := binary.PutUvarint(lenBuf, uint64(len(receiptBytes)))
+		if _, err := w.Write(lenBuf[:n]); err != nil {
+			return err
+		}
+		if _, err := w.Write(receiptBytes); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,82 @@
+// +build evm
+
+package db
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/loomnetwork/loomchain/receipts/leveldb"
+	"github.com/stretchr/testify/require"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+)
+
+func TestExportReceiptsBatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "receipts-export")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := goleveldb.OpenFile(filepath.Join(dir, "receipts_db"), nil)
+	require.NoError(t, err)
+	defer db.Close()
+
+	putReceipt := func(key []byte, height int64, txHash []byte) {
+		receiptBytes, err := proto.Marshal(&types.EvmTxReceipt{BlockNumber: height, TxHash: txHash})
+		require.NoError(t, err)
+		require.NoError(t, db.Put(key, receiptBytes, nil))
+	}
+	hash := func(prefix string, fill byte) []byte {
+		txHash := make([]byte, 32)
+		for i := range txHash {
+			txHash[i] = fill
+		}
+		return append([]byte(prefix), txHash[len(prefix):]...)
+	}
+
+	// live receipts are stored under their tx hash, which may start with the archive prefix
+	liveHashes := [][]byte{hash("ev", 0x11), hash("ev", 0xff), hash("", 0x01)}
+	for _, txHash := range liveHashes {
+		putReceipt(txHash, 10, txHash)
+	}
+	archivedKeys := [][]byte{}
+	for i := int64(1); i <= 3; i++ {
+		txHash := hash("", byte(i+1))
+		key := leveldb.EvictedReceiptKey(uint64(i), txHash)
+		putReceipt(key, i, txHash)
+		archivedKeys = append(archivedKeys, key)
+	}
+
+	outDir := filepath.Join(dir, "archive")
+	require.NoError(t, os.MkdirAll(outDir, 0755))
+	n, lastKey, err := exportReceiptsBatch(db, outDir, 2, false, 0, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+	require.Equal(t, archivedKeys[1], lastKey)
+	n, lastKey, err = exportReceiptsBatch(db, outDir, 2, false, 2, lastKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, archivedKeys[2], lastKey)
+	n, _, err = exportReceiptsBatch(db, outDir, 2, false, 3, lastKey)
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	files, err := ioutil.ReadDir(outDir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// exported receipts are deleted, live receipts are left alone
+	for _, key := range archivedKeys {
+		has, err := db.Has(key, nil)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+	for _, txHash := range liveHashes {
+		has, err := db.Has(txHash, nil)
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+}
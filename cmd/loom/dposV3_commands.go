@@ -151,45 +151,44 @@ func ListValidatorsCmdV3() *cobra.Command {
 
 const listCandidateCmdExample = `
 loom dpos3 list-candidates
+loom dpos3 list-candidates --limit 50
 `
 
 func ListCandidatesCmdV3() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var page pageFlags
 	cmd := &cobra.Command{
 		Use:     "list-candidates",
 		Short:   "List the registered candidates",
 		Example: listCandidateCmdExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			var resp dposv3.ListCandidatesResponse
-			err := cli.StaticCallContractWithFlags(
-				&flags, DPOSV3ContractName, "ListCandidates", &dposv3.ListCandidatesRequest{}, &resp,
-			)
-			if err != nil {
-				return err
-			}
-			out, err := formatJSON(&resp)
-			if err != nil {
-				return err
+			if page.Paged() {
+				return listCandidatesPageV3(&flags, &page)
 			}
-			fmt.Println(out)
-			return nil
+			return listCandidatesV3(&flags)
 		},
 	}
+	addPageFlags(cmd.Flags(), &page)
 	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
 	return cmd
 }
 
 const listReferrersCmdExample = `
 loom dpos3 list-referrers 
+loom dpos3 list-referrers --limit 50
 `
 
 func ListReferrersCmdV3() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var page pageFlags
 	cmd := &cobra.Command{
 		Use:     "list-referrers",
 		Short:   "List all registered referrers",
 		Example: listReferrersCmdExample,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if page.Paged() {
+				return listReferrersPageV3(&flags, &page)
+			}
 			var resp dposv3.ListReferrersResponse
 			err := cli.StaticCallContractWithFlags(
 				&flags, DPOSV3ContractName, "ListReferrers", &dposv3.ListReferrersRequest{}, &resp,
@@ -197,34 +196,38 @@ func ListReferrersCmdV3() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			type maxLength struct {
-				Name    int
-				Address int
-			}
-			ml := maxLength{Name: 20, Address: 50}
-
-			for _, r := range resp.Referrers {
-				if ml.Name < len(r.Name) {
-					ml.Name = len(r.Name)
-				}
-			}
-
-			fmt.Printf("%-*s | %-*s \n", ml.Name, "referrer name", ml.Address, "address")
-			fmt.Printf(strings.Repeat("-", ml.Name+ml.Address+4) + "\n")
-			for _, r := range resp.Referrers {
-				fmt.Printf(
-					"%-*s | %-*s "+"\n",
-					ml.Name, r.Name, ml.Address, loom.UnmarshalAddressPB(r.GetReferrerAddress()).String(),
-				)
-			}
-
+			printReferrers(resp.Referrers)
 			return nil
 		},
 	}
+	addPageFlags(cmd.Flags(), &page)
 	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
 	return cmd
 }
 
+func printReferrers(referrers []*dposv3.Referrer) {
+	type maxLength struct {
+		Name    int
+		Address int
+	}
+	ml := maxLength{Name: 20, Address: 50}
+
+	for _, r := range referrers {
+		if ml.Name < len(r.Name) {
+			ml.Name = len(r.Name)
+		}
+	}
+
+	fmt.Printf("%-*s | %-*s \n", ml.Name, "referrer name", ml.Address, "address")
+	fmt.Printf(strings.Repeat("-", ml.Name+ml.Address+4) + "\n")
+	for _, r := range referrers {
+		fmt.Printf(
+			"%-*s | %-*s "+"\n",
+			ml.Name, r.Name, ml.Address, loom.UnmarshalAddressPB(r.GetReferrerAddress()).String(),
+		)
+	}
+}
+
 const changeFeeCmdExample = `
 loom dpos3 change-fee 2000 --k path/to/private_key
 `
@@ -258,10 +261,12 @@ func ChangeFeeCmdV3() *cobra.Command {
 
 const registerCandidateCmdExample = `
 loom dpos3 register-candidate 0x7262d4c97c7B93937E4810D289b7320e9dA82857 100 3 --name candidate_name
+loom dpos3 register-candidate 0x7262d4c97c7B93937E4810D289b7320e9dA82857 100 3 --max-fee 1000 --max-fee-change 100
 `
 
 func RegisterCandidateCmdV3() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var maxFee, maxFeeChange uint64
 	cmd := &cobra.Command{
 		// nolint:lll
 		Use: "register-candidate [public key] [validator fee (" +
@@ -303,21 +308,31 @@ func RegisterCandidateCmdV3() *cobra.Command {
 				}
 			}
 
-			return cli.CallContractWithFlags(
-				&flags, DPOSV3ContractName, "RegisterCandidate",
-				&dposv3.RegisterCandidateRequest{
-					PubKey:                pubKey,
-					Fee:                   candidateFee,
-					Name:                  candidateName,
-					Description:           candidateDescription,
-					Website:               candidateWebsite,
-					LocktimeTier:          tier,
-					MaxReferralPercentage: maxReferralPercentage,
-				}, nil,
-			)
+			req := &dposv3.RegisterCandidateRequest{
+				PubKey:                pubKey,
+				Fee:                   candidateFee,
+				Name:                  candidateName,
+				Description:           candidateDescription,
+				Website:               candidateWebsite,
+				LocktimeTier:          tier,
+				MaxReferralPercentage: maxReferralPercentage,
+			}
+			if cmd.Flags().Changed("max-fee") || cmd.Flags().Changed("max-fee-change") {
+				if !cmd.Flags().Changed("max-fee") || !cmd.Flags().Changed("max-fee-change") {
+					return errors.New("--max-fee and --max-fee-change must be specified together")
+				}
+				return registerCandidateWithCommission(&flags, req, maxFee, maxFeeChange)
+			}
+			return cli.CallContractWithFlags(&flags, DPOSV3ContractName, "RegisterCandidate", req, nil)
 		},
 	}
-	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	cmdFlags := cmd.Flags()
+	cmdFlags.Uint64Var(&maxFee, "max-fee", 0, "Maximum fee (in basis points) the candidate commits to")
+	cmdFlags.Uint64Var(
+		&maxFeeChange, "max-fee-change", 0,
+		"Maximum amount (in basis points) the candidate commits to raising its fee by in a single change",
+	)
+	cli.AddContractCallFlags(cmdFlags, &flags)
 	return cmd
 }
 
@@ -1077,10 +1092,12 @@ func CheckRewardsCmdV3() *cobra.Command {
 
 const checkAllDelegationsCmdExample = `
 loom dpos3 check-all-delegations 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+loom dpos3 check-all-delegations 0x7262d4c97c7B93937E4810D289b7320e9dA82857 --limit 50
 `
 
 func CheckAllDelegationsCmdV3() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var page pageFlags
 	cmd := &cobra.Command{
 		Use:     "check-all-delegations [delegator]",
 		Short:   "display all of a particular delegator's delegations",
@@ -1091,6 +1108,9 @@ func CheckAllDelegationsCmdV3() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if page.Paged() {
+				return checkAllDelegationsPageV3(&flags, &page, addr)
+			}
 
 			var resp dposv3.CheckAllDelegationsResponse
 			err = cli.StaticCallContractWithFlags(
@@ -1108,6 +1128,7 @@ func CheckAllDelegationsCmdV3() *cobra.Command {
 			return nil
 		},
 	}
+	addPageFlags(cmd.Flags(), &page)
 	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
 	return cmd
 }
@@ -1146,10 +1167,12 @@ func TimeUntilElectionCmdV3() *cobra.Command {
 
 const listDelegationsCmdExample = `
 loom dpos3 list-delegations 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+loom dpos3 list-delegations 0x7262d4c97c7B93937E4810D289b7320e9dA82857 --limit 100
 `
 
 func ListDelegationsCmdV3() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var page pageFlags
 	cmd := &cobra.Command{
 		Use:     "list-delegations <candidate address>",
 		Short:   "list a candidate's delegations & delegation total",
@@ -1160,6 +1183,9 @@ func ListDelegationsCmdV3() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if page.Paged() {
+				return listDelegationsPageV3(&flags, &page, addr)
+			}
 
 			var resp dposv3.ListDelegationsResponse
 			err = cli.StaticCallContractWithFlags(
@@ -1177,22 +1203,28 @@ func ListDelegationsCmdV3() *cobra.Command {
 			return nil
 		},
 	}
+	addPageFlags(cmd.Flags(), &page)
 	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
 	return cmd
 }
 
 const listAllDelegationsCmdExample = `
 loom dpos3 list-all-delegations -u http://localhost:12345
+loom dpos3 list-all-delegations --limit 500 -u http://localhost:12345
 `
 
 func ListAllDelegationsCmdV3() *cobra.Command {
 	var flags cli.ContractCallFlags
+	var page pageFlags
 	cmd := &cobra.Command{
 		Use:     "list-all-delegations",
 		Short:   "display the results of calling list_delegations for all candidates",
 		Example: listAllDelegationsCmdExample,
 		Args:    cobra.MinimumNArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if page.Paged() {
+				return listAllDelegationsPageV3(&flags, &page)
+			}
 			var resp dposv3.ListAllDelegationsResponse
 			err := cli.StaticCallContractWithFlags(
 				&flags, DPOSV3ContractName, "ListAllDelegations",
@@ -1209,6 +1241,7 @@ func ListAllDelegationsCmdV3() *cobra.Command {
 			return nil
 		},
 	}
+	addPageFlags(cmd.Flags(), &page)
 	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
 	return cmd
 }
@@ -1644,6 +1677,17 @@ func NewDPOSV3Command() *cobra.Command {
 		UnjailValidatorCmdV3(),
 		EnableValidatorJailingCmd(),
 		IgnoreUnbondLocktimeCmd(),
+		RewardsHistoryCmdV3(),
+		ElectionHistoryCmdV3(),
+		SimulateElectionCmdV3(),
+		SetCommissionLimitsCmdV3(),
+		GetFeeScheduleCmdV3(),
+		ScheduleUnbondCmdV3(),
+		CancelUnbondCmdV3(),
+		ListUnbondingsCmdV3(),
+		UnbondingQueueCmdV3(),
+		RotateValidatorKeyCmdV3(),
+		GetKeyRotationCmdV3(),
 	)
 	return cmd
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// listCandidatesV3 prints all the registered candidates along with their fee schedules.
+func listCandidatesV3(flags *cli.ContractCallFlags) error {
+	return printPage(
+		flags, DPOSV3ContractName, "ListCandidates",
+		&dposv3.ListCandidatesRequest{}, &dposv3.ListCandidatesResponse{},
+	)
+}
+
+func registerCandidateWithCommission(
+	flags *cli.ContractCallFlags, req *dposv3.RegisterCandidateRequest, maxFee, maxFeeChange uint64,
+) error {
+	return cli.CallContractWithFlags(
+		flags, DPOSV3ContractName, "RegisterCandidateWithCommission",
+		&dposv3.RegisterCandidateWithCommissionRequest{
+			Candidate: req,
+			Limits: &dposv3.CommissionLimits{
+				MaxFee:       maxFee,
+				MaxFeeChange: maxFeeChange,
+			},
+		}, nil,
+	)
+}
+
+const setCommissionLimitsCmdExample = `
+loom dpos3 set-commission-limits 1000 100 --key path/to/private_key
+`
+
+func SetCommissionLimitsCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "set-commission-limits <max fee> <max fee change>",
+		Short:   "Declare (or tighten) the maximum fee & maximum fee raise of a candidate, in basis points",
+		Example: setCommissionLimitsCmdExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			maxFee, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid max fee")
+			}
+			maxFeeChange, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid max fee change")
+			}
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "SetCommissionLimits",
+				&dposv3.SetCommissionLimitsRequest{
+					Limits: &dposv3.CommissionLimits{
+						MaxFee:       maxFee,
+						MaxFeeChange: maxFeeChange,
+					},
+				}, nil,
+			)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const getFeeScheduleCmdExample = `
+loom dpos3 get-fee-schedule 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+`
+
+func GetFeeScheduleCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "get-fee-schedule <candidate address>",
+		Short:   "Show the current fee, pending fee change & commission limits of a candidate",
+		Example: getFeeScheduleCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3.GetCandidateFeeScheduleResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetCandidateFeeSchedule",
+				&dposv3.GetCandidateFeeScheduleRequest{Candidate: address.MarshalPB()}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
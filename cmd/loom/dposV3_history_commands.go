@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/spf13/cobra"
+)
+
+const rewardsHistoryCmdExample = `
+loom dpos3 rewards-history 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+loom dpos3 rewards-history 0x7262d4c97c7B93937E4810D289b7320e9dA82857 --start-id 120 --limit 50
+`
+
+func RewardsHistoryCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var startID uint64
+	var limit uint32
+	cmd := &cobra.Command{
+		Use:     "rewards-history <delegator address>",
+		Short:   "Show the rewards credited to a delegator in past elections, newest first",
+		Example: rewardsHistoryCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := cli.ResolveAccountAddress(args[0], &flags)
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3.GetDelegatorRewardHistoryResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetDelegatorRewardHistory",
+				&dposv3.GetDelegatorRewardHistoryRequest{
+					Delegator: address.MarshalPB(),
+					StartId:   startID,
+					Limit:     limit,
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cmdFlags := cmd.Flags()
+	cmdFlags.Uint64Var(&startID, "start-id", 0, "ID of the newest record to return, defaults to the latest record")
+	cmdFlags.Uint32Var(&limit, "limit", 0, "Maximum number of records to return")
+	cli.AddContractStaticCallFlags(cmdFlags, &flags)
+	return cmd
+}
+
+const electionHistoryCmdExample = `
+loom dpos3 election-history --limit 10
+`
+
+func ElectionHistoryCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var startID uint64
+	var limit uint32
+	cmd := &cobra.Command{
+		Use:     "election-history",
+		Short:   "Show the stake, rewards, fees & slashes of each validator in past elections, newest first",
+		Example: electionHistoryCmdExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp dposv3.GetElectionHistoryResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetElectionHistory",
+				&dposv3.GetElectionHistoryRequest{
+					StartId: startID,
+					Limit:   limit,
+				}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cmdFlags := cmd.Flags()
+	cmdFlags.Uint64Var(&startID, "start-id", 0, "ID of the newest record to return, defaults to the latest record")
+	cmdFlags.Uint32Var(&limit, "limit", 0, "Maximum number of records to return")
+	cli.AddContractStaticCallFlags(cmdFlags, &flags)
+	return cmd
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+)
+
+// The functions below fetch & print a single page of the results of the dpos3 list commands, the
+// DPOSv3 list requests are only paged if they specify a limit.
+
+func listCandidatesPageV3(flags *cli.ContractCallFlags, page *pageFlags) error {
+	startKey, err := page.StartKeyBytes()
+	if err != nil {
+		return err
+	}
+	return printPage(
+		flags, DPOSV3ContractName, "ListCandidates",
+		&dposv3.ListCandidatesRequest{StartKey: startKey, Limit: page.PageLimit()},
+		&dposv3.ListCandidatesResponse{},
+	)
+}
+
+func listDelegationsPageV3(flags *cli.ContractCallFlags, page *pageFlags, candidate loom.Address) error {
+	startKey, err := page.StartKeyBytes()
+	if err != nil {
+		return err
+	}
+	return printPage(
+		flags, DPOSV3ContractName, "ListDelegations",
+		&dposv3.ListDelegationsRequest{
+			Candidate: candidate.MarshalPB(),
+			StartKey:  startKey,
+			Limit:     page.PageLimit(),
+		},
+		&dposv3.ListDelegationsResponse{},
+	)
+}
+
+func listAllDelegationsPageV3(flags *cli.ContractCallFlags, page *pageFlags) error {
+	startKey, err := page.StartKeyBytes()
+	if err != nil {
+		return err
+	}
+	return printPage(
+		flags, DPOSV3ContractName, "ListAllDelegations",
+		&dposv3.ListAllDelegationsRequest{StartKey: startKey, Limit: page.PageLimit()},
+		&dposv3.ListAllDelegationsResponse{},
+	)
+}
+
+func checkAllDelegationsPageV3(flags *cli.ContractCallFlags, page *pageFlags, delegator loom.Address) error {
+	startKey, err := page.StartKeyBytes()
+	if err != nil {
+		return err
+	}
+	return printPage(
+		flags, DPOSV3ContractName, "CheckAllDelegations",
+		&dposv3.CheckAllDelegationsRequest{
+			DelegatorAddress: delegator.MarshalPB(),
+			StartKey:         startKey,
+			Limit:            page.PageLimit(),
+		},
+		&dposv3.CheckAllDelegationsResponse{},
+	)
+}
+
+func listReferrersPageV3(flags *cli.ContractCallFlags, page *pageFlags) error {
+	startKey, err := page.StartKeyBytes()
+	if err != nil {
+		return err
+	}
+	var resp dposv3.ListReferrersResponse
+	err = cli.StaticCallContractWithFlags(
+		flags, DPOSV3ContractName, "ListReferrers",
+		&dposv3.ListReferrersRequest{StartKey: startKey, Limit: page.PageLimit()}, &resp,
+	)
+	if err != nil {
+		return err
+	}
+	printReferrers(resp.Referrers)
+	printNextPageKey(resp.NextKey)
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/privval"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+const rotateValidatorKeyCmdExample = `
+loom dpos3 rotate-validator-key path/to/next_priv_validator.json --key path/to/private_key
+`
+
+func RotateValidatorKeyCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "rotate-validator-key <next priv validator file>",
+		Short:   "Replace the consensus key of a candidate at the next election",
+		Example: rotateValidatorKeyCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			nextPV, err := privval.LoadFilePV(args[0])
+			if err != nil {
+				return errors.Wrap(err, "failed to load next priv validator")
+			}
+			nextPubKey, ok := nextPV.GetPubKey().(ed25519.PubKeyEd25519)
+			if !ok {
+				return errors.New("next priv validator key must be ed25519")
+			}
+
+			signer, err := cli.GetSigner(flags.PrivFile, flags.HsmConfigFile, flags.Algo)
+			if err != nil {
+				return err
+			}
+			candidateAddr := loom.Address{
+				ChainID: flags.ChainID,
+				Local:   loom.LocalAddressFromPublicKey(signer.PublicKey()),
+			}
+			var rotationResp dposv3.GetValidatorKeyRotationResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetValidatorKeyRotation",
+				&dposv3.GetValidatorKeyRotationRequest{Candidate: candidateAddr.MarshalPB()}, &rotationResp,
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to load key rotation nonce")
+			}
+			// the new key proves it's controlled by the candidate by signing the rotation
+			sig, err := nextPV.GetPrivKey().Sign(dposv3.RotateValidatorKeySignBytes(
+				flags.ChainID, candidateAddr, nextPubKey[:], rotationResp.Nonce,
+			))
+			if err != nil {
+				return err
+			}
+
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "RotateValidatorKey", &dposv3.RotateValidatorKeyRequest{
+					NewPubKey: nextPubKey[:],
+					Signature: sig,
+				}, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const getKeyRotationCmdExample = `
+loom dpos3 get-key-rotation 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+`
+
+func GetKeyRotationCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "get-key-rotation <candidate address>",
+		Short:   "Show the pending consensus key rotation of a candidate",
+		Example: getKeyRotationCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3.GetValidatorKeyRotationResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetValidatorKeyRotation",
+				&dposv3.GetValidatorKeyRotationRequest{Candidate: addr.MarshalPB()}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
@@ -0,0 +1,382 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/cli"
+	glcommon "github.com/loomnetwork/go-loom/common"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/cmd/loom/common"
+	"github.com/loomnetwork/loomchain/config"
+	"github.com/loomnetwork/loomchain/events"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/log"
+	"github.com/loomnetwork/loomchain/plugin"
+	registry "github.com/loomnetwork/loomchain/registry/factory"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// Hypothetical changes to apply to the DPOSv3 state before running a simulated election.
+type electionChanges struct {
+	validatorCount       uint64
+	maxYearlyReward      string
+	crashSlashingPct     int64
+	byzantineSlashingPct int64
+	// <validator address>=<amount> pairs
+	delegations []string
+	// validators to slash for double-signing
+	slashed []string
+}
+
+func (c *electionChanges) changesParams() bool {
+	return c.validatorCount > 0 || c.maxYearlyReward != "" || c.crashSlashingPct >= 0 || c.byzantineSlashingPct >= 0
+}
+
+// Result of a simulated election for a single validator or candidate.
+type simulatedValidator struct {
+	power           int64
+	delegationTotal *types.BigUInt
+	rewards         *types.BigUInt
+}
+
+// Runs the DPOSv3 election code against a copy of the app state at the given height, optionally
+// applying some changes to the state beforehand. Nothing is ever written back to app.db.
+func simulateElection(
+	cfg *config.Config, appStore *store.IAVLStore, changes *electionChanges,
+) (map[string]*simulatedValidator, error) {
+	// All writes are buffered in this tx, which is never committed
+	storeTx := store.WrapAtomic(appStore).BeginTx()
+	defer storeTx.Rollback()
+
+	regVer, err := registry.RegistryVersionFromInt(cfg.RegistryVersion)
+	if err != nil {
+		return nil, err
+	}
+	createRegistry, err := registry.NewRegistryFactory(regVer)
+	if err != nil {
+		return nil, err
+	}
+	createCtx := func(electionTime int64) (contract.Context, *plugin.PluginVM, error) {
+		state := loomchain.NewStoreState(
+			context.Background(),
+			storeTx,
+			abci.Header{
+				ChainID: cfg.ChainID,
+				Height:  appStore.Version(),
+				Time:    time.Unix(electionTime, 0),
+			},
+			nil,
+			nil,
+		)
+		pvm := plugin.NewPluginVM(
+			common.NewDefaultContractsLoader(cfg),
+			state,
+			createRegistry(state),
+			loomchain.NewDefaultEventHandler(events.NewLogEventDispatcher()),
+			log.Default,
+			nil, // account balance manager factory
+			nil, // receipt writer
+			nil, // receipt reader
+		)
+		ctx, err := plugin.NewInternalContractContext("dposV3", pvm, false)
+		return ctx, pvm, err
+	}
+
+	ctx, _, err := createCtx(0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create DPOSv3 context")
+	}
+	dposState, err := dposv3.LoadState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Pretend that the next election is due
+	ctx, pvm, err := createCtx(dposState.LastElectionTime + dposState.Params.ElectionCycleLength)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create DPOSv3 context")
+	}
+	// The election history ledger provides the rewards of each validator
+	pvm.State.SetFeature(features.DPOSVersion3_13, true)
+
+	if changes != nil {
+		if err := applyElectionChanges(ctx, pvm, dposState, changes); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := dposv3.Elect(ctx); err != nil {
+		return nil, errors.Wrap(err, "election failed")
+	}
+
+	history, err := (&dposv3.DPOS{}).GetElectionHistory(ctx, &dposv3.GetElectionHistoryRequest{Limit: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(history.Records) == 0 {
+		return nil, errors.New("election wasn't recorded")
+	}
+
+	results := map[string]*simulatedValidator{}
+	for _, record := range history.Records[0].Validators {
+		results[loom.UnmarshalAddressPB(record.Validator).String()] = &simulatedValidator{
+			delegationTotal: record.NewDelegationTotal,
+			rewards:         record.TotalRewards,
+		}
+	}
+
+	candidates, err := dposv3.LoadCandidateList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := dposv3.ValidatorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range validators {
+		for _, c := range candidates {
+			if string(c.PubKey) != string(v.PubKey) {
+				continue
+			}
+			key := loom.UnmarshalAddressPB(c.Address).String()
+			if results[key] == nil {
+				results[key] = &simulatedValidator{
+					delegationTotal: loom.BigZeroPB(),
+					rewards:         loom.BigZeroPB(),
+				}
+			}
+			results[key].power = v.Power
+		}
+	}
+	return results, nil
+}
+
+func applyElectionChanges(
+	ctx contract.Context, pvm *plugin.PluginVM, dposState *dposv3.State, changes *electionChanges,
+) error {
+	dpos := &dposv3.DPOS{}
+	if changes.changesParams() {
+		if dposState.Params.OracleAddress == nil {
+			return errors.New("can't change DPOSv3 params without an oracle")
+		}
+		// The param setters can only be called by the oracle
+		oracleCtx := contract.WrapPluginContext(pvm.CreateContractContext(
+			loom.UnmarshalAddressPB(dposState.Params.OracleAddress), ctx.ContractAddress(), false,
+		))
+		if changes.validatorCount > 0 {
+			err := dpos.SetValidatorCount(oracleCtx, &dposv3.SetValidatorCountRequest{
+				ValidatorCount: changes.validatorCount,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to set validator count")
+			}
+		}
+		if changes.maxYearlyReward != "" {
+			amount, err := cli.ParseAmount(changes.maxYearlyReward)
+			if err != nil {
+				return err
+			}
+			err = dpos.SetMaxYearlyReward(oracleCtx, &dposv3.SetMaxYearlyRewardRequest{
+				MaxYearlyReward: &types.BigUInt{Value: *amount},
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to set max yearly reward")
+			}
+		}
+		if changes.crashSlashingPct >= 0 || changes.byzantineSlashingPct >= 0 {
+			req := &dposv3.SetSlashingPercentagesRequest{
+				CrashSlashingPercentage:     dposState.Params.CrashSlashingPercentage,
+				ByzantineSlashingPercentage: dposState.Params.ByzantineSlashingPercentage,
+			}
+			if changes.crashSlashingPct >= 0 {
+				req.CrashSlashingPercentage = &types.BigUInt{
+					Value: *loom.NewBigUIntFromInt(changes.crashSlashingPct),
+				}
+			}
+			if changes.byzantineSlashingPct >= 0 {
+				req.ByzantineSlashingPercentage = &types.BigUInt{
+					Value: *loom.NewBigUIntFromInt(changes.byzantineSlashingPct),
+				}
+			}
+			if err := dpos.SetSlashingPercentages(oracleCtx, req); err != nil {
+				return errors.Wrap(err, "failed to set slashing percentages")
+			}
+		}
+	}
+
+	chainID := ctx.Block().ChainID
+	// Hypothetical delegations are attributed to the root address, and bypass the coin contract
+	// since they'll never be committed anyway.
+	delegator := loom.RootAddress(chainID)
+	for _, d := range changes.delegations {
+		parts := strings.Split(d, "=")
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid delegation %s, expected <validator address>=<amount>", d)
+		}
+		validator, err := cli.ParseAddress(parts[0], chainID)
+		if err != nil {
+			return err
+		}
+		amount, err := cli.ParseAmount(parts[1])
+		if err != nil {
+			return err
+		}
+		if dposv3.GetCandidate(ctx, validator) == nil {
+			return fmt.Errorf("candidate %s not found", validator.String())
+		}
+		index, err := dposv3.GetNextDelegationIndex(ctx, *validator.MarshalPB(), *delegator.MarshalPB())
+		if err != nil {
+			return err
+		}
+		err = dposv3.SetDelegation(ctx, &dposv3.Delegation{
+			Validator:    validator.MarshalPB(),
+			Delegator:    delegator.MarshalPB(),
+			Amount:       loom.BigZeroPB(),
+			UpdateAmount: &types.BigUInt{Value: *amount},
+			LocktimeTier: dposv3.TIER_ZERO,
+			State:        dposv3.BONDING,
+			Index:        index,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, v := range changes.slashed {
+		validator, err := cli.ParseAddress(v, chainID)
+		if err != nil {
+			return err
+		}
+		statistic, err := dposv3.GetStatistic(ctx, validator)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load statistic of %s", validator.String())
+		}
+		if err := dposv3.SlashDoubleSign(ctx, statistic); err != nil {
+			return err
+		}
+		if err := dposv3.SetStatistic(ctx, statistic); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const simulateElectionCmdExample = `
+loom dpos3 simulate-election --validator-count 25
+loom dpos3 simulate-election --height 1000000 --delegate 0x7262d4c97c7B93937E4810D289b7320e9dA82857=1250000
+loom dpos3 simulate-election --byzantine-slashing-pct 1000 --slash 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+`
+
+func SimulateElectionCmdV3() *cobra.Command {
+	var appHeight int64
+	changes := &electionChanges{}
+	cmd := &cobra.Command{
+		Use:   "simulate-election",
+		Short: "Preview the outcome of the next election with hypothetical DPOSv3 changes applied",
+		Long: "Loads the DPOSv3 state from the local app.db, applies the hypothetical changes, and " +
+			"runs the next election without writing anything back to app.db. The resulting validator set " +
+			"is compared to the outcome of the same election without any changes applied.\n" +
+			"Slashing percentage changes only affect slashes applied via --slash, and changes to the " +
+			"registration requirement can't be simulated since they don't affect existing candidates.\n" +
+			"The node must be stopped while this command is running, app.db is opened read-only but " +
+			"LevelDB still locks it, so it can't be opened while the node is using it.",
+		Example: simulateElectionCmdExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := common.ParseConfig()
+			if err != nil {
+				return err
+			}
+
+			db, err := dbm.NewGoLevelDBWithOpts(cfg.DBName, cfg.RootPath(), &opt.Options{
+				ReadOnly: true,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to open app.db, make sure the node isn't running")
+			}
+			defer db.Close()
+
+			appStore, err := store.NewIAVLStore(db, 0, appHeight, 0)
+			if err != nil {
+				return err
+			}
+
+			baseline, err := simulateElection(cfg, appStore, nil)
+			if err != nil {
+				return err
+			}
+			simulated, err := simulateElection(cfg, appStore, changes)
+			if err != nil {
+				return err
+			}
+
+			printSimulatedElection(appStore.Version(), baseline, simulated)
+			return nil
+		},
+	}
+	cmdFlags := cmd.Flags()
+	cmdFlags.Int64Var(&appHeight, "height", 0, "App height to load the DPOSv3 state from, defaults to the latest")
+	cmdFlags.Uint64Var(&changes.validatorCount, "validator-count", 0, "Number of validators to elect")
+	cmdFlags.StringVar(&changes.maxYearlyReward, "max-yearly-reward", "", "Max amount of rewards per year")
+	cmdFlags.Int64Var(
+		&changes.crashSlashingPct, "crash-slashing-pct", -1, "Crash fault slashing percentage (basis points)",
+	)
+	cmdFlags.Int64Var(
+		&changes.byzantineSlashingPct, "byzantine-slashing-pct", -1,
+		"Byzantine fault slashing percentage (basis points)",
+	)
+	cmdFlags.StringSliceVar(
+		&changes.delegations, "delegate", nil, "Hypothetical delegation, <validator address>=<amount>",
+	)
+	cmdFlags.StringSliceVar(&changes.slashed, "slash", nil, "Validator to slash for double-signing")
+	return cmd
+}
+
+func printSimulatedElection(height int64, baseline, simulated map[string]*simulatedValidator) {
+	addrs := make([]string, 0, len(simulated))
+	for addr := range simulated {
+		addrs = append(addrs, addr)
+	}
+	for addr := range baseline {
+		if simulated[addr] == nil {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+
+	none := &simulatedValidator{delegationTotal: loom.BigZeroPB(), rewards: loom.BigZeroPB()}
+	fmt.Printf("Simulated election at app height %d\n\n", height)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "address\tpower\tpower delta\tdelegation total\trewards\trewards delta")
+	for _, addr := range addrs {
+		before, after := baseline[addr], simulated[addr]
+		if before == nil {
+			before = none
+		}
+		if after == nil {
+			after = none
+		}
+		rewardsDelta := glcommon.BigZero()
+		rewardsDelta.Sub(&after.rewards.Value, &before.rewards.Value)
+		fmt.Fprintf(
+			w, "%s\t%d\t%+d\t%s\t%s\t%s\n",
+			addr, after.power, after.power-before.power,
+			after.delegationTotal.Value.String(), after.rewards.Value.String(), rewardsDelta.String(),
+		)
+	}
+	w.Flush()
+}
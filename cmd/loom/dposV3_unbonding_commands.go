@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const scheduleUnbondCmdExample = `
+loom dpos3 schedule-unbond 0x7262d4c97c7B93937E4810D289b7320e9dA82857 1250 1 4 --key path/to/private_key
+`
+
+func ScheduleUnbondCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "schedule-unbond <validator address> <amount> <index> <elections>",
+		Short:   "De-allocate tokens from a validator in the given number of elections from now",
+		Example: scheduleUnbondCmdExample,
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			amount, err := cli.ParseAmount(args[1])
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid delegation index")
+			}
+			elections, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid number of elections")
+			}
+
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "ScheduleUnbond", &dposv3.ScheduleUnbondRequest{
+					ValidatorAddress: addr.MarshalPB(),
+					Amount:           &types.BigUInt{Value: *amount},
+					Index:            index,
+					Elections:        elections,
+				}, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const cancelUnbondCmdExample = `
+loom dpos3 cancel-unbond 0x7262d4c97c7B93937E4810D289b7320e9dA82857 1 --key path/to/private_key
+`
+
+func CancelUnbondCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "cancel-unbond <validator address> <index>",
+		Short:   "Cancel a pending unbonding, re-bonding the delegation",
+		Example: cancelUnbondCmdExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid delegation index")
+			}
+
+			return cli.CallContractWithFlags(
+				&flags, DPOSV3ContractName, "CancelUnbond", &dposv3.CancelUnbondRequest{
+					ValidatorAddress: addr.MarshalPB(),
+					Index:            index,
+				}, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const listUnbondingsCmdExample = `
+loom dpos3 list-unbondings 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+`
+
+func ListUnbondingsCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "list-unbondings <delegator address>",
+		Short:   "Show the pending unbondings of a delegator & their estimated maturity times",
+		Example: listUnbondingsCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := cli.ResolveAccountAddress(args[0], &flags)
+			if err != nil {
+				return err
+			}
+
+			var resp dposv3.ListUnbondingsResponse
+			err = cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "ListUnbondings",
+				&dposv3.ListUnbondingsRequest{Delegator: address.MarshalPB()}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const unbondingQueueCmdExample = `
+loom dpos3 unbonding-queue
+loom dpos3 unbonding-queue --election 42
+`
+
+func UnbondingQueueCmdV3() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var election uint64
+	cmd := &cobra.Command{
+		Use:     "unbonding-queue",
+		Short:   "Show the unbondings that complete in an election",
+		Example: unbondingQueueCmdExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp dposv3.GetUnbondingQueueResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, DPOSV3ContractName, "GetUnbondingQueue",
+				&dposv3.GetUnbondingQueueRequest{Election: election}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			out, err := formatJSON(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(out)
+			return nil
+		},
+	}
+	cmdFlags := cmd.Flags()
+	cmdFlags.Uint64Var(&election, "election", 0, "Number of the completion election, defaults to the next election")
+	cli.AddContractStaticCallFlags(cmdFlags, &flags)
+	return cmd
+}
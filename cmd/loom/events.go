@@ -0,0 +1,148 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/loomnetwork/loomchain/cmd/loom/common"
+	"github.com/loomnetwork/loomchain/events"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+func newEventsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Event dispatcher maintenance",
+	}
+	cmd.AddCommand(newWebhookCommand())
+	return cmd
+}
+
+func newWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Inspect & replay failed webhook deliveries (the node must be stopped)",
+	}
+	cmd.AddCommand(
+		newListWebhookDeliveriesCommand(),
+		newReplayWebhookDeliveryCommand(),
+		newPurgeWebhookDeliveriesCommand(),
+	)
+	return cmd
+}
+
+func loadWebhookQueue() (*events.WebhookQueue, func(), error) {
+	cfg, err := common.ParseConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	eventsDB, err := loadEventStoreDB(cfg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to load event store DB")
+	}
+	return events.NewWebhookQueue(eventsDB), eventsDB.Close, nil
+}
+
+func newListWebhookDeliveriesCommand() *cobra.Command {
+	var showPending bool
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List webhook deliveries in the dead-letter list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queue, closeDB, err := loadWebhookQueue()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			var deliveries []*events.WebhookDelivery
+			if showPending {
+				deliveries, err = queue.Pending()
+			} else {
+				deliveries, err = queue.DeadLetters()
+			}
+			if err != nil {
+				return err
+			}
+			for _, d := range deliveries {
+				fmt.Printf(
+					"id: %d, url: %s, block: %d, event: %d, attempts: %d, next attempt: %s, error: %s\n",
+					d.ID, d.URL, d.BlockHeight, d.EventIndex, d.Attempts,
+					time.Unix(d.NextAttempt, 0).UTC().Format(time.RFC3339), d.LastError,
+				)
+			}
+			fmt.Printf("%d deliveries\n", len(deliveries))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&showPending, "pending", false, "List deliveries still in the retry queue instead")
+	return cmd
+}
+
+func newReplayWebhookDeliveryCommand() *cobra.Command {
+	var replayAll bool
+	cmd := &cobra.Command{
+		Use:   "replay [delivery-id]",
+		Short: "Move webhook deliveries from the dead-letter list back into the retry queue",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !replayAll && len(args) == 0 {
+				return errors.New("delivery ID or --all must be specified")
+			}
+			queue, closeDB, err := loadWebhookQueue()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			var ids []uint64
+			if replayAll {
+				deliveries, err := queue.DeadLetters()
+				if err != nil {
+					return err
+				}
+				for _, d := range deliveries {
+					ids = append(ids, d.ID)
+				}
+			} else {
+				id, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return errors.Wrap(err, "invalid delivery ID")
+				}
+				ids = append(ids, id)
+			}
+			for _, id := range ids {
+				if err := queue.Replay(id); err != nil {
+					return err
+				}
+			}
+			fmt.Printf("%d deliveries will be retried when the node is started\n", len(ids))
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&replayAll, "all", false, "Replay all deliveries in the dead-letter list")
+	return cmd
+}
+
+func newPurgeWebhookDeliveriesCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "purge",
+		Short: "Delete all webhook deliveries in the dead-letter list",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queue, closeDB, err := loadWebhookQueue()
+			if err != nil {
+				return err
+			}
+			defer closeDB()
+
+			n, err := queue.PurgeDeadLetters()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Deleted %d deliveries\n", n)
+			return nil
+		},
+	}
+}
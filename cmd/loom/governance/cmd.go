@@ -0,0 +1,248 @@
+package governance
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/go-loom/client"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const contractName = governance.ContractName
+
+func NewGovernanceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "governance <command>",
+		Short: "On-chain governance CLI",
+	}
+	cmd.AddCommand(
+		proposeCmd(),
+		voteCmd(),
+		getProposalCmd(),
+		listProposalsCmd(),
+		getParamsCmd(),
+	)
+	return cmd
+}
+
+const proposeCmdExample = `
+loom governance propose dposV3 SetValidatorCount --input req.hex --description "Increase validator count to 25" -k validator.key
+`
+
+func proposeCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var inputFile, description string
+	cmd := &cobra.Command{
+		Use:   "propose <contract name or address> <method>",
+		Short: "Submit a proposal to call a Go contract method (only validators & delegators can do this)",
+		Long: "Submit a proposal to call a Go contract method, the input file should contain the hex " +
+			"encoded protobuf request of the method. If the proposal passes the Governance contract " +
+			"will call the method once the proposal timelock expires.",
+		Example: proposeCmdExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			input, err := readHexFile(inputFile)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			var contractAddr loom.Address
+			if strings.HasPrefix(args[0], "0x") {
+				contractAddr, err = cli.ParseAddress(args[0], flags.ChainID)
+			} else {
+				rpcClient := client.NewDAppChainRPCClient(flags.ChainID, flags.URI+"/rpc", flags.URI+"/query")
+				contractAddr, err = rpcClient.Resolve(args[0])
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to resolve contract address")
+			}
+
+			req := &governance.ProposeRequest{
+				Description: description,
+				Call: &governance.ContractCall{
+					Contract: contractAddr.MarshalPB(),
+					Method:   args[1],
+					Args:     input,
+				},
+			}
+			var resp governance.ProposeResponse
+			if err := cli.CallContractWithFlags(&flags, contractName, "Propose", req, &resp); err != nil {
+				return err
+			}
+			fmt.Printf("Proposal %d submitted\n", resp.ProposalId)
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().StringVarP(&inputFile, "input", "i", "", "file with hex encoded method request")
+	cmd.Flags().StringVar(&description, "description", "", "description of the proposal")
+	return cmd
+}
+
+const voteCmdExample = `
+loom governance vote 3 yes -k validator.key
+`
+
+func voteCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "vote <proposal id> <yes|no>",
+		Short:   "Vote on a proposal (only validators & delegators can do this)",
+		Example: voteCmdExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid proposal id")
+			}
+			var approve bool
+			switch strings.ToLower(args[1]) {
+			case "yes":
+				approve = true
+			case "no":
+				approve = false
+			default:
+				return errors.Errorf("invalid vote %s, must be yes or no", args[1])
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &governance.VoteRequest{ProposalId: id, Approve: approve}
+			return cli.CallContractWithFlags(&flags, contractName, "Vote", req, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func getProposalCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "get-proposal <proposal id>",
+		Short: "Show the status & votes of a proposal",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid proposal id")
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &governance.GetProposalRequest{ProposalId: id}
+			var resp governance.GetProposalResponse
+			if err := cli.StaticCallContractWithFlags(&flags, contractName, "GetProposal", req, &resp); err != nil {
+				return err
+			}
+			printProposal(resp.Proposal)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func listProposalsCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "list-proposals",
+		Short: "Show all proposals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var resp governance.ListProposalsResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, contractName, "ListProposals", &governance.ListProposalsRequest{}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			for i, proposal := range resp.Proposals {
+				if i > 0 {
+					fmt.Println()
+				}
+				printProposal(proposal)
+			}
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func getParamsCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "get-params",
+		Short: "Show the voting parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var resp governance.GetParamsResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, contractName, "GetParams", &governance.GetParamsRequest{}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			marshaler := jsonpb.Marshaler{Indent: "  ", EmitDefaults: true}
+			output, err := marshaler.MarshalToString(resp.Params)
+			if err != nil {
+				return err
+			}
+			fmt.Println(output)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func printProposal(p *governance.Proposal) {
+	fmt.Printf("id: %d\n", p.Id)
+	fmt.Printf("status: %s\n", strings.ToLower(p.Status.String()))
+	fmt.Printf("proposer: %s\n", loom.UnmarshalAddressPB(p.Proposer).String())
+	fmt.Printf("description: %s\n", p.Description)
+	if p.Call != nil {
+		fmt.Printf("call: %s.%s(%x)\n", loom.UnmarshalAddressPB(p.Call.Contract).String(), p.Call.Method, p.Call.Args)
+	}
+	fmt.Printf("voting ends: %s\n", time.Unix(p.VotingEndsAt, 0).UTC().Format(time.RFC3339))
+	if p.ExecutableAt != 0 {
+		fmt.Printf("executable after: %s\n", time.Unix(p.ExecutableAt, 0).UTC().Format(time.RFC3339))
+	}
+	fmt.Printf("votes: %d approve, %d reject, %d total power\n", p.ApprovePower, p.RejectPower, p.TotalPower)
+	for _, v := range p.Votes {
+		vote := "reject"
+		if v.Approve {
+			vote = "approve"
+		}
+		fmt.Printf("  %s: %s (%d)\n", loom.UnmarshalAddressPB(v.Voter).String(), vote, v.Power)
+	}
+	if p.Error != "" {
+		fmt.Printf("error: %s\n", p.Error)
+	}
+}
+
+func readHexFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read input file")
+	}
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+}
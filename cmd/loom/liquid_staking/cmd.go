@@ -0,0 +1,312 @@
+package liquid_staking
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/liquid_staking"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const contractName = liquid_staking.ContractName
+
+func NewLiquidStakingCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquid-staking <command>",
+		Short: "Liquid staking of DPOSv3 delegations",
+	}
+	cmd.AddCommand(
+		stakeCmd(),
+		redeemCmd(),
+		withdrawCmd(),
+		compoundRewardsCmd(),
+		listRedemptionsCmd(),
+		exchangeRateCmd(),
+		balanceCmd(),
+		setEVMMirrorCmd(),
+		mirrorCmd("mirror-to-evm", "MirrorToEVM", "Move receipt tokens to the EVM mirror"),
+		mirrorCmd("mirror-from-evm", "MirrorFromEVM", "Move receipt tokens back from the EVM mirror"),
+	)
+	return cmd
+}
+
+const stakeCmdExample = `
+loom coin approve liquid-staking 100 -k priv.key
+loom liquid-staking stake 0x7262d4c97c7B93937E4810D289b7320e9dA82857 100 -k priv.key
+`
+
+func stakeCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "stake <validator address> <amount>",
+		Short: "Delegate LOOM to a validator in exchange for receipt tokens",
+		Long: "Delegate LOOM to a validator in exchange for receipt tokens, the LiquidStaking contract " +
+			"must be approved to transfer the LOOM beforehand.",
+		Example: stakeCmdExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validator, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			amount, err := cli.ParseAmount(args[1])
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &liquid_staking.StakeRequest{
+				ValidatorAddress: validator.MarshalPB(),
+				Amount:           &types.BigUInt{Value: *amount},
+			}
+			var resp liquid_staking.StakeResponse
+			if err := cli.CallContractWithFlags(&flags, contractName, "Stake", req, &resp); err != nil {
+				return err
+			}
+			fmt.Printf("Minted %s receipt tokens\n", resp.Minted.Value.String())
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func redeemCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "redeem <amount> [validator address]",
+		Short: "Burn receipt tokens and unbond the LOOM they're worth",
+		Long: "Burn receipt tokens and unbond the LOOM they're worth from the delegations held by the " +
+			"LiquidStaking contract, if a validator is specified only delegations to that validator are unbonded.",
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, err := cli.ParseAmount(args[0])
+			if err != nil {
+				return err
+			}
+			req := &liquid_staking.RedeemRequest{
+				Amount: &types.BigUInt{Value: *amount},
+			}
+			if len(args) > 1 {
+				validator, err := cli.ParseAddress(args[1], flags.ChainID)
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddress = validator.MarshalPB()
+			}
+
+			cmd.SilenceUsage = true
+
+			var resp liquid_staking.RedeemResponse
+			if err := cli.CallContractWithFlags(&flags, contractName, "Redeem", req, &resp); err != nil {
+				return err
+			}
+			fmt.Printf(
+				"Redemption %d will return up to %s LOOM after the next election\n",
+				resp.RedemptionId, resp.Amount.Value.String(),
+			)
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func withdrawCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "withdraw <redemption id>",
+		Short: "Withdraw the LOOM unbonded by a redemption",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid redemption id")
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &liquid_staking.WithdrawRequest{RedemptionId: id}
+			var resp liquid_staking.WithdrawResponse
+			if err := cli.CallContractWithFlags(&flags, contractName, "Withdraw", req, &resp); err != nil {
+				return err
+			}
+			fmt.Printf("Withdrew %s LOOM\n", resp.Amount.Value.String())
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func compoundRewardsCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "compound-rewards [validator address]",
+		Short: "Delegate previously claimed rewards and claim the rewards accrued since then",
+		Long: "Delegate the rewards claimed by the previous call to the given validator (or the first " +
+			"validator the LiquidStaking contract delegated to), and claim the rewards that accrued since then.",
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &liquid_staking.CompoundRewardsRequest{}
+			if len(args) > 0 {
+				validator, err := cli.ParseAddress(args[0], flags.ChainID)
+				if err != nil {
+					return err
+				}
+				req.ValidatorAddress = validator.MarshalPB()
+			}
+
+			cmd.SilenceUsage = true
+
+			var resp liquid_staking.CompoundRewardsResponse
+			err := cli.CallContractWithFlags(&flags, contractName, "CompoundRewards", req, &resp)
+			if err != nil {
+				return err
+			}
+			fmt.Printf(
+				"Delegated %s LOOM, claimed %s LOOM\n",
+				resp.Delegated.Value.String(), resp.Claimed.Value.String(),
+			)
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func listRedemptionsCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "list-redemptions [owner address]",
+		Short: "Show redemptions that haven't been withdrawn yet",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &liquid_staking.ListRedemptionsRequest{}
+			if len(args) > 0 {
+				owner, err := cli.ParseAddress(args[0], flags.ChainID)
+				if err != nil {
+					return err
+				}
+				req.Owner = owner.MarshalPB()
+			}
+
+			cmd.SilenceUsage = true
+
+			var resp liquid_staking.ListRedemptionsResponse
+			if err := cli.StaticCallContractWithFlags(&flags, contractName, "ListRedemptions", req, &resp); err != nil {
+				return err
+			}
+			marshaler := jsonpb.Marshaler{Indent: "  ", EmitDefaults: true}
+			output, err := marshaler.MarshalToString(&resp)
+			if err != nil {
+				return err
+			}
+			fmt.Println(output)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func exchangeRateCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "exchange-rate",
+		Short: "Show the amount of staked LOOM backing the receipt tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var resp liquid_staking.GetExchangeRateResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, contractName, "GetExchangeRate", &liquid_staking.GetExchangeRateRequest{}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("total staked: %s\n", resp.TotalStaked.Value.String())
+			fmt.Printf("total supply: %s\n", resp.TotalSupply.Value.String())
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func balanceCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "balance <owner address>",
+		Short: "Show the receipt token balance of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &liquid_staking.BalanceOfRequest{Owner: owner.MarshalPB()}
+			var resp liquid_staking.BalanceOfResponse
+			if err := cli.StaticCallContractWithFlags(&flags, contractName, "BalanceOf", req, &resp); err != nil {
+				return err
+			}
+			fmt.Println(resp.Balance.Value.String())
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func setEVMMirrorCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "set-evm-mirror <contract address>",
+		Short: "Set the ERC20 contract that mirrors the receipt token in the EVM (owner only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mirror, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &liquid_staking.SetEVMMirrorRequest{Contract: mirror.MarshalPB()}
+			return cli.CallContractWithFlags(&flags, contractName, "SetEVMMirror", req, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func mirrorCmd(use, method, short string) *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   use + " <amount>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			amount, err := cli.ParseAmount(args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &liquid_staking.MirrorRequest{Amount: &types.BigUInt{Value: *amount}}
+			return cli.CallContractWithFlags(&flags, contractName, method, req, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
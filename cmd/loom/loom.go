@@ -18,6 +18,8 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus/push"
 	"github.com/tendermint/tendermint/libs/db"
+	rpccore "github.com/tendermint/tendermint/rpc/core"
+	tmtypes "github.com/tendermint/tendermint/types"
 
 	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	"github.com/gogo/protobuf/proto"
@@ -47,6 +49,11 @@ import (
 	"github.com/loomnetwork/loomchain/cmd/loom/dbg"
 	deployer "github.com/loomnetwork/loomchain/cmd/loom/deployerwhitelist"
 	gatewaycmd "github.com/loomnetwork/loomchain/cmd/loom/gateway"
+	governancecmd "github.com/loomnetwork/loomchain/cmd/loom/governance"
+	liquidstakingcmd "github.com/loomnetwork/loomchain/cmd/loom/liquid_staking"
+	multisigcmd "github.com/loomnetwork/loomchain/cmd/loom/multisig"
+	sessionkeyscmd "github.com/loomnetwork/loomchain/cmd/loom/sessionkeys"
+	txfeescmd "github.com/loomnetwork/loomchain/cmd/loom/txfees"
 	userdeployer "github.com/loomnetwork/loomchain/cmd/loom/userdeployerwhitelist"
 	"github.com/loomnetwork/loomchain/config"
 	"github.com/loomnetwork/loomchain/core"
@@ -402,7 +409,26 @@ func newRunCommand() *cobra.Command {
 			}
 			appDB.Close()
 
-			app, err := loadApp(chainID, cfg, loader, backend, appHeight)
+			var denylist *throttle.Denylist
+			if cfg.Denylist.Enabled {
+				denylist, err = throttle.NewDenylist(cfg.DenylistPath())
+				if err != nil {
+					return err
+				}
+				if cfg.Denylist.ReloadInterval > 0 {
+					go denylist.AutoReload(time.Duration(cfg.Denylist.ReloadInterval)*time.Second, nil)
+				}
+			}
+
+			var nonceQueue *auth.NonceQueue
+			if cfg.NonceQueue.Enabled {
+				nonceQueue = auth.NewNonceQueue(cfg.NonceQueue, func(txBytes []byte) error {
+					_, err := rpccore.BroadcastTxAsync(tmtypes.Tx(txBytes))
+					return err
+				})
+			}
+
+			app, err := loadApp(chainID, cfg, loader, backend, appHeight, denylist, nonceQueue)
 			if err != nil {
 				return err
 			}
@@ -415,7 +441,9 @@ func newRunCommand() *cobra.Command {
 				return err
 			}
 
-			if err := initQueryService(app, chainID, cfg, loader, app.ReceiptHandlerProvider); err != nil {
+			if err := initQueryService(
+				app, chainID, cfg, loader, app.ReceiptHandlerProvider, denylist, nonceQueue,
+			); err != nil {
 				return err
 			}
 
@@ -683,12 +711,7 @@ func loadAppStore(cfg *config.Config, logger *loom.Logger, targetVersion int64)
 }
 
 func loadEventStore(cfg *config.Config, logger *loom.Logger) (store.EventStore, error) {
-	eventStoreCfg := cfg.EventStore
-	db, err := cdb.LoadDB(
-		eventStoreCfg.DBBackend, eventStoreCfg.DBName, cfg.RootPath(),
-		20, 4, //TODO do we want a separate cache config for eventstore?,
-		cfg.Metrics.Database,
-	)
+	db, err := loadEventStoreDB(cfg)
 	if err != nil {
 		return nil, err
 	}
@@ -697,6 +720,15 @@ func loadEventStore(cfg *config.Config, logger *loom.Logger) (store.EventStore,
 	return eventStore, nil
 }
 
+func loadEventStoreDB(cfg *config.Config) (db.DB, error) {
+	eventStoreCfg := cfg.EventStore
+	return cdb.LoadDB(
+		eventStoreCfg.DBBackend, eventStoreCfg.DBName, cfg.RootPath(),
+		20, 4, //TODO do we want a separate cache config for eventstore?,
+		cfg.Metrics.Database,
+	)
+}
+
 func loadEvmStore(cfg *config.Config, targetVersion int64) (*store.EvmStore, error) {
 	evmStoreCfg := cfg.EvmStore
 	db, err := cdb.LoadDB(
@@ -723,6 +755,8 @@ func loadApp(
 	loader plugin.Loader,
 	b backend.Backend,
 	appHeight int64,
+	denylist *throttle.Denylist,
+	nonceQueue *auth.NonceQueue,
 ) (*loomchain.Application, error) {
 	logger := log.Root
 
@@ -763,6 +797,18 @@ func loadApp(
 		if err != nil {
 			return nil, err
 		}
+	case events.DispatcherWebhook:
+		logger.Info("Using webhook event dispatcher")
+		eventsDB, err := loadEventStoreDB(cfg)
+		if err != nil {
+			return nil, err
+		}
+		webhookDispatcher, err := events.NewWebhookEventDispatcher(cfg.EventDispatcher.Webhook, eventsDB)
+		if err != nil {
+			return nil, err
+		}
+		webhookDispatcher.Start()
+		eventDispatcher = webhookDispatcher
 	case events.DispatcherLog:
 		logger.Info("Using simple log event dispatcher")
 		eventDispatcher = events.NewLogEventDispatcher()
@@ -793,7 +839,12 @@ func loadApp(
 		return nil, err
 	}
 
-	receiptHandlerProvider := receipts.NewReceiptHandlerProvider(eventHandler, cfg.EVMPersistentTxReceiptsMax, evmAuxStore)
+	if err := cfg.ReceiptRetention.Validate(); err != nil {
+		return nil, err
+	}
+	receiptHandlerProvider := receipts.NewReceiptHandlerProvider(
+		eventHandler, cfg.EVMPersistentTxReceiptsMax, evmAuxStore, cfg.ReceiptRetention,
+	).WithCallTracing(cfg.Web3.CallTracingEnabled)
 
 	var newABMFactory plugin.NewAccountBalanceManagerFactoryFunc
 	if evm.EVMEnabled && cfg.EVMAccountsEnabled {
@@ -948,15 +999,34 @@ func loadApp(
 		loomchain.RecoveryTxMiddleware,
 	}
 
+	// The nonce queue must see the raw tx bytes, so it must run before any middleware that unwraps
+	// or rejects txs.
+	if nonceQueue != nil {
+		txMiddleWare = append(txMiddleWare, nonceQueue.TxMiddleware())
+	}
+
 	postCommitMiddlewares := []loomchain.PostCommitMiddleware{
 		loomchain.LogPostCommitMiddleware,
 	}
 
+	if cfg.TxFees.ContractEnabled {
+		txMiddleWare = append(txMiddleWare, throttle.NewFeePayerMiddleware())
+	}
+
+	if err := cfg.Auth.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid auth config")
+	}
 	txMiddleWare = append(txMiddleWare, auth.NewChainConfigMiddleware(
 		cfg.Auth,
 		getContractStaticCtx("addressmapper", vmManager),
+		getContractStaticCtx("multisig", vmManager),
+		getContractStaticCtx("sessionkeys", vmManager),
 	))
 
+	if denylist != nil {
+		txMiddleWare = append(txMiddleWare, throttle.NewDenylistMiddleware(denylist))
+	}
+
 	createKarmaContractCtx := getContractCtx("karma", vmManager)
 
 	if cfg.Karma.Enabled {
@@ -972,6 +1042,12 @@ func loadApp(
 		txMiddleWare = append(txMiddleWare, throttle.NewTxLimiterMiddleware(cfg.TxLimiter))
 	}
 
+	// The block tx limiter is always installed since it affects consensus, it's activated by the
+	// BlockTxLimiterFeature flag & the limits stored in the ChainConfig contract.
+	txMiddleWare = append(txMiddleWare, throttle.NewBlockTxLimiterMiddleware(
+		getContractStaticCtx("chainconfig", vmManager), appStore,
+	))
+
 	if cfg.ContractTxLimiter.Enabled {
 		contextFactory := getContractCtx("user-deployer-whitelist", vmManager)
 		txMiddleWare = append(
@@ -1048,6 +1124,15 @@ func loadApp(
 	nonceTxHandler := auth.NewNonceHandler()
 	txMiddleWare = append(txMiddleWare, nonceTxHandler.TxMiddleware(appStore))
 
+	if cfg.TxFees.ContractEnabled {
+		txMiddleWare = append(txMiddleWare, throttle.NewTxFeeMiddleware(
+			appStore,
+			getContractStaticCtx("txfees", vmManager),
+			getContractCtx("coin", vmManager),
+			getContractCtx("ethcoin", vmManager),
+		))
+	}
+
 	if cfg.GoContractDeployerWhitelist.Enabled {
 		goDeployers, err := cfg.GoContractDeployerWhitelist.DeployerAddresses(chainID)
 		if err != nil {
@@ -1093,6 +1178,26 @@ func loadApp(
 		return m, nil
 	}
 
+	createGovernanceHandler := func(state loomchain.State) (loomchain.GovernanceHandler, error) {
+		if !cfg.Governance.ContractEnabled || !state.FeatureEnabled(features.GovernanceFeature, false) {
+			return nil, nil
+		}
+		pvm, err := vmManager.InitVM(vm.VMType_PLUGIN, state)
+		if err != nil {
+			return nil, err
+		}
+
+		h, err := plugin.NewGovernanceHandler(pvm.(*plugin.PluginVM))
+		if err != nil {
+			// Proposals won't be executed until the Governance contract is deployed
+			if err == plugin.ErrGovernanceContractNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return h, nil
+	}
+
 	if !cfg.Karma.Enabled && cfg.Karma.UpkeepEnabled {
 		logger.Info("Karma disabled, upkeep enabled ignored")
 	}
@@ -1130,6 +1235,7 @@ func loadApp(
 		CreateValidatorManager:      createValidatorsManager,
 		CreateChainConfigManager:    createChainConfigManager,
 		CreateContractUpkeepHandler: createContractUpkeepHandler,
+		CreateGovernanceHandler:     createGovernanceHandler,
 		EventStore:                  eventStore,
 		GetValidatorSet:             getValidatorSet,
 		EvmAuxStore:                 evmAuxStore,
@@ -1217,6 +1323,7 @@ func initBackend(cfg *config.Config, abciServerAddr string, fnRegistry fnConsens
 		HsmConfig:                cfg.HsmConfig,
 		FnConsensusReactorConfig: cfg.FnConsensus.Reactor,
 		MempoolWalEnabled:        cfg.MempoolWalEnabled,
+		NextPrivValidatorFile:    cfg.NextPrivValidatorFile,
 	}
 	return &backend.TendermintBackend{
 		RootPath:    path.Join(cfg.RootPath(), "chaindata"),
@@ -1228,7 +1335,8 @@ func initBackend(cfg *config.Config, abciServerAddr string, fnRegistry fnConsens
 
 func initQueryService(
 	app *loomchain.Application, chainID string, cfg *config.Config, loader plugin.Loader,
-	receiptHandlerProvider loomchain.ReceiptHandlerProvider,
+	receiptHandlerProvider loomchain.ReceiptHandlerProvider, denylist *throttle.Denylist,
+	nonceQueue *auth.NonceQueue,
 ) error {
 	// metrics
 	fieldKeys := []string{"method", "error"}
@@ -1284,6 +1392,7 @@ func initQueryService(
 		EvmAuxStore:            app.EvmAuxStore,
 		Web3Cfg:                cfg.Web3,
 		DPOSCfg:                cfg.DPOS,
+		NonceQueue:             nonceQueue,
 	}
 	bus := &rpc.QueryEventBus{
 		Subs:    *app.EventHandler.SubscriptionSet(),
@@ -1291,7 +1400,10 @@ func initQueryService(
 	}
 	var qsvc rpc.QueryService = rpc.NewInstrumentingMiddleWare(requestCount, requestLatency, qs)
 	logger := log.Root.With("module", "query-server")
-	err = rpc.RPCServer(qsvc, chainID, logger, bus, cfg.RPCBindAddress, cfg.UnsafeRPCEnabled, cfg.UnsafeRPCBindAddress)
+	err = rpc.RPCServer(
+		qsvc, chainID, logger, bus, cfg.RPCBindAddress, cfg.UnsafeRPCEnabled, cfg.UnsafeRPCBindAddress, denylist,
+		cfg.GraphQL,
+	)
 	if err != nil {
 		return err
 	}
@@ -1347,8 +1459,14 @@ func main() {
 		chaincfgcmd.NewChainCfgCommand(),
 		deployer.NewDeployCommand(),
 		userdeployer.NewUserDeployCommand(),
+		multisigcmd.NewMultisigCommand(),
+		sessionkeyscmd.NewSessionKeysCommand(),
+		txfeescmd.NewTxFeesCommand(),
+		governancecmd.NewGovernanceCommand(),
+		liquidstakingcmd.NewLiquidStakingCommand(),
 		dbg.NewDebugCommand(),
 		contractInfoCommand(),
+		newEventsCommand(),
 	)
 	err := RootCmd.Execute()
 	if err != nil {
@@ -0,0 +1,387 @@
+package multisig
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/auth"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/go-loom/client"
+	lp "github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/loomnetwork/loomchain/builtin/plugins/multisig"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/lib/client"
+	ttypes "github.com/tendermint/tendermint/types"
+	"golang.org/x/crypto/ed25519"
+)
+
+const contractName = "multisig"
+
+func NewMultisigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig <command>",
+		Short: "Multisig account CLI",
+	}
+	cmd.AddCommand(
+		createAccountCmd(),
+		getAccountCmd(),
+		accountAddressCmd(),
+		prepareTxCmd(),
+		signTxCmd(),
+		sendTxCmd(),
+	)
+	return cmd
+}
+
+// parseAccountKeys parses the threshold & base64 encoded ed25519 public keys of a multisig account.
+func parseAccountKeys(args []string) (uint32, [][]byte, error) {
+	threshold, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "invalid threshold")
+	}
+	var pubKeys [][]byte
+	for _, arg := range args[1:] {
+		pubKey, err := base64.StdEncoding.DecodeString(arg)
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "invalid public key %s", arg)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return uint32(threshold), pubKeys, nil
+}
+
+const createAccountCmdExample = `
+loom multisig create 2 <base64 pubkey 1> <base64 pubkey 2> <base64 pubkey 3> -k admin.key
+`
+
+func createAccountCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "create <threshold> <public key>...",
+		Short:   "Create an M-of-N multisig account",
+		Example: createAccountCmdExample,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			threshold, pubKeys, err := parseAccountKeys(args)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &multisig.CreateAccountRequest{
+				Threshold:  threshold,
+				PublicKeys: pubKeys,
+			}
+			var resp multisig.CreateAccountResponse
+			if err := cli.CallContractWithFlags(&flags, contractName, "CreateAccount", req, &resp); err != nil {
+				return err
+			}
+			fmt.Println(loom.UnmarshalAddressPB(resp.Address).String())
+			return nil
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const getAccountCmdExample = `
+loom multisig get 0x7262d4c97c7B93937E4810D289b7320e9dA82857
+`
+
+func getAccountCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "get <multisig address>",
+		Short:   "Show the key set & threshold of a multisig account",
+		Example: getAccountCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &multisig.GetAccountRequest{Address: addr.MarshalPB()}
+			var resp multisig.GetAccountResponse
+			if err := cli.StaticCallContractWithFlags(&flags, contractName, "GetAccount", req, &resp); err != nil {
+				return err
+			}
+			fmt.Printf("address: %s\n", loom.UnmarshalAddressPB(resp.Account.Address).String())
+			fmt.Printf("threshold: %d of %d\n", resp.Account.Threshold, len(resp.Account.PublicKeys))
+			for _, pubKey := range resp.Account.PublicKeys {
+				fmt.Printf("key: %s\n", base64.StdEncoding.EncodeToString(pubKey))
+			}
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func accountAddressCmd() *cobra.Command {
+	var chainID string
+	cmd := &cobra.Command{
+		Use:   "address <threshold> <public key>...",
+		Short: "Compute the address of a multisig account without creating it",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			threshold, pubKeys, err := parseAccountKeys(args)
+			if err != nil {
+				return err
+			}
+			sorted, err := multisig.SortPublicKeys(pubKeys)
+			if err != nil {
+				return err
+			}
+			addr := loom.Address{ChainID: chainID, Local: multisig.AccountAddress(threshold, sorted)}
+			fmt.Println(addr.String())
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&chainID, "chain", "default", "chain ID")
+	return cmd
+}
+
+func readTxFile(path string) (*multisig.MultiSignedTx, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read tx file")
+	}
+	var tx multisig.MultiSignedTx
+	if err := jsonpb.UnmarshalString(string(data), &tx); err != nil {
+		return nil, errors.Wrap(err, "failed to parse tx file")
+	}
+	return &tx, nil
+}
+
+func writeTxFile(path string, tx *multisig.MultiSignedTx) error {
+	marshaler := jsonpb.Marshaler{Indent: "  "}
+	data, err := marshaler.MarshalToString(tx)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(data), 0644)
+}
+
+const prepareTxCmdExample = `
+loom multisig prepare 0x7262d4c97c7B93937E4810D289b7320e9dA82857 dposV3 --method ChangeFee --input req.hex -o tx.json
+loom multisig prepare 0x7262d4c97c7B93937E4810D289b7320e9dA82857 0x5cecd1f7261e1f4c684e297be3edf03b825e01c4 --input calldata.hex -o tx.json
+`
+
+func prepareTxCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var method, inputFile, outFile string
+	var nonce uint64
+	cmd := &cobra.Command{
+		Use:   "prepare <multisig address> <contract name or address>",
+		Short: "Create an unsigned tx to be sent from a multisig account",
+		Long: "Create an unsigned tx to be sent from a multisig account. If --method is specified the tx " +
+			"will call the named method of a Go contract and the input file should contain the hex encoded " +
+			"protobuf request, otherwise the input file should contain hex encoded EVM call data.",
+		Example: prepareTxCmdExample,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			input, err := readHexFile(inputFile)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			rpcClient := client.NewDAppChainRPCClient(flags.ChainID, flags.URI+"/rpc", flags.URI+"/query")
+			var to loom.Address
+			if strings.HasPrefix(args[1], "0x") {
+				to, err = cli.ParseAddress(args[1], flags.ChainID)
+			} else {
+				to, err = rpcClient.Resolve(args[1])
+			}
+			if err != nil {
+				return errors.Wrap(err, "failed to resolve contract address")
+			}
+
+			callTx := &vm.CallTx{VmType: vm.VMType_EVM, Input: input}
+			if method != "" {
+				body, err := proto.Marshal(&lp.ContractMethodCall{Method: method, Args: input})
+				if err != nil {
+					return err
+				}
+				req, err := proto.Marshal(&lp.Request{
+					ContentType: lp.EncodingType_PROTOBUF3,
+					Accept:      lp.EncodingType_PROTOBUF3,
+					Body:        body,
+				})
+				if err != nil {
+					return err
+				}
+				callTx = &vm.CallTx{VmType: vm.VMType_PLUGIN, Input: req}
+			}
+
+			if !cmd.Flags().Changed("nonce") {
+				queryClient := rpcclient.NewJSONRPCClient(flags.URI + "/query")
+				if _, err := queryClient.Call("nonce", map[string]interface{}{"account": from.String()}, &nonce); err != nil {
+					return errors.Wrap(err, "failed to query nonce")
+				}
+				nonce++
+			}
+
+			nonceTx, err := wrapCallTx(from, to, callTx, nonce)
+			if err != nil {
+				return err
+			}
+			if err := writeTxFile(outFile, &multisig.MultiSignedTx{Inner: nonceTx}); err != nil {
+				return err
+			}
+			fmt.Printf("Unsigned tx with nonce %d written to %s\n", nonce, outFile)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().StringVar(&method, "method", "", "Go contract method to call")
+	cmd.Flags().StringVarP(&inputFile, "input", "i", "", "file with hex encoded input data")
+	cmd.Flags().StringVarP(&outFile, "output", "o", "multisig-tx.json", "file to write the unsigned tx to")
+	cmd.Flags().Uint64Var(&nonce, "nonce", 0, "tx nonce, queried from the node if not specified")
+	return cmd
+}
+
+func readHexFile(path string) ([]byte, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read input file")
+	}
+	return hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(data)), "0x"))
+}
+
+func wrapCallTx(from, to loom.Address, callTx *vm.CallTx, nonce uint64) ([]byte, error) {
+	callTxBytes, err := proto.Marshal(callTx)
+	if err != nil {
+		return nil, err
+	}
+	msgTxBytes, err := proto.Marshal(&vm.MessageTx{
+		From: from.MarshalPB(),
+		To:   to.MarshalPB(),
+		Data: callTxBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := proto.Marshal(&types.Transaction{
+		Id:   uint32(types.TxID_CALL),
+		Data: msgTxBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(&auth.NonceTx{
+		Inner:    txBytes,
+		Sequence: nonce,
+	})
+}
+
+const signTxCmdExample = `
+loom multisig sign tx.json -k member1.key
+`
+
+func signTxCmd() *cobra.Command {
+	var keyFile string
+	cmd := &cobra.Command{
+		Use:     "sign <tx file>",
+		Short:   "Add a member signature to a multisig tx, the private key never leaves this machine",
+		Example: signTxCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tx, err := readTxFile(args[0])
+			if err != nil {
+				return err
+			}
+			keyData, err := ioutil.ReadFile(keyFile)
+			if err != nil {
+				return errors.Wrap(err, "failed to read private key")
+			}
+			privKey, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(keyData)))
+			if err != nil || len(privKey) != ed25519.PrivateKeySize {
+				return errors.New("invalid ed25519 private key")
+			}
+			signer := auth.NewEd25519Signer(privKey)
+			for _, sig := range tx.Signatures {
+				if bytes.Equal(sig.PublicKey, signer.PublicKey()) {
+					return errors.New("tx has already been signed with this key")
+				}
+			}
+			tx.Signatures = append(tx.Signatures, &multisig.Signature{
+				PublicKey: signer.PublicKey(),
+				Signature: signer.Sign(tx.Inner),
+			})
+			if err := writeTxFile(args[0], tx); err != nil {
+				return err
+			}
+			fmt.Printf("Tx now has %d signatures\n", len(tx.Signatures))
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&keyFile, "key", "k", "", "private key file")
+	return cmd
+}
+
+func sendTxCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "send <tx file>",
+		Short: "Broadcast a multisig tx once it has been signed by enough account members",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tx, err := readTxFile(args[0])
+			if err != nil {
+				return err
+			}
+			txBytes, err := proto.Marshal(tx)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			rpcClient := rpcclient.NewJSONRPCClient(flags.URI + "/rpc")
+			cdc := amino.NewCodec()
+			ctypes.RegisterAmino(cdc)
+			rpcClient.SetCodec(cdc)
+			var result ctypes.ResultBroadcastTxCommit
+			params := map[string]interface{}{"tx": ttypes.Tx(txBytes)}
+			if _, err := rpcClient.Call("broadcast_tx_commit", params, &result); err != nil {
+				return errors.Wrap(err, "failed to broadcast tx")
+			}
+			if result.CheckTx.IsErr() {
+				return fmt.Errorf("CheckTx failed: %s", result.CheckTx.Log)
+			}
+			if result.DeliverTx.IsErr() {
+				return fmt.Errorf("DeliverTx failed: %s", result.DeliverTx.Log)
+			}
+			fmt.Printf("Tx %X committed in block %d\n", result.Hash, result.Height)
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/loomchain/builtin/plugins/pagination"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// pageFlags are the flags of list commands that can fetch the results one page at a time.
+type pageFlags struct {
+	StartKey string
+	Limit    uint32
+}
+
+func addPageFlags(fs *pflag.FlagSet, flags *pageFlags) {
+	fs.StringVar(&flags.StartKey, "start-key", "", "Key of the first item in the page, as returned in next_key by the previous page")
+	fs.Uint32Var(&flags.Limit, "limit", 0, "Maximum number of items in the page, only a single page is fetched if this or --start-key is set")
+}
+
+// Paged returns true if only a single page of results should be fetched.
+func (f *pageFlags) Paged() bool {
+	return f.StartKey != "" || f.Limit > 0
+}
+
+// PageLimit returns the limit to send in a request that's only paged if the limit is non-zero.
+func (f *pageFlags) PageLimit() uint32 {
+	if f.Limit == 0 {
+		return pagination.DefaultPageSize
+	}
+	return f.Limit
+}
+
+func (f *pageFlags) StartKeyBytes() ([]byte, error) {
+	if f.StartKey == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(f.StartKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid start key")
+	}
+	return key, nil
+}
+
+// Prints the start key of the page that follows a page of results printed in a table.
+func printNextPageKey(nextKey []byte) {
+	if len(nextKey) > 0 {
+		fmt.Printf("\nnext key: %s\n", base64.StdEncoding.EncodeToString(nextKey))
+	}
+}
+
+// Fetches a single page of results from a contract and prints it as JSON.
+func printPage(flags *cli.ContractCallFlags, contractName, method string, req, resp proto.Message) error {
+	if err := cli.StaticCallContractWithFlags(flags, contractName, method, req, resp); err != nil {
+		return err
+	}
+	out, err := formatJSON(resp)
+	if err != nil {
+		return err
+	}
+	fmt.Println(out)
+	return nil
+}
@@ -0,0 +1,213 @@
+package sessionkeys
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/go-loom/client"
+	sk "github.com/loomnetwork/loomchain/builtin/plugins/session_keys"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const contractName = "sessionkeys"
+
+type scopeInfo struct {
+	Contract string
+	Methods  []string `json:",omitempty"`
+}
+
+type sessionKeyInfo struct {
+	Address   string
+	Owner     string
+	ExpiresAt uint64
+	Scopes    []scopeInfo
+}
+
+func NewSessionKeysCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "session-keys <command>",
+		Short: "Session keys CLI",
+	}
+	cmd.AddCommand(
+		authorizeSessionKeyCmd(),
+		revokeSessionKeyCmd(),
+		getSessionKeyCmd(),
+		listSessionKeysCmd(),
+	)
+	return cmd
+}
+
+// parseScope parses a scope in the form <contract name or address>[:method1,method2,...]
+func parseScope(scope string, flags *cli.ContractCallFlags) (*sk.Scope, error) {
+	parts := strings.SplitN(scope, ":", 2)
+	var addr loom.Address
+	var err error
+	if strings.HasPrefix(parts[0], "0x") {
+		addr, err = cli.ParseAddress(parts[0], flags.ChainID)
+	} else {
+		rpcClient := client.NewDAppChainRPCClient(flags.ChainID, flags.URI+"/rpc", flags.URI+"/query")
+		addr, err = rpcClient.Resolve(parts[0])
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve contract %s", parts[0])
+	}
+	var methods []string
+	if len(parts) > 1 && parts[1] != "" {
+		methods = strings.Split(parts[1], ",")
+	}
+	return &sk.Scope{Contract: addr.MarshalPB(), Methods: methods}, nil
+}
+
+func printSessionKeys(keys ...*sk.SessionKey) error {
+	infos := []sessionKeyInfo{}
+	for _, key := range keys {
+		info := sessionKeyInfo{
+			Address:   loom.UnmarshalAddressPB(key.Address).String(),
+			Owner:     loom.UnmarshalAddressPB(key.Owner).String(),
+			ExpiresAt: key.ExpiresAt,
+		}
+		for _, scope := range key.Scopes {
+			info.Scopes = append(info.Scopes, scopeInfo{
+				Contract: loom.UnmarshalAddressPB(scope.Contract).String(),
+				Methods:  scope.Methods,
+			})
+		}
+		infos = append(infos, info)
+	}
+	output, err := json.MarshalIndent(infos, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
+
+const authorizeSessionKeyCmdExample = `
+loom session-keys authorize 0x7262d4c97c7B93937E4810D289b7320e9dA82857 --duration 1000 \
+  --scope 0x5cecd1f7261e1f4c684e297be3edf03b825e01c4:0xa9059cbb --scope coin:Transfer,Approve -k owner.key
+`
+
+func authorizeSessionKeyCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var duration uint64
+	var scopes []string
+	cmd := &cobra.Command{
+		Use:     "authorize <session key address>",
+		Short:   "Allow a session key to sign txs on behalf of the caller for a limited time",
+		Example: authorizeSessionKeyCmdExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+			if len(scopes) == 0 {
+				return errors.New("at least one --scope must be specified")
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &sk.AuthorizeSessionKeyRequest{
+				SessionKey: addr.MarshalPB(),
+				Duration:   duration,
+			}
+			for _, s := range scopes {
+				scope, err := parseScope(s, &flags)
+				if err != nil {
+					return err
+				}
+				req.Scopes = append(req.Scopes, scope)
+			}
+			return cli.CallContractWithFlags(&flags, contractName, "AuthorizeSessionKey", req, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().Uint64Var(&duration, "duration", 1000, "number of blocks the session key will be valid for")
+	cmd.Flags().StringArrayVar(
+		&scopes, "scope", nil,
+		"contract the session key can call, optionally followed by a comma separated list of allowed methods",
+	)
+	return cmd
+}
+
+func revokeSessionKeyCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "revoke <session key address>",
+		Short: "Revoke a session key previously authorized by the caller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := cli.ParseAddress(args[0], flags.ChainID)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &sk.RevokeSessionKeyRequest{SessionKey: addr.MarshalPB()}
+			return cli.CallContractWithFlags(&flags, contractName, "RevokeSessionKey", req, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func getSessionKeyCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "get <owner address> <session key address>",
+		Short: "Show the expiry & scopes of a session key",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := cli.ResolveAccountAddress(args[0], &flags)
+			if err != nil {
+				return err
+			}
+			addr, err := cli.ParseAddress(args[1], flags.ChainID)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &sk.GetSessionKeyRequest{Owner: owner.MarshalPB(), SessionKey: addr.MarshalPB()}
+			var resp sk.GetSessionKeyResponse
+			if err := cli.StaticCallContractWithFlags(&flags, contractName, "GetSessionKey", req, &resp); err != nil {
+				return err
+			}
+			return printSessionKeys(resp.SessionKey)
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func listSessionKeysCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "list <owner address>",
+		Short: "List the session keys authorized by an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := cli.ResolveAccountAddress(args[0], &flags)
+			if err != nil {
+				return err
+			}
+
+			cmd.SilenceUsage = true
+
+			req := &sk.ListSessionKeysRequest{Owner: owner.MarshalPB()}
+			var resp sk.ListSessionKeysResponse
+			if err := cli.StaticCallContractWithFlags(&flags, contractName, "ListSessionKeys", req, &resp); err != nil {
+				return err
+			}
+			return printSessionKeys(resp.SessionKeys...)
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
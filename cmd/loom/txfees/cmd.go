@@ -0,0 +1,147 @@
+package txfees
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/cli"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/builtin/plugins/tx_fees"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const contractName = "txfees"
+
+type feeConfigInfo struct {
+	Denom    string
+	TxFee    string
+	GasPrice string
+	Treasury string `json:",omitempty"`
+}
+
+func NewTxFeesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tx-fees <command>",
+		Short: "Tx fees CLI",
+	}
+	cmd.AddCommand(
+		getFeeConfigCmd(),
+		setFeeConfigCmd(),
+	)
+	return cmd
+}
+
+func formatAmount(amount *types.BigUInt) string {
+	if amount == nil || amount.Value.Int == nil {
+		return "0"
+	}
+	return amount.Value.String()
+}
+
+// parseAmount parses an amount specified in the smallest unit of the fee denomination.
+func parseAmount(s string) (*types.BigUInt, error) {
+	amount, ok := new(big.Int).SetString(s, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount %s", s)
+	}
+	return &types.BigUInt{Value: *loom.NewBigUInt(amount)}, nil
+}
+
+func getFeeConfigCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:   "get-config",
+		Short: "Show the current tx fee schedule",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			var resp tx_fees.GetFeeConfigResponse
+			err := cli.StaticCallContractWithFlags(
+				&flags, contractName, "GetFeeConfig", &tx_fees.GetFeeConfigRequest{}, &resp,
+			)
+			if err != nil {
+				return err
+			}
+			cfg := resp.Config
+			if cfg == nil {
+				cfg = &tx_fees.FeeConfig{}
+			}
+			info := feeConfigInfo{
+				Denom:    strings.ToLower(cfg.Denom.String()),
+				TxFee:    formatAmount(cfg.TxFee),
+				GasPrice: formatAmount(cfg.GasPrice),
+			}
+			if cfg.Treasury != nil {
+				info.Treasury = loom.UnmarshalAddressPB(cfg.Treasury).String()
+			}
+			output, err := json.MarshalIndent(info, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	cli.AddContractStaticCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+const setFeeConfigCmdExample = `
+loom tx-fees set-config --denom coin --tx-fee 1000000000000000 --gas-price 1000 -k owner.key
+loom tx-fees set-config --denom ethcoin --tx-fee 1000000000000 --treasury 0x7262d4c97c7B93937E4810D289b7320e9dA82857 -k owner.key
+`
+
+func setFeeConfigCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	var denom, txFee, gasPrice, treasury string
+	cmd := &cobra.Command{
+		Use:     "set-config",
+		Short:   "Change the tx fee schedule (only the contract owner can do this)",
+		Example: setFeeConfigCmdExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			d, found := tx_fees.Denom_value[strings.ToUpper(denom)]
+			if !found {
+				return errors.Errorf("unsupported denomination %s", denom)
+			}
+			txFeeAmount, err := parseAmount(txFee)
+			if err != nil {
+				return errors.Wrap(err, "invalid tx fee")
+			}
+			gasPriceAmount, err := parseAmount(gasPrice)
+			if err != nil {
+				return errors.Wrap(err, "invalid gas price")
+			}
+
+			req := &tx_fees.SetFeeConfigRequest{
+				Config: &tx_fees.FeeConfig{
+					Denom:    tx_fees.Denom(d),
+					TxFee:    txFeeAmount,
+					GasPrice: gasPriceAmount,
+				},
+			}
+			if treasury != "" {
+				addr, err := cli.ParseAddress(treasury, flags.ChainID)
+				if err != nil {
+					return errors.Wrap(err, "invalid treasury address")
+				}
+				req.Config.Treasury = addr.MarshalPB()
+			}
+
+			cmd.SilenceUsage = true
+
+			return cli.CallContractWithFlags(&flags, contractName, "SetFeeConfig", req, nil)
+		},
+	}
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	cmd.Flags().StringVar(&denom, "denom", "coin", "fee denomination, either coin or ethcoin")
+	cmd.Flags().StringVar(&txFee, "tx-fee", "0", "flat fee charged per tx, in the smallest unit of the denomination")
+	cmd.Flags().StringVar(&gasPrice, "gas-price", "0", "fee charged per unit of EVM gas, in the smallest unit of the denomination")
+	cmd.Flags().StringVar(&treasury, "treasury", "", "address that receives the fees, required for ethcoin fees")
+	return cmd
+}
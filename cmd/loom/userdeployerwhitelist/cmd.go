@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/loomnetwork/go-loom"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/loomnetwork/go-loom/cli"
 	"github.com/loomnetwork/go-loom/client"
 	"github.com/loomnetwork/go-loom/types"
+	udw "github.com/loomnetwork/loomchain/builtin/plugins/user_deployer_whitelist"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
@@ -37,6 +39,7 @@ func NewUserDeployCommand() *cobra.Command {
 		getDeployedContractsCmd(),
 		getTierInfoCmd(),
 		setTierInfoCmd(),
+		getTierLimitsCmd(),
 	)
 	return cmd
 }
@@ -250,12 +253,14 @@ func getTierInfoCmd() *cobra.Command {
 
 const setTierCmdExample = `
 loom dev set-tier 0 --fee 100 --name Tier1 --block-range 10 --max-txs 2 
+loom dev set-tier 0 --method-limit 0xa9059cbb:5 --method-limit 0x60fe47b1:1 --gas-budget 1000000
 `
 
 func setTierInfoCmd() *cobra.Command {
 	var flags cli.ContractCallFlags
 	var inputFee, tierName string
-	var blockRange, maxTxs uint64
+	var blockRange, maxTxs, gasBudget uint64
+	var methodLimits []string
 	cmd := &cobra.Command{
 		Use:     "set-tier <tier> [options]",
 		Short:   "Set tier details",
@@ -310,20 +315,115 @@ func setTierInfoCmd() *cobra.Command {
 			if maxTxs == 0 {
 				return fmt.Errorf("max-txs must be greater than zero")
 			}
-			req := &udwtypes.SetTierInfoRequest{
-				Fee:        fee,
-				Name:       tierName,
-				TierID:     udwtypes.TierID(tierID),
-				BlockRange: blockRange,
-				MaxTxs:     maxTxs,
+			if !cmd.Flags().Changed("method-limit") && !cmd.Flags().Changed("gas-budget") {
+				req := &udwtypes.SetTierInfoRequest{
+					Fee:        fee,
+					Name:       tierName,
+					TierID:     udwtypes.TierID(tierID),
+					BlockRange: blockRange,
+					MaxTxs:     maxTxs,
+				}
+				return cli.CallContractWithFlags(&flags, dwContractName, "SetTierInfo", req, nil)
 			}
-			return cli.CallContractWithFlags(&flags, dwContractName, "SetTierInfo", req, nil)
+
+			var getTierLimitsResp udw.GetTierLimitsResponse
+			_, err = udwContract.StaticCall(
+				"GetTierLimits", &udw.GetTierLimitsRequest{TierId: int32(tierID)}, udwAddress, &getTierLimitsResp,
+			)
+			if err != nil {
+				return errors.Wrap(err, "failed to call GetTierLimits")
+			}
+			// the tier info & limits are set in a single tx so the tier can't end up with only
+			// some of the changes applied
+			req := &udw.SetTierRequest{
+				TierId:       int32(tierID),
+				Name:         tierName,
+				Fee:          fee,
+				BlockRange:   blockRange,
+				MaxTxs:       maxTxs,
+				MethodLimits: getTierLimitsResp.Limits.MethodLimits,
+				GasBudget:    getTierLimitsResp.Limits.GasBudget,
+			}
+			if cmd.Flags().Changed("method-limit") {
+				req.MethodLimits, err = parseMethodLimits(methodLimits)
+				if err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed("gas-budget") {
+				req.GasBudget = gasBudget
+			}
+			return cli.CallContractWithFlags(&flags, dwContractName, "SetTier", req, nil)
 		}}
 
 	cmd.Flags().StringVarP(&inputFee, "fee", "f", "", "Tier fee")
 	cmd.Flags().StringVarP(&tierName, "name", "n", "", "Tier name")
 	cmd.Flags().Uint64Var(&blockRange, "block-range", 0, "Block range")
 	cmd.Flags().Uint64Var(&maxTxs, "max-txs", 0, "Max txs per block range")
+	cmd.Flags().StringSliceVar(
+		&methodLimits, "method-limit", nil,
+		"Max txs per block range for an EVM contract method, in the form <selector>:<max txs>, "+
+			"replaces all existing method limits",
+	)
+	cmd.Flags().Uint64Var(&gasBudget, "gas-budget", 0, "Max gas per block range, zero means unlimited")
+	cli.AddContractCallFlags(cmd.Flags(), &flags)
+	return cmd
+}
+
+func parseMethodLimits(values []string) ([]*udw.MethodTxLimit, error) {
+	limits := make([]*udw.MethodTxLimit, 0, len(values))
+	for _, value := range values {
+		parts := strings.Split(value, ":")
+		if len(parts) != 2 || len(parts[0]) == 0 {
+			return nil, fmt.Errorf("invalid method limit %s", value)
+		}
+		maxTxs, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "max txs %s does not parse as integer", parts[1])
+		}
+		if maxTxs == 0 {
+			return nil, fmt.Errorf("max txs for method %s must be greater than zero", parts[0])
+		}
+		limits = append(limits, &udw.MethodTxLimit{
+			Method: parts[0],
+			MaxTxs: maxTxs,
+		})
+	}
+	return limits, nil
+}
+
+const getTierLimitsCmdExample = `
+loom dev get-tier-limits 0
+`
+
+func getTierLimitsCmd() *cobra.Command {
+	var flags cli.ContractCallFlags
+	cmd := &cobra.Command{
+		Use:     "get-tier-limits <tier>",
+		Short:   "Show per-method tx limits & gas budget of a tier",
+		Example: getTierLimitsCmdExample,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tierID, err := strconv.ParseInt(args[0], 10, 32)
+			if err != nil {
+				return errors.Wrapf(err, "tierID %s does not parse as integer", args[0])
+			}
+			req := &udw.GetTierLimitsRequest{
+				TierId: int32(tierID),
+			}
+			var resp udw.GetTierLimitsResponse
+			err = cli.StaticCallContractWithFlags(&flags, dwContractName, "GetTierLimits", req, &resp)
+			if err != nil {
+				return err
+			}
+			output, err := json.MarshalIndent(resp.Limits, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
 	cli.AddContractCallFlags(cmd.Flags(), &flags)
 	return cmd
 }
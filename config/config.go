@@ -18,6 +18,7 @@ import (
 	"github.com/loomnetwork/loomchain/evm"
 	hsmpv "github.com/loomnetwork/loomchain/privval/hsm"
 	receipts "github.com/loomnetwork/loomchain/receipts/handler"
+	receiptsleveldb "github.com/loomnetwork/loomchain/receipts/leveldb"
 	registry "github.com/loomnetwork/loomchain/registry/factory"
 	"github.com/loomnetwork/loomchain/rpc/eth"
 	"github.com/loomnetwork/loomchain/store"
@@ -41,6 +42,9 @@ type Config struct {
 	RegistryVersion            int32
 	ReceiptsVersion            int32
 	EVMPersistentTxReceiptsMax uint64
+	// Per-contract policies that determine which receipts are kept after they're evicted from the
+	// EVMPersistentTxReceiptsMax most recent receipts.
+	ReceiptRetention *receiptsleveldb.RetentionConfig
 
 	// When this setting is enabled Loom EVM accounts are hooked up to the builtin ethcoin Go contract,
 	// which makes it possible to use the payable/transfer features of the EVM to transfer ETH in
@@ -75,6 +79,7 @@ type Config struct {
 	GoContractDeployerWhitelist *throttle.GoContractDeployerWhitelistConfig
 	TxLimiter                   *throttle.TxLimiterConfig
 	ContractTxLimiter           *throttle.ContractTxLimiterConfig
+	Denylist                    *throttle.DenylistConfig
 	// Logging
 	LogDestination          string
 	ContractLogLevel        string
@@ -94,6 +99,21 @@ type Config struct {
 	// UserDeployerWhitelist
 	UserDeployerWhitelist *UserDeployerWhitelistConfig
 
+	// Multisig accounts
+	Multisig *MultisigConfig
+
+	// Session keys
+	SessionKeys *SessionKeysConfig
+
+	// Tx fees
+	TxFees *TxFeesConfig
+
+	// On-chain governance
+	Governance *GovernanceConfig
+
+	// Liquid staking of DPOSv3 delegations
+	LiquidStaking *LiquidStakingConfig
+
 	// Transfer gateway
 	TransferGateway         *TransferGatewayConfig
 	LoomCoinTransferGateway *TransferGatewayConfig
@@ -115,6 +135,9 @@ type Config struct {
 	ContractLoaders []string
 	//Hsm
 	HsmConfig *hsmpv.HsmConfig
+	// Path to the priv validator file of the key the node's consensus key is being rotated to, the
+	// node switches to this key once it replaces the current one in the validator set.
+	NextPrivValidatorFile string
 
 	// Oracle serializable
 	// todo Cannot be read in from file due to nested pointers to structs.
@@ -140,6 +163,8 @@ type Config struct {
 
 	Auth *auth.Config
 
+	NonceQueue *auth.NonceQueueConfig
+
 	EvmStore *evm.EvmStoreConfig
 	// Allow deployment of named EVM contracts (should only be used in tests!)
 	AllowNamedEvmContracts bool
@@ -149,9 +174,10 @@ type Config struct {
 	// Set to true to disable minimum required build number check on node startup
 	SkipMinBuildCheck bool
 
-	Web3 *eth.Web3Config
-	Geth *GethConfig
-	DPOS *DPOSConfig
+	Web3    *eth.Web3Config
+	Geth    *GethConfig
+	DPOS    *DPOSConfig
+	GraphQL *GraphQLConfig
 }
 
 type GethConfig struct {
@@ -211,6 +237,23 @@ func (dposCfg *DPOSConfig) BootstrapNodesList() map[string]bool {
 	return bootstrapNodesList
 }
 
+type GraphQLConfig struct {
+	// Serve GraphQL queries on the /graphql endpoint
+	Enabled bool
+	// Maximum depth of the fields selected by a query
+	MaxDepth int
+	// Maximum number of fields of a query that are resolved concurrently
+	MaxParallelism int
+}
+
+func DefaultGraphQLConfig() *GraphQLConfig {
+	return &GraphQLConfig{
+		Enabled:        false,
+		MaxDepth:       10,
+		MaxParallelism: 10,
+	}
+}
+
 type DBBackendConfig struct {
 	CacheSizeMegs   int
 	WriteBufferMegs int
@@ -258,6 +301,26 @@ type UserDeployerWhitelistConfig struct {
 	ContractEnabled bool
 }
 
+type MultisigConfig struct {
+	ContractEnabled bool
+}
+
+type SessionKeysConfig struct {
+	ContractEnabled bool
+}
+
+type TxFeesConfig struct {
+	ContractEnabled bool
+}
+
+type GovernanceConfig struct {
+	ContractEnabled bool
+}
+
+type LiquidStakingConfig struct {
+	ContractEnabled bool
+}
+
 func DefaultDBBackendConfig() *DBBackendConfig {
 	return &DBBackendConfig{
 		CacheSizeMegs:   1042, //1 Gigabyte
@@ -317,6 +380,36 @@ func DefaultUserDeployerWhitelistConfig() *UserDeployerWhitelistConfig {
 	}
 }
 
+func DefaultMultisigConfig() *MultisigConfig {
+	return &MultisigConfig{
+		ContractEnabled: false,
+	}
+}
+
+func DefaultSessionKeysConfig() *SessionKeysConfig {
+	return &SessionKeysConfig{
+		ContractEnabled: false,
+	}
+}
+
+func DefaultTxFeesConfig() *TxFeesConfig {
+	return &TxFeesConfig{
+		ContractEnabled: false,
+	}
+}
+
+func DefaultGovernanceConfig() *GovernanceConfig {
+	return &GovernanceConfig{
+		ContractEnabled: false,
+	}
+}
+
+func DefaultLiquidStakingConfig() *LiquidStakingConfig {
+	return &LiquidStakingConfig{
+		ContractEnabled: false,
+	}
+}
+
 //Structure for LOOM ENV
 
 type Env struct {
@@ -450,6 +543,7 @@ func DefaultConfig() *Config {
 	cfg.HsmConfig = hsmpv.DefaultConfig()
 	cfg.TxLimiter = throttle.DefaultTxLimiterConfig()
 	cfg.ContractTxLimiter = throttle.DefaultContractTxLimiterConfig()
+	cfg.Denylist = throttle.DefaultDenylistConfig()
 	cfg.GoContractDeployerWhitelist = throttle.DefaultGoContractDeployerWhitelistConfig()
 	cfg.DPOSv2OracleConfig = DefaultDPOS2OracleConfig()
 	cfg.CachingStoreConfig = store.DefaultCachingStoreConfig()
@@ -460,18 +554,26 @@ func DefaultConfig() *Config {
 	cfg.ChainConfig = DefaultChainConfigConfig(cfg.RPCProxyPort)
 	cfg.DeployerWhitelist = DefaultDeployerWhitelistConfig()
 	cfg.UserDeployerWhitelist = DefaultUserDeployerWhitelistConfig()
+	cfg.Multisig = DefaultMultisigConfig()
+	cfg.SessionKeys = DefaultSessionKeysConfig()
+	cfg.TxFees = DefaultTxFeesConfig()
+	cfg.Governance = DefaultGovernanceConfig()
+	cfg.LiquidStaking = DefaultLiquidStakingConfig()
 	cfg.DBBackendConfig = DefaultDBBackendConfig()
 	cfg.PrometheusPushGateway = DefaultPrometheusPushGatewayConfig()
 	cfg.EventDispatcher = events.DefaultEventDispatcherConfig()
 	cfg.EventStore = events.DefaultEventStoreConfig()
 	cfg.EvmStore = evm.DefaultEvmStoreConfig()
+	cfg.ReceiptRetention = receiptsleveldb.DefaultRetentionConfig()
 	cfg.Web3 = eth.DefaultWeb3Config()
 	cfg.Geth = DefaultGethConfig()
 	cfg.DPOS = DefaultDPOSConfig()
+	cfg.GraphQL = DefaultGraphQLConfig()
 
 	cfg.FnConsensus = DefaultFnConsensusConfig()
 
 	cfg.Auth = auth.DefaultConfig()
+	cfg.NonceQueue = auth.DefaultNonceQueueConfig()
 	return cfg
 }
 
@@ -497,9 +599,12 @@ func (c *Config) Clone() *Config {
 	clone.HsmConfig = c.HsmConfig.Clone()
 	clone.TxLimiter = c.TxLimiter.Clone()
 	clone.ContractTxLimiter = c.ContractTxLimiter.Clone()
+	clone.Denylist = c.Denylist.Clone()
 	clone.EventStore = c.EventStore.Clone()
 	clone.EventDispatcher = c.EventDispatcher.Clone()
 	clone.Auth = c.Auth.Clone()
+	clone.NonceQueue = c.NonceQueue.Clone()
+	clone.ReceiptRetention = c.ReceiptRetention.Clone()
 	return &clone
 }
 
@@ -523,6 +628,13 @@ func (c *Config) PluginsPath() string {
 	return c.fullPath(c.PluginsDir)
 }
 
+func (c *Config) DenylistPath() string {
+	if filepath.IsAbs(c.Denylist.File) {
+		return c.Denylist.File
+	}
+	return c.fullPath(c.Denylist.File)
+}
+
 func (c *Config) WriteToFile(filename string) error {
 	var buf bytes.Buffer
 	cfgTemplate, err := parseCfgTemplate()
@@ -564,6 +676,21 @@ EVMAccountsEnabled: {{ .EVMAccountsEnabled }}
 DPOSVersion: {{ .DPOSVersion }}
 CreateEmptyBlocks: {{ .CreateEmptyBlocks }}
 MempoolWalEnabled: {{ .MempoolWalEnabled }}
+{{- if .ReceiptRetention }}
+ReceiptRetention:
+  # If true receipts evicted from the receipts DB are archived until they're exported with
+  # "loom db receipts export", otherwise they're deleted.
+  ArchiveEvicted: {{ .ReceiptRetention.ArchiveEvicted }}
+  {{- if .ReceiptRetention.Policies }}
+  # Retention policies keyed by contract address, available modes: forever | max-age | failed-only
+  Policies:
+    {{- range $addr, $p := .ReceiptRetention.Policies }}
+    "{{ $addr }}":
+      Mode: "{{ $p.Mode }}"
+      MaxAgeBlocks: {{ $p.MaxAgeBlocks }}
+    {{- end }}
+  {{- end }}
+{{- end }}
 #
 # Network
 #
@@ -601,6 +728,10 @@ ContractTxLimiter:
   Enabled: {{ .ContractTxLimiter.Enabled }}
   ContractDataRefreshInterval: {{ .ContractTxLimiter.ContractDataRefreshInterval }}
   TierDataRefreshInterval: {{ .ContractTxLimiter.TierDataRefreshInterval }}
+Denylist:
+  Enabled: {{ .Denylist.Enabled }}
+  File: "{{ .Denylist.File }}"
+  ReloadInterval: {{ .Denylist.ReloadInterval }}
 
 #
 # ContractLoader
@@ -653,6 +784,36 @@ DeployerWhitelist:
 #
 UserDeployerWhitelist:
   ContractEnabled: {{ .UserDeployerWhitelist.ContractEnabled }}
+
+#
+# Multisig
+#
+Multisig:
+  ContractEnabled: {{ .Multisig.ContractEnabled }}
+
+#
+# SessionKeys
+#
+SessionKeys:
+  ContractEnabled: {{ .SessionKeys.ContractEnabled }}
+
+#
+# TxFees
+#
+TxFees:
+  ContractEnabled: {{ .TxFees.ContractEnabled }}
+
+#
+# Governance
+#
+Governance:
+  ContractEnabled: {{ .Governance.ContractEnabled }}
+
+#
+# LiquidStaking
+#
+LiquidStaking:
+  ContractEnabled: {{ .LiquidStaking.ContractEnabled }}
 #
 # SampleGoContractEnabled
 #
@@ -730,6 +891,10 @@ HsmConfig:
   # key domain
   HsmSignKeyDomain: {{ .HsmConfig.HsmSignKeyDomain }}
 
+# Priv validator file of the key the node's consensus key is being rotated to (see the dpos3
+# rotate-validator-key command), leave empty when no rotation is in progress.
+NextPrivValidatorFile: "{{ .NextPrivValidatorFile }}"
+
 #
 # App store
 #
@@ -782,6 +947,9 @@ EvmStore:
 Web3:
   # Specifies the maximum number of blocks eth_getLogs will query per request
   GetLogsMaxBlockRange: {{.Web3.GetLogsMaxBlockRange}}
+  # Record the internal calls made by each EVM tx, so they can be queried via trace_transaction
+  # & trace_block (this slows down EVM tx execution).
+  CallTracingEnabled: {{.Web3.CallTracingEnabled}}
 {{end}}
 
 # 
@@ -809,13 +977,39 @@ FnConsensus:
 # EventDispatcher
 #
 EventDispatcher:
-  # Available dispatcher: "db_indexer" | "log" | "redis"
+  # Available dispatcher: "db_indexer" | "log" | "redis" | "webhook"
   Dispatcher: {{.EventDispatcher.Dispatcher}}
   {{if eq .EventDispatcher.Dispatcher "redis"}}
   # Redis will be use when Dispatcher is "redis"
   Redis:
     URI: "{{.EventDispatcher.Redis.URI}}"
   {{end}}
+  {{- if eq .EventDispatcher.Dispatcher "webhook"}}
+  # Webhook will be used when Dispatcher is "webhook", failed deliveries are retried with an
+  # exponential backoff, and are moved to a dead-letter list after MaxAttempts. Failed deliveries
+  # can be inspected & replayed via the "loom events webhook" command.
+  Webhook:
+    # If set payloads are signed with HMAC-SHA256, the signature is in the X-Loom-Signature header.
+    Secret: "{{.EventDispatcher.Webhook.Secret}}"
+    MaxAttempts: {{.EventDispatcher.Webhook.MaxAttempts}}
+    RetryIntervalSecs: {{.EventDispatcher.Webhook.RetryIntervalSecs}}
+    TimeoutSecs: {{.EventDispatcher.Webhook.TimeoutSecs}}
+    PollIntervalMillis: {{.EventDispatcher.Webhook.PollIntervalMillis}}
+    # Events are sent to every route they match, a route without any Contracts or Topics matches
+    # all events.
+    Routes:
+    {{- range .EventDispatcher.Webhook.Routes}}
+      - URL: "{{.URL}}"
+        Contracts:
+        {{- range .Contracts}}
+          - "{{.}}"
+        {{- end}}
+        Topics:
+        {{- range .Topics}}
+          - "{{.}}"
+        {{- end}}
+    {{- end}}
+  {{- end}}
 #
 # Tx signing & accounts
 #
@@ -825,7 +1019,19 @@ Auth:
     {{$k}}:
       TxType: "{{.TxType -}}"
       AccountType: {{.AccountType -}}
+      {{- if .SignatureTypes}}
+      SignatureTypes:
+        {{- range .SignatureTypes}}
+        - "{{.}}"
+        {{- end}}
+      {{- end}}
     {{- end}}
+NonceQueue:
+  Enabled: {{ .NonceQueue.Enabled }}
+  MaxTxs: {{ .NonceQueue.MaxTxs }}
+  MaxTxsPerAccount: {{ .NonceQueue.MaxTxsPerAccount }}
+  MaxNonceGap: {{ .NonceQueue.MaxNonceGap }}
+  MaxAge: {{ .NonceQueue.MaxAge }}
 # These should pretty much never be changed
 RootDir: "{{ .RootDir }}"
 DBName: "{{ .DBName }}"
@@ -846,6 +1052,18 @@ DPOS:
   TotalStakedCacheDuration: {{ .DPOS.TotalStakedCacheDuration }}
 {{end}}
 
+{{if .GraphQL -}}
+#
+# Configuration of the GraphQL queries served on the /graphql endpoint.
+#
+GraphQL:
+  Enabled: {{ .GraphQL.Enabled }}
+  # Queries that select fields nested deeper than this are rejected
+  MaxDepth: {{ .GraphQL.MaxDepth }}
+  # Maximum number of fields of a query that are resolved concurrently
+  MaxParallelism: {{ .GraphQL.MaxParallelism }}
+{{end}}
+
 #
 # Here be dragons, don't change the defaults unless you know what you're doing
 #
@@ -0,0 +1,152 @@
+package subs
+
+import (
+	"bytes"
+	"fmt"
+
+	etypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gorilla/websocket"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/auth"
+	ltypes "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/loomnetwork/loomchain/rpc/eth"
+	"github.com/phonkee/go-pubsub"
+	ttypes "github.com/tendermint/tendermint/types"
+)
+
+// pendingTx is a tx that has been accepted into the mempool.
+type pendingTx struct {
+	txObj eth.JsonTxObject
+	from  loom.LocalAddress
+	to    loom.LocalAddress
+}
+
+// decodePendingTx extracts the sender, target, and body of a tx from the raw tx bytes. Since the
+// tx hasn't been executed yet the EVM tx hash isn't known, so the Tendermint tx hash is used to
+// identify the tx.
+func decodePendingTx(txBytes []byte) (*pendingTx, error) {
+	tx := &pendingTx{
+		txObj: eth.JsonTxObject{
+			Hash:     eth.EncBytes(ttypes.Tx(txBytes).Hash()),
+			Value:    eth.EncInt(0),
+			GasPrice: eth.EncInt(0),
+			Gas:      eth.EncInt(0),
+		},
+	}
+
+	var signedTx auth.SignedTx
+	if err := proto.Unmarshal(txBytes, &signedTx); err != nil {
+		return nil, err
+	}
+	var nonceTx auth.NonceTx
+	if err := proto.Unmarshal(signedTx.Inner, &nonceTx); err != nil {
+		return nil, err
+	}
+	tx.txObj.Nonce = eth.EncInt(int64(nonceTx.Sequence))
+
+	var txTx ltypes.Transaction
+	if err := proto.Unmarshal(nonceTx.Inner, &txTx); err != nil {
+		return nil, err
+	}
+	var msg vm.MessageTx
+	if err := proto.Unmarshal(txTx.Data, &msg); err != nil {
+		return nil, err
+	}
+	tx.txObj.From = eth.EncAddress(msg.From)
+	if msg.From != nil {
+		tx.from = msg.From.Local
+	}
+
+	var input []byte
+	switch ltypes.TxID(txTx.Id) {
+	case ltypes.TxID_DEPLOY:
+		var deployTx vm.DeployTx
+		if err := proto.Unmarshal(msg.Data, &deployTx); err != nil {
+			return nil, err
+		}
+		input = deployTx.Code
+		if deployTx.Value != nil {
+			tx.txObj.Value = eth.EncBigInt(*deployTx.Value.Value.Int)
+		}
+
+	case ltypes.TxID_CALL:
+		var callTx vm.CallTx
+		if err := proto.Unmarshal(msg.Data, &callTx); err != nil {
+			return nil, err
+		}
+		input = callTx.Input
+		tx.setTo(msg.To)
+		if callTx.Value != nil {
+			tx.txObj.Value = eth.EncBigInt(*callTx.Value.Value.Int)
+		}
+
+	case ltypes.TxID_MIGRATION:
+		input = msg.Data
+		tx.setTo(msg.To)
+
+	case ltypes.TxID_ETHEREUM:
+		var ethTx etypes.Transaction
+		if err := rlp.DecodeBytes(msg.Data, &ethTx); err != nil {
+			return nil, err
+		}
+		if ethTx.To() != nil {
+			tx.setTo(msg.To)
+		}
+		tx.txObj.Value = eth.EncBigInt(*ethTx.Value())
+		tx.txObj.GasPrice = eth.EncBigInt(*ethTx.GasPrice())
+		tx.txObj.Gas = eth.EncUint(ethTx.Gas())
+		input = ethTx.Data()
+
+	default:
+		return nil, fmt.Errorf("unrecognised tx type %v", txTx.Id)
+	}
+	tx.txObj.Input = eth.EncBytes(input)
+	return tx, nil
+}
+
+func (tx *pendingTx) setTo(addr *ltypes.Address) {
+	to := eth.EncAddress(addr)
+	tx.txObj.To = &to
+	if addr != nil {
+		tx.to = addr.Local
+	}
+}
+
+type pendingTxSubscriber struct {
+	wsSubscriber
+	filter eth.PendingTxFilter
+}
+
+func newPendingTxSubscriber(
+	hub pubsub.ResetHub, id string, filter eth.PendingTxFilter, conn *websocket.Conn,
+) pendingTxSubscriber {
+	wsSub := newWsSubscriber(hub, conn, id)
+	return pendingTxSubscriber{
+		wsSubscriber: *wsSub,
+		filter:       filter,
+	}
+}
+
+func (s pendingTxSubscriber) Match(topic string) bool {
+	return topic == NewPendingTransactions
+}
+
+func (s pendingTxSubscriber) matchTx(tx *pendingTx) bool {
+	return matchAddress(s.filter.From, tx.from) && matchAddress(s.filter.To, tx.to)
+}
+
+// matchAddress returns true if addrs is empty, or if it contains addr.
+func matchAddress(addrs []loom.LocalAddress, addr loom.LocalAddress) bool {
+	if len(addrs) == 0 {
+		return true
+	}
+	for _, a := range addrs {
+		if bytes.Equal(a, addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package subs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/auth"
+	ltypes "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/go-loom/vm"
+	"github.com/loomnetwork/loomchain/rpc/eth"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pendingTxCaller   = loom.MustParseAddress("default:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	pendingTxContract = loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+)
+
+func mockCallTx(t *testing.T, from, to loom.Address, input []byte) []byte {
+	callTx, err := proto.Marshal(&vm.CallTx{VmType: vm.VMType_EVM, Input: input})
+	require.NoError(t, err)
+	messageTx, err := proto.Marshal(&vm.MessageTx{From: from.MarshalPB(), To: to.MarshalPB(), Data: callTx})
+	require.NoError(t, err)
+	txTx, err := proto.Marshal(&ltypes.Transaction{Id: uint32(ltypes.TxID_CALL), Data: messageTx})
+	require.NoError(t, err)
+	nonceTx, err := proto.Marshal(&auth.NonceTx{Sequence: 7, Inner: txTx})
+	require.NoError(t, err)
+	signedTx, err := proto.Marshal(&auth.SignedTx{Inner: nonceTx})
+	require.NoError(t, err)
+	return signedTx
+}
+
+func TestDecodePendingTx(t *testing.T) {
+	txBytes := mockCallTx(t, pendingTxCaller, pendingTxContract, []byte{1, 2, 3})
+	tx, err := decodePendingTx(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, eth.EncInt(7), tx.txObj.Nonce)
+	require.Equal(t, eth.EncBytes(pendingTxCaller.Local), tx.txObj.From)
+	require.Equal(t, eth.EncBytes(pendingTxContract.Local), *tx.txObj.To)
+	require.Equal(t, eth.EncBytes([]byte{1, 2, 3}), tx.txObj.Input)
+	require.Equal(t, pendingTxCaller.Local, tx.from)
+	require.Equal(t, pendingTxContract.Local, tx.to)
+
+	_, err = decodePendingTx([]byte("not a tx"))
+	require.Error(t, err)
+}
+
+func TestPendingTxFilter(t *testing.T) {
+	tx, err := decodePendingTx(mockCallTx(t, pendingTxCaller, pendingTxContract, nil))
+	require.NoError(t, err)
+
+	var filter eth.JsonFilter
+	require.NoError(t, json.Unmarshal([]byte(`true`), &filter))
+	require.True(t, filter.FullTransactions)
+
+	filter = eth.JsonFilter{}
+	require.NoError(t, json.Unmarshal([]byte(`{"from":"`+pendingTxCaller.Local.String()+`"}`), &filter))
+	f, err := eth.DecLogFilter(filter)
+	require.NoError(t, err)
+	require.False(t, f.FullTransactions)
+	require.True(t, pendingTxSubscriber{filter: f.PendingTxFilter}.matchTx(tx))
+
+	filter = eth.JsonFilter{}
+	require.NoError(t, json.Unmarshal([]byte(`{"to":["`+pendingTxCaller.Local.String()+`"]}`), &filter))
+	f, err = eth.DecLogFilter(filter)
+	require.NoError(t, err)
+	require.False(t, pendingTxSubscriber{filter: f.PendingTxFilter}.matchTx(tx))
+
+	require.True(t, pendingTxSubscriber{}.matchTx(tx))
+}
@@ -1,17 +1,18 @@
 package subs
 
 import (
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"sync"
 
 	"github.com/gorilla/websocket"
 	"github.com/loomnetwork/loomchain/eth/utils"
+	"github.com/loomnetwork/loomchain/log"
 	"github.com/loomnetwork/loomchain/rpc/eth"
 	"github.com/phonkee/go-pubsub"
 	"github.com/pkg/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	ttypes "github.com/tendermint/tendermint/types"
 )
 
 const (
@@ -84,33 +85,105 @@ func (nh *headsResetHub) emitBlockEvent(header abci.Header) (err error) {
 	return nil
 }
 
+const (
+	// Max number of pending txs that can be queued up for publishing, if the queue is full new
+	// txs will be dropped.
+	pendingTxQueueSize = 1000
+	// Number of recently published tx hashes to remember, txs that are rechecked by the mempool
+	// after each block shouldn't be published again.
+	pendingTxSeenCacheSize = 10000
+)
+
 type pendingTxsResetHub struct {
 	ethResetHub
+	txQueue   chan []byte
+	seen      map[string]struct{}
+	seenOrder []string
 }
 
 func newPendingTxsResetHub() *pendingTxsResetHub {
 	hub := newEthResetHub()
-	return &pendingTxsResetHub{
+	pt := &pendingTxsResetHub{
 		ethResetHub: *hub,
+		txQueue:     make(chan []byte, pendingTxQueueSize),
+		seen:        make(map[string]struct{}),
 	}
+	go pt.run()
+	return pt
 }
 
-func (pt *pendingTxsResetHub) addSubscriber(conn *websocket.Conn) string {
+func (pt *pendingTxsResetHub) addSubscriber(filter eth.PendingTxFilter, conn *websocket.Conn) string {
 	id := utils.GetId()
-	sub := newTopicSubscriber(pt, id, NewPendingTransactions, conn)
+	sub := newPendingTxSubscriber(pt, id, filter, conn)
+	pt.mutex.Lock()
+	defer pt.mutex.Unlock()
 	pt.clients[id] = sub
 	pt.unsent[id] = true
 	return id
 }
 
-func (pt *pendingTxsResetHub) emitTxEvent(txHash []byte) (err error) {
-	if len(pt.clients) > 0 {
-		txHashRawJson, err := json.Marshal(hex.EncodeToString(txHash))
+// emitPendingTx queues up a tx that was accepted into the mempool to be published to subscribers.
+// The tx is decoded & published on a separate goroutine so CheckTx isn't held up by subscribers.
+func (pt *pendingTxsResetHub) emitPendingTx(txBytes []byte) {
+	pt.mutex.RLock()
+	numClients := len(pt.clients)
+	pt.mutex.RUnlock()
+	if numClients == 0 {
+		return
+	}
+	select {
+	case pt.txQueue <- txBytes:
+	default:
+		log.Debug("Pending tx queue is full, dropping tx")
+	}
+}
+
+func (pt *pendingTxsResetHub) run() {
+	for txBytes := range pt.txQueue {
+		txHash := string(ttypes.Tx(txBytes).Hash())
+		if _, seen := pt.seen[txHash]; seen {
+			continue
+		}
+		pt.seen[txHash] = struct{}{}
+		pt.seenOrder = append(pt.seenOrder, txHash)
+		if len(pt.seenOrder) > pendingTxSeenCacheSize {
+			delete(pt.seen, pt.seenOrder[0])
+			pt.seenOrder = pt.seenOrder[1:]
+		}
+
+		tx, err := decodePendingTx(txBytes)
 		if err != nil {
-			return errors.Wrapf(err, "json marshaling tx hash %v", txHash)
+			log.Debug("Failed to decode pending tx", "err", err)
+			continue
+		}
+		if err := pt.publishPendingTx(tx); err != nil {
+			log.Error("Failed to publish pending tx", "err", err)
+		}
+	}
+}
+
+func (pt *pendingTxsResetHub) publishPendingTx(tx *pendingTx) error {
+	hashMsg, err := json.Marshal(tx.txObj.Hash)
+	if err != nil {
+		return errors.Wrapf(err, "json marshaling tx hash %v", tx.txObj.Hash)
+	}
+	txMsg, err := json.Marshal(tx.txObj)
+	if err != nil {
+		return errors.Wrapf(err, "json marshaling tx %v", tx.txObj.Hash)
+	}
+
+	pt.mutex.RLock()
+	defer pt.mutex.RUnlock()
+	for _, client := range pt.clients {
+		sub, ok := client.(pendingTxSubscriber)
+		if !ok || !sub.matchTx(tx) {
+			continue
+		}
+		if sub.filter.FullTransactions {
+			sub.Publish(pubsub.NewMessage(NewPendingTransactions, txMsg))
+		} else {
+			sub.Publish(pubsub.NewMessage(NewPendingTransactions, hashMsg))
 		}
-		pt.Reset()
-		pt.Publish(pubsub.NewMessage(NewPendingTransactions, txHashRawJson))
 	}
 	return nil
 }
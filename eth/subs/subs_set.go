@@ -14,14 +14,14 @@ import (
 type EthSubscriptionSet struct {
 	logsHub      logsResetHub
 	newHeadsHub  headsResetHub
-	pendingTxHub pendingTxsResetHub
+	pendingTxHub *pendingTxsResetHub
 }
 
 func NewEthSubscriptionSet() *EthSubscriptionSet {
 	s := &EthSubscriptionSet{
 		logsHub:      *newLogsResetHubResetHub(),
 		newHeadsHub:  *newHeadsResetHub(),
-		pendingTxHub: *newPendingTxsResetHub(),
+		pendingTxHub: newPendingTxsResetHub(),
 	}
 	return s
 }
@@ -37,7 +37,7 @@ func (s *EthSubscriptionSet) AddSubscription(
 	case NewHeads:
 		id = s.newHeadsHub.addSubscriber(conn)
 	case NewPendingTransactions:
-		id = s.pendingTxHub.addSubscriber(conn)
+		id = s.pendingTxHub.addSubscriber(filter.PendingTxFilter, conn)
 	case Syncing:
 		return "", fmt.Errorf("syncing not supported")
 	default:
@@ -50,8 +50,10 @@ func (s *EthSubscriptionSet) EmitBlockEvent(header abci.Header) (err error) {
 	return s.newHeadsHub.emitBlockEvent(header)
 }
 
-func (s *EthSubscriptionSet) EmitTxEvent(txHash []byte) (err error) {
-	return s.pendingTxHub.emitTxEvent(txHash)
+// EmitPendingTx notifies newPendingTransactions subscribers of a tx that has been accepted into
+// the mempool.
+func (s *EthSubscriptionSet) EmitPendingTx(txBytes []byte) {
+	s.pendingTxHub.emitPendingTx(txBytes)
 }
 
 func (s *EthSubscriptionSet) EmitEvent(data types.EventData) error {
@@ -4,6 +4,7 @@ const (
 	DispatcherDBIndexer = "db_indexer"
 	DispatcherRedis     = "redis"
 	DispatcherLog       = "log"
+	DispatcherWebhook   = "webhook"
 )
 
 type EventStoreConfig struct {
@@ -33,6 +34,7 @@ func (c *EventStoreConfig) Clone() *EventStoreConfig {
 type EventDispatcherConfig struct {
 	Dispatcher string
 	Redis      *RedisEventDispatcherConfig
+	Webhook    *WebhookEventDispatcherConfig
 }
 
 func DefaultEventDispatcherConfig() *EventDispatcherConfig {
@@ -41,6 +43,7 @@ func DefaultEventDispatcherConfig() *EventDispatcherConfig {
 		Redis: &RedisEventDispatcherConfig{
 			URI: "127.0.0.1",
 		},
+		Webhook: DefaultWebhookEventDispatcherConfig(),
 	}
 }
 
@@ -51,5 +54,6 @@ func (c *EventDispatcherConfig) Clone() *EventDispatcherConfig {
 	}
 	clone := *c
 	*clone.Redis = *c.Redis
+	clone.Webhook = c.Webhook.Clone()
 	return &clone
 }
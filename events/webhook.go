@@ -0,0 +1,291 @@
+package events
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/log"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+const (
+	// HTTP header containing the hex-encoded HMAC-SHA256 of the request body
+	WebhookSignatureHeader = "X-Loom-Signature"
+	// HTTP header containing the ID of the delivery, receivers can use it to detect duplicates
+	WebhookDeliveryHeader = "X-Loom-Delivery"
+
+	maxWebhookRetryInterval = 1 * time.Hour
+	webhookBatchSize        = 100
+)
+
+// WebhookRoute determines which events are sent to a webhook URL. If neither Contracts nor Topics
+// are specified all events will be sent to the URL.
+type WebhookRoute struct {
+	URL string
+	// Names of Go contracts, or hex-encoded addresses of EVM contracts
+	Contracts []string
+	Topics    []string
+}
+
+type WebhookEventDispatcherConfig struct {
+	Routes []*WebhookRoute
+	// Secret used to sign payloads, if empty payloads won't be signed.
+	Secret string
+	// Max number of delivery attempts before a delivery is moved to the dead-letter list.
+	MaxAttempts int
+	// Delay before the first retry of a failed delivery, the delay doubles with each failed attempt.
+	RetryIntervalSecs int64
+	// HTTP request timeout.
+	TimeoutSecs int64
+	// How often the delivery queue should be checked for deliveries that are due.
+	PollIntervalMillis int64
+}
+
+func DefaultWebhookEventDispatcherConfig() *WebhookEventDispatcherConfig {
+	return &WebhookEventDispatcherConfig{
+		MaxAttempts:        10,
+		RetryIntervalSecs:  5,
+		TimeoutSecs:        10,
+		PollIntervalMillis: 500,
+	}
+}
+
+// Clone returns a deep clone of the config.
+func (c *WebhookEventDispatcherConfig) Clone() *WebhookEventDispatcherConfig {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	if c.Routes != nil {
+		clone.Routes = make([]*WebhookRoute, len(c.Routes))
+		for i, route := range c.Routes {
+			if route == nil {
+				continue
+			}
+			r := *route
+			r.Contracts = append([]string(nil), route.Contracts...)
+			r.Topics = append([]string(nil), route.Topics...)
+			clone.Routes[i] = &r
+		}
+	}
+	return &clone
+}
+
+func (r *WebhookRoute) matches(event *types.EventData) bool {
+	if len(r.Contracts) > 0 {
+		matched := false
+		var contractAddr string
+		if event.Address != nil {
+			contractAddr = loom.UnmarshalAddressPB(event.Address).Local.String()
+		}
+		for _, contract := range r.Contracts {
+			if contract == event.PluginName || (contractAddr != "" && strings.EqualFold(contract, contractAddr)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(r.Topics) > 0 {
+		for _, topic := range r.Topics {
+			for _, eventTopic := range event.Topics {
+				if topic == eventTopic {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// WebhookEventDispatcher POSTs events to HTTP endpoints. Events are written to a durable queue
+// when the dispatcher is flushed, and delivered by a background worker which retries failed
+// deliveries with an exponential backoff. Deliveries that still fail after MaxAttempts are moved
+// to a dead-letter list, from which they can be replayed via the `loom events webhook` command.
+type WebhookEventDispatcher struct {
+	cfg        *WebhookEventDispatcherConfig
+	queue      *WebhookQueue
+	httpClient *http.Client
+	pending    []*WebhookDelivery
+	wakeCh     chan struct{}
+	quitCh     chan struct{}
+	sync.Mutex
+}
+
+var _ loomchain.EventDispatcher = &WebhookEventDispatcher{}
+
+// NewWebhookEventDispatcher creates a new webhook dispatcher that stores its delivery queue in
+// the given DB, the dispatcher won't deliver anything until Start is called.
+func NewWebhookEventDispatcher(
+	cfg *WebhookEventDispatcherConfig, db dbm.DB,
+) (*WebhookEventDispatcher, error) {
+	if cfg == nil || len(cfg.Routes) == 0 {
+		return nil, errors.New("no webhook routes configured")
+	}
+	for _, route := range cfg.Routes {
+		if route == nil || route.URL == "" {
+			return nil, errors.New("webhook route URL must be specified")
+		}
+	}
+	if cfg.MaxAttempts <= 0 {
+		return nil, errors.New("webhook MaxAttempts must be greater than zero")
+	}
+	return &WebhookEventDispatcher{
+		cfg:   cfg,
+		queue: NewWebhookQueue(db),
+		httpClient: &http.Client{
+			Timeout: time.Duration(cfg.TimeoutSecs) * time.Second,
+		},
+		wakeCh: make(chan struct{}, 1),
+		quitCh: make(chan struct{}),
+	}, nil
+}
+
+// Start launches the background worker that delivers queued events.
+func (ed *WebhookEventDispatcher) Start() {
+	go ed.run()
+}
+
+// Stop shuts down the background worker, any pending deliveries will remain in the queue.
+func (ed *WebhookEventDispatcher) Stop() {
+	close(ed.quitCh)
+}
+
+func (ed *WebhookEventDispatcher) Send(blockHeight uint64, eventIndex int, msg []byte) error {
+	var eventData types.EventData
+	if err := json.Unmarshal(msg, &eventData); err != nil {
+		return err
+	}
+
+	ed.Lock()
+	defer ed.Unlock()
+	for _, route := range ed.cfg.Routes {
+		if route.matches(&eventData) {
+			ed.pending = append(ed.pending, &WebhookDelivery{
+				URL:         route.URL,
+				BlockHeight: blockHeight,
+				EventIndex:  eventIndex,
+				Payload:     msg,
+			})
+		}
+	}
+	return nil
+}
+
+func (ed *WebhookEventDispatcher) Flush() {
+	ed.Lock()
+	deliveries := ed.pending
+	ed.pending = nil
+	ed.Unlock()
+
+	if err := ed.queue.Enqueue(deliveries); err != nil {
+		log.Error("Failed to queue webhook deliveries", "err", err)
+		return
+	}
+	if len(deliveries) > 0 {
+		select {
+		case ed.wakeCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (ed *WebhookEventDispatcher) run() {
+	ticker := time.NewTicker(time.Duration(ed.cfg.PollIntervalMillis) * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ed.quitCh:
+			return
+		case <-ticker.C:
+		case <-ed.wakeCh:
+		}
+		if err := ed.deliverDue(time.Now()); err != nil {
+			log.Error("Failed to process webhook deliveries", "err", err)
+		}
+	}
+}
+
+// deliverDue attempts all the queued deliveries that are due at the given time.
+func (ed *WebhookEventDispatcher) deliverDue(now time.Time) error {
+	for {
+		deliveries, err := ed.queue.Due(now.Unix(), webhookBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, d := range deliveries {
+			if err := ed.deliver(d); err != nil {
+				if err := ed.handleFailedDelivery(d, err, now); err != nil {
+					return err
+				}
+				continue
+			}
+			ed.queue.Remove(d.ID)
+		}
+		if len(deliveries) < webhookBatchSize {
+			return nil
+		}
+	}
+}
+
+func (ed *WebhookEventDispatcher) handleFailedDelivery(d *WebhookDelivery, err error, now time.Time) error {
+	d.Attempts++
+	d.LastError = err.Error()
+	if d.Attempts >= ed.cfg.MaxAttempts {
+		log.Error("Webhook delivery failed, moving to dead-letter list",
+			"id", d.ID, "url", d.URL, "attempts", d.Attempts, "err", err)
+		return ed.queue.MoveToDeadLetters(d)
+	}
+	retryInterval := time.Duration(ed.cfg.RetryIntervalSecs) * time.Second << uint(d.Attempts-1)
+	if retryInterval > maxWebhookRetryInterval || retryInterval <= 0 {
+		retryInterval = maxWebhookRetryInterval
+	}
+	d.NextAttempt = now.Add(retryInterval).Unix()
+	log.Info("Webhook delivery failed, will retry",
+		"id", d.ID, "url", d.URL, "attempts", d.Attempts, "retryIn", retryInterval, "err", err)
+	return ed.queue.Update(d)
+}
+
+func (ed *WebhookEventDispatcher) deliver(d *WebhookDelivery) error {
+	req, err := http.NewRequest(http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookDeliveryHeader, fmt.Sprintf("%d", d.ID))
+	if ed.cfg.Secret != "" {
+		req.Header.Set(WebhookSignatureHeader, SignWebhookPayload([]byte(ed.cfg.Secret), d.Payload))
+	}
+	resp, err := ed.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the hex-encoded HMAC-SHA256 of the payload, receivers should compare
+// it against the value of the X-Loom-Signature header to verify the payload.
+func SignWebhookPayload(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
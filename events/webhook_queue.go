@@ -0,0 +1,229 @@
+package events
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+var (
+	// Pending webhook deliveries, key format: webhookQueuePrefix | delivery ID
+	webhookQueuePrefix = []byte("whq")
+	// Webhook deliveries that exceeded the max number of attempts, key format:
+	// webhookDeadLetterPrefix | delivery ID
+	webhookDeadLetterPrefix = []byte("whdl")
+	// Stores the ID of the last webhook delivery that was queued
+	webhookLastIDKey = []byte("whseq")
+)
+
+// WebhookDelivery is a single event that should be POSTed to a webhook.
+type WebhookDelivery struct {
+	ID          uint64 `json:"id"`
+	URL         string `json:"url"`
+	BlockHeight uint64 `json:"blockHeight"`
+	EventIndex  int    `json:"eventIndex"`
+	Payload     []byte `json:"payload"`
+	// Number of failed delivery attempts
+	Attempts int `json:"attempts"`
+	// Unix timestamp (in seconds) of the earliest time at which the next attempt should be made
+	NextAttempt int64  `json:"nextAttempt"`
+	LastError   string `json:"lastError,omitempty"`
+}
+
+// WebhookQueue is a durable queue of webhook deliveries, stored in the event store DB so that
+// deliveries survive node restarts.
+type WebhookQueue struct {
+	db dbm.DB
+	sync.Mutex
+}
+
+func NewWebhookQueue(db dbm.DB) *WebhookQueue {
+	return &WebhookQueue{db: db}
+}
+
+func webhookQueueKey(id uint64) []byte {
+	return util.PrefixKey(webhookQueuePrefix, deliveryIDKey(id))
+}
+
+func webhookDeadLetterKey(id uint64) []byte {
+	return util.PrefixKey(webhookDeadLetterPrefix, deliveryIDKey(id))
+}
+
+func deliveryIDKey(id uint64) []byte {
+	idB := make([]byte, 8)
+	binary.BigEndian.PutUint64(idB, id)
+	return idB
+}
+
+// Enqueue assigns IDs to the given deliveries & adds them to the queue.
+func (q *WebhookQueue) Enqueue(deliveries []*WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	q.Lock()
+	defer q.Unlock()
+
+	var lastID uint64
+	if idB := q.db.Get(webhookLastIDKey); len(idB) == 8 {
+		lastID = binary.BigEndian.Uint64(idB)
+	}
+	batch := q.db.NewBatch()
+	for _, d := range deliveries {
+		lastID++
+		d.ID = lastID
+		data, err := json.Marshal(d)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal webhook delivery")
+		}
+		batch.Set(webhookQueueKey(d.ID), data)
+	}
+	batch.Set(webhookLastIDKey, deliveryIDKey(lastID))
+	batch.Write()
+	return nil
+}
+
+// Due returns up to limit queued deliveries that should be attempted at or before the given time.
+func (q *WebhookQueue) Due(now int64, limit int) ([]*WebhookDelivery, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var due []*WebhookDelivery
+	err := q.iterate(webhookQueuePrefix, func(d *WebhookDelivery) bool {
+		if d.NextAttempt <= now {
+			due = append(due, d)
+		}
+		return len(due) < limit
+	})
+	return due, err
+}
+
+// Remove deletes a delivery from the queue.
+func (q *WebhookQueue) Remove(id uint64) {
+	q.Lock()
+	defer q.Unlock()
+	q.db.Delete(webhookQueueKey(id))
+}
+
+// Update stores the updated state of a queued delivery.
+func (q *WebhookQueue) Update(d *WebhookDelivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook delivery")
+	}
+	q.Lock()
+	defer q.Unlock()
+	q.db.Set(webhookQueueKey(d.ID), data)
+	return nil
+}
+
+// MoveToDeadLetters removes a delivery from the queue and adds it to the dead-letter list.
+func (q *WebhookQueue) MoveToDeadLetters(d *WebhookDelivery) error {
+	data, err := json.Marshal(d)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook delivery")
+	}
+	q.Lock()
+	defer q.Unlock()
+	batch := q.db.NewBatch()
+	batch.Delete(webhookQueueKey(d.ID))
+	batch.Set(webhookDeadLetterKey(d.ID), data)
+	batch.Write()
+	return nil
+}
+
+// DeadLetters returns all the deliveries in the dead-letter list.
+func (q *WebhookQueue) DeadLetters() ([]*WebhookDelivery, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var deliveries []*WebhookDelivery
+	err := q.iterate(webhookDeadLetterPrefix, func(d *WebhookDelivery) bool {
+		deliveries = append(deliveries, d)
+		return true
+	})
+	return deliveries, err
+}
+
+// Pending returns all the deliveries that are still queued.
+func (q *WebhookQueue) Pending() ([]*WebhookDelivery, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var deliveries []*WebhookDelivery
+	err := q.iterate(webhookQueuePrefix, func(d *WebhookDelivery) bool {
+		deliveries = append(deliveries, d)
+		return true
+	})
+	return deliveries, err
+}
+
+// Replay moves a delivery from the dead-letter list back into the queue, the delivery will be
+// attempted as soon as possible.
+func (q *WebhookQueue) Replay(id uint64) error {
+	q.Lock()
+	defer q.Unlock()
+
+	data := q.db.Get(webhookDeadLetterKey(id))
+	if len(data) == 0 {
+		return errors.Errorf("webhook delivery %d not found in dead-letter list", id)
+	}
+	var d WebhookDelivery
+	if err := json.Unmarshal(data, &d); err != nil {
+		return errors.Wrap(err, "failed to unmarshal webhook delivery")
+	}
+	d.Attempts = 0
+	d.NextAttempt = 0
+	d.LastError = ""
+	data, err := json.Marshal(&d)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal webhook delivery")
+	}
+	batch := q.db.NewBatch()
+	batch.Delete(webhookDeadLetterKey(id))
+	batch.Set(webhookQueueKey(id), data)
+	batch.Write()
+	return nil
+}
+
+// PurgeDeadLetters deletes all the deliveries in the dead-letter list, and returns the number of
+// deliveries that were deleted.
+func (q *WebhookQueue) PurgeDeadLetters() (int, error) {
+	q.Lock()
+	defer q.Unlock()
+
+	var ids []uint64
+	err := q.iterate(webhookDeadLetterPrefix, func(d *WebhookDelivery) bool {
+		ids = append(ids, d.ID)
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+	batch := q.db.NewBatch()
+	for _, id := range ids {
+		batch.Delete(webhookDeadLetterKey(id))
+	}
+	batch.Write()
+	return len(ids), nil
+}
+
+// iterate calls fn for each delivery stored under the given prefix until fn returns false.
+func (q *WebhookQueue) iterate(prefix []byte, fn func(d *WebhookDelivery) bool) error {
+	itr := q.db.Iterator(prefix, util.PrefixRangeEnd(prefix))
+	defer itr.Close()
+
+	for ; itr.Valid(); itr.Next() {
+		var d WebhookDelivery
+		if err := json.Unmarshal(itr.Value(), &d); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal webhook delivery %x", itr.Key())
+		}
+		if !fn(&d) {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package events
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+type webhookReceiver struct {
+	sync.Mutex
+	payloads   [][]byte
+	signatures []string
+	fail       bool
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.Lock()
+	defer r.Unlock()
+	if r.fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	r.payloads = append(r.payloads, body)
+	r.signatures = append(r.signatures, req.Header.Get(WebhookSignatureHeader))
+}
+
+func TestWebhookDispatcherRoutes(t *testing.T) {
+	receiver := &webhookReceiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	cfg := DefaultWebhookEventDispatcherConfig()
+	cfg.Secret = "secret"
+	cfg.Routes = []*WebhookRoute{
+		{URL: server.URL + "/contract", Contracts: []string{"plugin1"}},
+		{URL: server.URL + "/topic", Topics: []string{"event:Transfer"}},
+	}
+	dispatcher, err := NewWebhookEventDispatcher(cfg, dbm.NewMemDB())
+	require.NoError(t, err)
+
+	events := []types.EventData{
+		{PluginName: "plugin1", BlockHeight: 1},
+		{PluginName: "plugin2", BlockHeight: 1, Topics: []string{"event:Transfer"}},
+		{PluginName: "plugin2", BlockHeight: 1, Topics: []string{"event:Approval"}},
+	}
+	for i, event := range events {
+		msg, err := json.Marshal(event)
+		require.NoError(t, err)
+		require.NoError(t, dispatcher.Send(1, i, msg))
+	}
+	dispatcher.Flush()
+
+	pending, err := dispatcher.queue.Pending()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pending))
+
+	require.NoError(t, dispatcher.deliverDue(time.Now()))
+	require.Equal(t, 2, len(receiver.payloads))
+	for i, payload := range receiver.payloads {
+		require.Equal(t, SignWebhookPayload([]byte("secret"), payload), receiver.signatures[i])
+	}
+
+	pending, err = dispatcher.queue.Pending()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pending))
+}
+
+func TestWebhookDispatcherRetries(t *testing.T) {
+	receiver := &webhookReceiver{fail: true}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	cfg := DefaultWebhookEventDispatcherConfig()
+	cfg.MaxAttempts = 2
+	cfg.Routes = []*WebhookRoute{{URL: server.URL}}
+	db := dbm.NewMemDB()
+	dispatcher, err := NewWebhookEventDispatcher(cfg, db)
+	require.NoError(t, err)
+
+	msg, err := json.Marshal(types.EventData{PluginName: "plugin1"})
+	require.NoError(t, err)
+	require.NoError(t, dispatcher.Send(1, 0, msg))
+	dispatcher.Flush()
+
+	now := time.Now()
+	require.NoError(t, dispatcher.deliverDue(now))
+	pending, err := dispatcher.queue.Pending()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pending))
+	require.Equal(t, 1, pending[0].Attempts)
+	require.Equal(t, now.Add(5*time.Second).Unix(), pending[0].NextAttempt)
+
+	// retry isn't due yet
+	require.NoError(t, dispatcher.deliverDue(now))
+	pending, err = dispatcher.queue.Pending()
+	require.NoError(t, err)
+	require.Equal(t, 1, pending[0].Attempts)
+
+	// second failure should move the delivery to the dead-letter list
+	require.NoError(t, dispatcher.deliverDue(now.Add(5*time.Second)))
+	pending, err = dispatcher.queue.Pending()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pending))
+	deadLetters, err := dispatcher.queue.DeadLetters()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(deadLetters))
+	require.Equal(t, 2, deadLetters[0].Attempts)
+
+	// the queue should survive a restart
+	queue := NewWebhookQueue(db)
+	require.NoError(t, queue.Replay(deadLetters[0].ID))
+	deadLetters, err = queue.DeadLetters()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(deadLetters))
+
+	receiver.Lock()
+	receiver.fail = false
+	receiver.Unlock()
+	require.NoError(t, dispatcher.deliverDue(now.Add(5*time.Second)))
+	require.Equal(t, 1, len(receiver.payloads))
+	require.Equal(t, msg, receiver.payloads[0])
+	pending, err = dispatcher.queue.Pending()
+	require.NoError(t, err)
+	require.Equal(t, 0, len(pending))
+}
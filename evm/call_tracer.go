@@ -0,0 +1,217 @@
+// +build evm
+
+package evm
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmaux "github.com/loomnetwork/loomchain/store/evm_aux"
+)
+
+// callTracer is an EVM tracer that records the tree of message calls & contract creations made
+// while executing a tx, it's a native version of the callTracer JS tracer that ships with geth.
+type callTracer struct {
+	// callstack[0] is the top-level call, the last frame is the call that's currently executing
+	callstack []*tracedCall
+}
+
+type tracedCall struct {
+	*evmaux.CallFrame
+	// location in memory to read the call output from when the call returns
+	outOffset uint64
+	outLength uint64
+}
+
+var _ vm.Tracer = &callTracer{}
+
+func newCallTracer() *callTracer {
+	return &callTracer{}
+}
+
+// Result returns the call frame of the top-level call, or nil if nothing was traced.
+func (t *callTracer) Result() *evmaux.CallFrame {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	return t.callstack[0].CallFrame
+}
+
+func (t *callTracer) CaptureStart(
+	from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int,
+) error {
+	callType := "CALL"
+	if create {
+		callType = "CREATE"
+	}
+	t.callstack = []*tracedCall{
+		{
+			CallFrame: &evmaux.CallFrame{
+				Type:  callType,
+				From:  from.Bytes(),
+				To:    to.Bytes(),
+				Input: common.CopyBytes(input),
+				Value: copyBigInt(value),
+			},
+		},
+	}
+	return nil
+}
+
+func (t *callTracer) CaptureState(
+	env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack,
+	contract *vm.Contract, depth int, err error,
+) error {
+	if err != nil {
+		return t.CaptureFault(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+	}
+	if len(t.callstack) == 0 {
+		return nil
+	}
+
+	switch op {
+	case vm.CREATE, vm.CREATE2:
+		inOffset := stack.Back(1).Uint64()
+		inLength := stack.Back(2).Uint64()
+		t.callstack = append(t.callstack, &tracedCall{
+			CallFrame: &evmaux.CallFrame{
+				Type:  op.String(),
+				From:  contract.Address().Bytes(),
+				Input: memorySlice(memory, inOffset, inLength),
+				Value: copyBigInt(stack.Back(0)),
+			},
+		})
+		return nil
+
+	case vm.SELFDESTRUCT:
+		parent := t.callstack[len(t.callstack)-1]
+		parent.Calls = append(parent.Calls, &evmaux.CallFrame{
+			Type:  op.String(),
+			From:  contract.Address().Bytes(),
+			To:    common.BigToAddress(stack.Back(0)).Bytes(),
+			Value: copyBigInt(env.StateDB.GetBalance(contract.Address())),
+		})
+		return nil
+
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		to := common.BigToAddress(stack.Back(1))
+		if _, isPrecompile := vm.PrecompiledContractsByzantium[to]; isPrecompile {
+			return nil
+		}
+		// DELEGATECALL & STATICCALL don't have a value argument
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+		call := &tracedCall{
+			CallFrame: &evmaux.CallFrame{
+				Type:  op.String(),
+				From:  contract.Address().Bytes(),
+				To:    to.Bytes(),
+				Input: memorySlice(memory, stack.Back(2+off).Uint64(), stack.Back(3+off).Uint64()),
+			},
+			outOffset: stack.Back(4 + off).Uint64(),
+			outLength: stack.Back(5 + off).Uint64(),
+		}
+		if off == 1 {
+			call.Value = copyBigInt(stack.Back(2))
+		}
+		t.callstack = append(t.callstack, call)
+		return nil
+	}
+
+	if op == vm.REVERT {
+		t.callstack[len(t.callstack)-1].Error = "execution reverted"
+		return nil
+	}
+
+	// Execution has returned to the caller of the current frame. Note that this also handles calls
+	// to accounts without any code, in which case no new frame is entered and the op following
+	// the call executes at the same depth as the call op itself.
+	if depth == len(t.callstack)-1 {
+		call := t.callstack[len(t.callstack)-1]
+		t.callstack = t.callstack[:len(t.callstack)-1]
+
+		ret := stack.Back(0)
+		if call.Type == vm.CREATE.String() || call.Type == vm.CREATE2.String() {
+			if ret.Sign() != 0 {
+				addr := common.BigToAddress(ret)
+				call.To = addr.Bytes()
+				call.Output = common.CopyBytes(env.StateDB.GetCode(addr))
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		} else {
+			if ret.Sign() != 0 {
+				call.Output = memorySlice(memory, call.outOffset, call.outLength)
+			} else if call.Error == "" {
+				call.Error = "internal failure"
+			}
+		}
+
+		parent := t.callstack[len(t.callstack)-1]
+		parent.Calls = append(parent.Calls, call.CallFrame)
+	}
+	return nil
+}
+
+func (t *callTracer) CaptureFault(
+	env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack,
+	contract *vm.Contract, depth int, err error,
+) error {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	call := t.callstack[len(t.callstack)-1]
+	if call.Error != "" {
+		return nil
+	}
+	call.Error = err.Error()
+	// The failed frame won't return normally, so pop it now & attach it to its parent.
+	if len(t.callstack) > 1 {
+		t.callstack = t.callstack[:len(t.callstack)-1]
+		parent := t.callstack[len(t.callstack)-1]
+		parent.Calls = append(parent.Calls, call.CallFrame)
+	}
+	return nil
+}
+
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	root := t.callstack[0]
+	root.Output = common.CopyBytes(output)
+	if err != nil {
+		root.Error = err.Error()
+	}
+	// Any frames still on the stack didn't complete, so they must be attached to the tree here.
+	for i := len(t.callstack) - 1; i > 0; i-- {
+		parent := t.callstack[i-1]
+		parent.Calls = append(parent.Calls, t.callstack[i].CallFrame)
+	}
+	t.callstack = t.callstack[:1]
+	return nil
+}
+
+// memorySlice returns a copy of the specified memory range, out of bounds ranges are truncated.
+func memorySlice(memory *vm.Memory, offset, length uint64) []byte {
+	data := memory.Data()
+	if length == 0 || offset >= uint64(len(data)) {
+		return nil
+	}
+	end := offset + length
+	if end > uint64(len(data)) || end < offset {
+		end = uint64(len(data))
+	}
+	return common.CopyBytes(data[offset:end])
+}
+
+func copyBigInt(v *big.Int) *big.Int {
+	if v == nil {
+		return nil
+	}
+	return new(big.Int).Set(v)
+}
@@ -0,0 +1,104 @@
+// +build evm
+
+package evm
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/loomnetwork/go-loom"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallTracer(t *testing.T) {
+	caller := loom.Address{
+		ChainID: "myChainID",
+		Local:   []byte("myCaller"),
+	}
+	levm, err := NewLoomEvm(mockState(), nil, nil, false)
+	require.NoError(t, err)
+	levm.EnableCallTracing()
+
+	// reverter always reverts without any return data
+	reverter := deployRuntimeCode(t, levm, caller, []byte{
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.REVERT),
+	})
+	// callee calls the reverter, then returns a single word containing 0x2a
+	calleeCode := callCode(reverter.Local, 0)
+	calleeCode = append(calleeCode,
+		byte(vm.PUSH1), 0x2a, byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	callee := deployRuntimeCode(t, levm, caller, calleeCode)
+
+	// the top-level contract calls the callee, then creates a contract without any code
+	initCode := []byte{byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURN)}
+	code := callCode(callee.Local, 32)
+	code = append(code, byte(vm.PUSH5))
+	code = append(code, initCode...)
+	code = append(code,
+		byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), byte(len(initCode)), byte(vm.PUSH1), byte(32-len(initCode)), byte(vm.PUSH1), 0,
+		byte(vm.CREATE), byte(vm.POP), byte(vm.STOP),
+	)
+	contract := deployRuntimeCode(t, levm, caller, code)
+
+	_, err = levm.Call(caller, contract, nil, nil)
+	require.NoError(t, err)
+
+	root := levm.CallTrace()
+	require.NotNil(t, root)
+	require.Equal(t, "CALL", root.Type)
+	require.Equal(t, common.BytesToAddress(caller.Local).Bytes(), root.From)
+	require.Equal(t, []byte(contract.Local), root.To)
+	require.Empty(t, root.Error)
+	require.Len(t, root.Calls, 2)
+
+	call := root.Calls[0]
+	require.Equal(t, "CALL", call.Type)
+	require.Equal(t, []byte(contract.Local), call.From)
+	require.Equal(t, []byte(callee.Local), call.To)
+	require.Equal(t, common.LeftPadBytes([]byte{0x2a}, 32), call.Output)
+	require.Empty(t, call.Error)
+	require.Len(t, call.Calls, 1)
+
+	reverted := call.Calls[0]
+	require.Equal(t, "CALL", reverted.Type)
+	require.Equal(t, []byte(callee.Local), reverted.From)
+	require.Equal(t, []byte(reverter.Local), reverted.To)
+	require.Equal(t, "execution reverted", reverted.Error)
+	require.Empty(t, reverted.Output)
+	require.Empty(t, reverted.Calls)
+
+	created := root.Calls[1]
+	require.Equal(t, "CREATE", created.Type)
+	require.Equal(t, []byte(contract.Local), created.From)
+	require.Equal(t, initCode, created.Input)
+	require.Len(t, created.To, common.AddressLength)
+	require.Empty(t, created.Output)
+	require.Empty(t, created.Error)
+}
+
+// deployRuntimeCode deploys a contract with the given runtime code and returns its address.
+func deployRuntimeCode(t *testing.T, levm *LoomEvm, caller loom.Address, runtimeCode []byte) loom.Address {
+	// init code that copies the runtime code that follows it into memory & returns it
+	initCode := []byte{
+		byte(vm.PUSH1), byte(len(runtimeCode)), byte(vm.PUSH1), 12, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), byte(len(runtimeCode)), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	}
+	_, addr, err := levm.Create(caller, append(initCode, runtimeCode...), nil)
+	require.NoError(t, err)
+	return addr
+}
+
+// callCode returns code that calls the given address without any input or value, copies up to
+// outSize bytes of the output to memory offset zero, and discards the call result.
+func callCode(to loom.LocalAddress, outSize byte) []byte {
+	code := []byte{
+		byte(vm.PUSH1), outSize, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH1), 0, byte(vm.PUSH20),
+	}
+	code = append(code, common.BytesToAddress(to).Bytes()...)
+	return append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP))
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/loomnetwork/loomchain"
 	"github.com/loomnetwork/loomchain/features"
 	"github.com/loomnetwork/loomchain/log"
+	evmaux "github.com/loomnetwork/loomchain/store/evm_aux"
 )
 
 // EVMEnabled indicates whether or not Loom EVM integration is available
@@ -145,6 +146,8 @@ type Evm struct {
 	vmConfig        vm.Config
 	validateTxValue bool
 	gasLimit        uint64
+	callTracer      *callTracer
+	gasMeter        *loomchain.GasMeter
 }
 
 func NewEvm(sdb vm.StateDB, lstate loomchain.State, abm *evmAccountBalanceManager, debug bool) *Evm {
@@ -159,6 +162,7 @@ func NewEvm(sdb vm.StateDB, lstate loomchain.State, abm *evmAccountBalanceManage
 
 	p.vmConfig = defaultVmConfig(debug)
 	p.validateTxValue = lstate.FeatureEnabled(features.CheckTxValueFeature, false)
+	p.gasMeter = loomchain.GasMeterFromContext(lstate.Context())
 	p.context = vm.Context{
 		CanTransfer: core.CanTransfer,
 		Transfer:    core.Transfer,
@@ -183,6 +187,23 @@ func NewEvm(sdb vm.StateDB, lstate loomchain.State, abm *evmAccountBalanceManage
 	return p
 }
 
+// EnableCallTracing makes the EVM record the tree of internal message calls & contract creations
+// made by each subsequent Call/Create.
+func (e *Evm) EnableCallTracing() {
+	e.callTracer = newCallTracer()
+	e.vmConfig.Debug = true
+	e.vmConfig.Tracer = e.callTracer
+}
+
+// CallTrace returns the call tree recorded during the last Call/Create, or nil if call tracing
+// isn't enabled.
+func (e Evm) CallTrace() *evmaux.CallFrame {
+	if e.callTracer == nil {
+		return nil
+	}
+	return e.callTracer.Result()
+}
+
 func (e Evm) Create(caller loom.Address, code []byte, value *loom.BigUInt) ([]byte, loom.Address, error) {
 	var err error
 	var usedGas uint64
@@ -208,6 +229,9 @@ func (e Evm) Create(caller loom.Address, code []byte, value *loom.BigUInt) ([]by
 
 	runCode, address, leftOverGas, err := vmenv.Create(vm.AccountRef(origin), code, e.gasLimit, val)
 	usedGas = e.gasLimit - leftOverGas
+	if e.gasMeter != nil {
+		e.gasMeter.ConsumeGas(usedGas)
+	}
 	loomAddress := loom.Address{
 		ChainID: caller.ChainID,
 		Local:   address.Bytes(),
@@ -244,6 +268,9 @@ func (e Evm) Call(caller, addr loom.Address, input []byte, value *loom.BigUInt)
 	}
 	ret, leftOverGas, err := vmenv.Call(vm.AccountRef(origin), contract, input, e.gasLimit, val)
 	usedGas = e.gasLimit - leftOverGas
+	if e.gasMeter != nil {
+		e.gasMeter.ConsumeGas(usedGas)
+	}
 	return ret, err
 }
 
@@ -109,6 +109,7 @@ var LoomVmFactory = func(state loomchain.State) (vm.VM, error) {
 		eventHandler,
 		handler.DefaultMaxReceipts,
 		nil,
+		nil,
 	)
 	receiptHandler := receiptHandlerProvider.Writer()
 	return NewLoomVm(state, eventHandler, receiptHandler, nil, debug), nil
@@ -155,6 +156,9 @@ func (lvm LoomVm) Create(caller loom.Address, code []byte, value *loom.BigUInt)
 	if err != nil {
 		return nil, loom.Address{}, err
 	}
+	if lvm.receiptHandler != nil && lvm.receiptHandler.CallTracingEnabled() {
+		levm.EnableCallTracing()
+	}
 	bytecode, addr, err := levm.Create(caller, code, value)
 	if err == nil {
 		_, err = levm.Commit()
@@ -191,6 +195,8 @@ func (lvm LoomVm) Create(caller loom.Address, code []byte, value *loom.BigUInt)
 		txHash, errSaveReceipt = lvm.receiptHandler.CacheReceipt(lvm.state, caller, addr, events, err, txHash)
 		if errSaveReceipt != nil {
 			err = errors.Wrapf(err, "failed to create tx receipt: %v", errSaveReceipt)
+		} else if trace := levm.CallTrace(); trace != nil {
+			lvm.receiptHandler.CacheCallTrace(trace)
 		}
 	}
 
@@ -218,6 +224,9 @@ func (lvm LoomVm) Call(caller, addr loom.Address, input []byte, value *loom.BigU
 	if err != nil {
 		return nil, err
 	}
+	if lvm.receiptHandler != nil && lvm.receiptHandler.CallTracingEnabled() {
+		levm.EnableCallTracing()
+	}
 	_, err = levm.Call(caller, addr, input, value)
 	if err == nil {
 		_, err = levm.Commit()
@@ -255,6 +264,8 @@ func (lvm LoomVm) Call(caller, addr loom.Address, input []byte, value *loom.BigU
 		txHash, errSaveReceipt = lvm.receiptHandler.CacheReceipt(lvm.state, caller, addr, events, err, txHash)
 		if errSaveReceipt != nil {
 			err = errors.Wrapf(err, "failed to create tx receipt: %v", errSaveReceipt)
+		} else if trace := levm.CallTrace(); trace != nil {
+			lvm.receiptHandler.CacheCallTrace(trace)
 		}
 	}
 
@@ -38,6 +38,41 @@ const (
 	// Enables stricter chain-specific signature verification in MultiChainSignatureTxMiddleware
 	MultiChainSigTxMiddlewareVersion1_1 = "mw:mulcsigtx:v1.1"
 
+	// Enables the on-chain signature type flags, which override the signature types specified in
+	// the loom.yml for a chain ID when at least one of the flags for that chain ID is enabled.
+	AuthSigTypesFeature = "auth:sigtypes:v1.0"
+
+	// Allows txs from a chain ID to be signed with a particular signature type once
+	// auth:sigtypes:v1.0 is enabled, there's a feature flag per chain ID & signature type,
+	// e.g. auth:sigtype:eth:geth, auth:sigtype:tron:tron
+	AuthSigTypeFeaturePrefix = "auth:sigtype:"
+
+	// Enables processing of txs signed by the members of a multisig account.
+	// NOTE: The Multisig contract must be loaded & deployed first!
+	MultisigAccountFeature = "auth:multisig"
+
+	// Enables processing of txs signed by session keys in MultiChainSignatureTxMiddleware.
+	// NOTE: The SessionKeys contract must be loaded & deployed first!
+	SessionKeysFeature = "auth:session-keys"
+
+	// Enforces the chain ID & expiry height of txs wrapped in a BoundNonceTx.
+	TxBindingFeature = "auth:tx-binding"
+
+	// Enables the collection of tx fees by the TxFeeMiddleware.
+	// NOTE: The TxFees contract must be loaded & deployed first!
+	TxFeesFeature = "tx:fees"
+
+	// Enables the BlockTxLimiterMiddleware, which enforces per-account tx limits in DeliverTx.
+	BlockTxLimiterFeature = "tx:block-limiter"
+
+	// Enables processing of MetaTx(s), which allow relayers to submit txs on behalf of users.
+	MetaTxFeature = "auth:meta-tx"
+
+	// Enables on-chain governance, proposals that pass are executed automatically, and the DPOS,
+	// ChainConfig, Karma & DeployerWhitelist contracts accept admin calls from the Governance contract.
+	// NOTE: The Governance contract must be loaded & deployed first!
+	GovernanceFeature = "governance"
+
 	// Enables DPOS v3
 	// NOTE: The DPOS v3 contract must be loaded & deployed first!
 	DPOSVersion3Feature = "dpos:v3"
@@ -61,6 +96,20 @@ const (
 	DPOSVersion3_9 = "dpos:v3.9"
 	// Makes it possible for the oracle to call Redelegate & UnregisterCandidate
 	DPOSVersion3_10 = "dpos:v3.10"
+	// Enables slashing & jailing of validators that double-sign blocks
+	DPOSVersion3_11 = "dpos:v3.11"
+	// Enables the DPOSv3 election & rewards history ledger
+	DPOSVersion3_13 = "dpos:v3.13"
+	// Enables candidate commission limits, which cap the fee a candidate can set with ChangeFee
+	DPOSVersion3_14 = "dpos:v3.14"
+	// Enables the DPOSv3 unbonding queue, which allows unbonding to be scheduled & cancelled
+	DPOSVersion3_15 = "dpos:v3.15"
+	// Enables DPOSv3 validator key rotation, which lets a candidate switch to a new consensus key
+	// without re-registering
+	DPOSVersion3_16 = "dpos:v3.16"
+	// Enables the DPOSv3 per-validator & per-delegator delegation indices, which are used to page
+	// through delegations
+	DPOSVersion3_17 = "dpos:v3.17"
 
 	// Enables rewards to be distributed even when a delegator owns less than 0.01% of the validator's stake
 	// Also makes whitelists give bonuses correctly if whitelist locktime tier is set to be 0-3 (else defaults to 5%)
@@ -102,6 +151,9 @@ const (
 	// deleting them.
 	UserDeployerWhitelistVersion1_2Feature = "userdeploy-wl:v1.2"
 
+	// Enables per-method tx limits & gas budgets for tiers in the User Deployer Whitelist contract
+	UserDeployerWhitelistVersion1_3Feature = "userdeploy-wl:v1.3"
+
 	// Enables processing of MigrationTx.
 	MigrationTxFeature = "tx:migration"
 
@@ -0,0 +1,37 @@
+package loomchain
+
+import (
+	"context"
+)
+
+type gasMeterContextKey struct{}
+
+// GasMeter accumulates the gas consumed by the VMs while a single tx is being processed.
+type GasMeter struct {
+	gasUsed uint64
+}
+
+// ConsumeGas adds the given amount of gas to the total consumed by the current tx.
+func (m *GasMeter) ConsumeGas(amount uint64) {
+	m.gasUsed += amount
+}
+
+// GasUsed returns the total amount of gas consumed by the current tx so far.
+func (m *GasMeter) GasUsed() uint64 {
+	return m.gasUsed
+}
+
+// WithGasMeter returns a copy of the context that carries the given gas meter, VMs that find a gas
+// meter in the state context will report the gas consumed by each call to it.
+func WithGasMeter(ctx context.Context, meter *GasMeter) context.Context {
+	return context.WithValue(ctx, gasMeterContextKey{}, meter)
+}
+
+// GasMeterFromContext returns the gas meter stored in the context, or nil if there isn't one.
+func GasMeterFromContext(ctx context.Context) *GasMeter {
+	if ctx == nil {
+		return nil
+	}
+	meter, _ := ctx.Value(gasMeterContextKey{}).(*GasMeter)
+	return meter
+}
@@ -0,0 +1,51 @@
+package plugin
+
+import (
+	"github.com/loomnetwork/go-loom"
+	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/builtin/plugins/governance"
+	regcommon "github.com/loomnetwork/loomchain/registry"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrGovernanceContractNotFound indicates that the Governance contract hasn't been deployed yet.
+	ErrGovernanceContractNotFound = errors.New("[GovernanceHandler] Governance contract not found")
+)
+
+// GovernanceHandler implements loomchain.GovernanceHandler interface
+type GovernanceHandler struct {
+	ctx contract.Context
+}
+
+var _ loomchain.GovernanceHandler = &GovernanceHandler{}
+
+// NewGovernanceHandler attempts to create an instance of GovernanceHandler.
+func NewGovernanceHandler(pvm *PluginVM) (*GovernanceHandler, error) {
+	caller := loom.RootAddress(pvm.State.Block().ChainID)
+	contractAddr, err := pvm.Registry.Resolve(governance.ContractName)
+	if err != nil {
+		if err == regcommon.ErrNotFound {
+			return nil, ErrGovernanceContractNotFound
+		}
+		return nil, err
+	}
+	readOnly := false
+	ctx := contract.WrapPluginContext(pvm.CreateContractContext(caller, contractAddr, readOnly))
+	return &GovernanceHandler{
+		ctx: ctx,
+	}, nil
+}
+
+func (h *GovernanceHandler) TallyProposals() ([]uint64, error) {
+	return governance.TallyProposals(h.ctx)
+}
+
+func (h *GovernanceHandler) ExecuteProposal(id uint64) error {
+	return governance.ExecuteProposal(h.ctx, id)
+}
+
+func (h *GovernanceHandler) SetProposalFailed(id uint64, reason error) error {
+	return governance.SetProposalFailed(h.ctx, id, reason)
+}
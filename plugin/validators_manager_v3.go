@@ -1,8 +1,10 @@
 package plugin
 
 import (
+	"crypto/sha256"
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/loomnetwork/go-loom"
 	contract "github.com/loomnetwork/go-loom/plugin/contractpb"
 	types "github.com/loomnetwork/go-loom/types"
@@ -82,6 +84,8 @@ func (m *ValidatorsManagerV3) BeginBlock(req abci.RequestBeginBlock, currentHeig
 		}
 	}
 
+	doubleSignSlashingEnabled := m.ctx.FeatureEnabled(features.DPOSVersion3_11, false)
+
 	for _, evidence := range req.ByzantineValidators {
 		// DuplicateVoteEvidence is the only type of evidence currently
 		// implemented in tendermint but we don't get access to this via the
@@ -89,12 +93,28 @@ func (m *ValidatorsManagerV3) BeginBlock(req abci.RequestBeginBlock, currentHeig
 		// The conflicting vote data is kept within the consensus engine itself.
 		m.ctx.Logger().Debug("DPOS BeginBlock", "ByzantineEvidence", fmt.Sprintf("%v+", evidence))
 
-		if evidence.Height > (currentHeight - 100) {
-			m.ctx.Logger().Debug("DPOS BeginBlock Byzantine Slashing", "FreshEvidenceHeight", evidence.Height, "CurrentHeight", currentHeight)
-			//err := m.SlashDoubleSign(evidence.Validator.Address)
-			//if err != nil {
-			//	return err
-			//}
+		if !doubleSignSlashingEnabled || evidence.Type != tmtypes.ABCIEvidenceTypeDuplicateVote {
+			continue
+		}
+
+		address, err := dposv3.GetLocalCandidateAddressFromTendermintAddress(
+			m.ctx, evidence.Validator.Address, candidates,
+		)
+		if err != nil {
+			m.ctx.Logger().Error(
+				"DPOS BeginBlock failed to find candidate for byzantine validator",
+				"validator", fmt.Sprintf("%X", evidence.Validator.Address),
+				"err", err,
+			)
+			continue
+		}
+
+		evidenceHash, err := hashEvidence(evidence)
+		if err != nil {
+			return err
+		}
+		if err := dposv3.SlashDoubleSignEvidence(m.ctx, evidenceHash, address); err != nil {
+			return err
 		}
 	}
 
@@ -136,7 +156,8 @@ func (m *ValidatorsManagerV3) EndBlock(req abci.RequestEndBlock) ([]abci.Validat
 	var validators []abci.ValidatorUpdate
 
 	// Clearing current validators by passing in list of zero-power update to
-	// tendermint.
+	// tendermint. This also removes the old keys of any validators whose keys were rotated in this
+	// election, so the old & new keys are swapped in the same validator set update.
 	removedValidators := dposv3.MissingValidators(oldValidatorList, validatorList)
 	for _, validator := range removedValidators {
 		validators = append(validators, abci.ValidatorUpdate{
@@ -163,3 +184,15 @@ func (m *ValidatorsManagerV3) EndBlock(req abci.RequestEndBlock) ([]abci.Validat
 
 	return validators, nil
 }
+
+// hashEvidence computes a hash that uniquely identifies a piece of evidence, tendermint may report
+// the same evidence more than once so this hash is used to avoid slashing a validator repeatedly
+// for a single offence.
+func hashEvidence(evidence abci.Evidence) ([]byte, error) {
+	data, err := proto.Marshal(&evidence)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(data)
+	return hash[:], nil
+}
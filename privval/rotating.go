@@ -0,0 +1,149 @@
+package privval
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/types"
+)
+
+// RotatingPV switches a validator node from its current consensus key to the next key at the
+// height at which the next key replaces the current one in the validator set. The switch height is
+// detected from the validator updates of each block, see EndBlock, and persisted so the node keeps
+// using the right key across restarts. Once the switch height has passed the next key should
+// become the node's priv validator.
+type RotatingPV struct {
+	mtx          sync.RWMutex
+	current      PrivValidator
+	next         PrivValidator
+	nextPubKey   abci.PubKey
+	stateFile    string
+	height       int64
+	switchHeight int64
+}
+
+type rotationState struct {
+	SwitchHeight int64 `json:"switch_height"`
+	// The state only applies to the next key it was saved for
+	NextPubKey []byte `json:"next_pub_key"`
+}
+
+var _ PrivValidator = &RotatingPV{}
+
+// NewRotatingPV creates a RotatingPV that persists the switch height to stateFile, if the file
+// already exists, and was saved for the same next key, the switch height is loaded from it.
+// lastHeight must be the height of the last block committed by the node.
+func NewRotatingPV(current, next PrivValidator, stateFile string, lastHeight int64) (*RotatingPV, error) {
+	pv := &RotatingPV{
+		current:    current,
+		next:       next,
+		nextPubKey: types.TM2PB.PubKey(next.GetPubKey()),
+		stateFile:  stateFile,
+		height:     lastHeight + 1,
+	}
+	data, err := ioutil.ReadFile(stateFile)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		var state rotationState
+		if err := json.Unmarshal(data, &state); err != nil {
+			return nil, err
+		}
+		// A state file left over from a previous rotation doesn't apply to a new next key
+		if bytes.Equal(state.NextPubKey, pv.nextPubKey.Data) {
+			pv.switchHeight = state.SwitchHeight
+		}
+	}
+	return pv, nil
+}
+
+// Returns the priv validator that should be used to sign at the given height.
+func (pv *RotatingPV) signer(height int64) PrivValidator {
+	if pv.switchHeight > 0 && height >= pv.switchHeight {
+		return pv.next
+	}
+	return pv.current
+}
+
+// EndBlock must be called with the validator updates returned at the end of each block. Validator
+// updates returned at the end of block H take effect at H+2, so if the updates add the next key to
+// the validator set the node switches to it from H+2 onwards.
+func (pv *RotatingPV) EndBlock(height int64, updates []abci.ValidatorUpdate) error {
+	pv.mtx.Lock()
+	defer pv.mtx.Unlock()
+
+	// The consensus engine only moves on to the next height after the block has been committed
+	pv.height = height + 1
+	if pv.switchHeight > 0 {
+		return nil
+	}
+	for _, update := range updates {
+		if update.Power > 0 && bytes.Equal(update.PubKey.Data, pv.nextPubKey.Data) {
+			pv.switchHeight = height + 2
+			data, err := json.Marshal(&rotationState{
+				SwitchHeight: pv.switchHeight,
+				NextPubKey:   pv.nextPubKey.Data,
+			})
+			if err != nil {
+				return err
+			}
+			return cmn.WriteFileAtomic(pv.stateFile, data, 0600)
+		}
+	}
+	return nil
+}
+
+// SwitchHeight returns the height from which the next key is used, or zero if it's not known yet.
+func (pv *RotatingPV) SwitchHeight() int64 {
+	pv.mtx.RLock()
+	defer pv.mtx.RUnlock()
+
+	return pv.switchHeight
+}
+
+func (pv *RotatingPV) GetPubKey() crypto.PubKey {
+	pv.mtx.RLock()
+	defer pv.mtx.RUnlock()
+
+	return pv.signer(pv.height).GetPubKey()
+}
+
+func (pv *RotatingPV) GetAddress() types.Address {
+	pv.mtx.RLock()
+	defer pv.mtx.RUnlock()
+
+	return pv.signer(pv.height).GetAddress()
+}
+
+func (pv *RotatingPV) SignVote(chainID string, vote *types.Vote) error {
+	pv.mtx.RLock()
+	signer := pv.signer(vote.Height)
+	pv.mtx.RUnlock()
+
+	return signer.SignVote(chainID, vote)
+}
+
+func (pv *RotatingPV) SignProposal(chainID string, proposal *types.Proposal) error {
+	pv.mtx.RLock()
+	signer := pv.signer(proposal.Height)
+	pv.mtx.RUnlock()
+
+	return signer.SignProposal(chainID, proposal)
+}
+
+func (pv *RotatingPV) Save() {
+	pv.current.Save()
+	pv.next.Save()
+}
+
+func (pv *RotatingPV) Reset(height int64) {
+	pv.current.Reset(height)
+	pv.next.Reset(height)
+}
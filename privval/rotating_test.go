@@ -0,0 +1,64 @@
+package privval
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/types"
+)
+
+func TestRotatingPV(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotating-pv")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	current, err := GenFilePV(filepath.Join(dir, "priv_validator.json"))
+	require.NoError(t, err)
+	next, err := GenFilePV(filepath.Join(dir, "next_priv_validator.json"))
+	require.NoError(t, err)
+	stateFile := filepath.Join(dir, "priv_validator_rotation.json")
+
+	pv, err := NewRotatingPV(current, next, stateFile, 0)
+	require.NoError(t, err)
+	require.Equal(t, current.GetAddress(), pv.GetAddress())
+
+	// updates that don't add the next key don't schedule a switch
+	currentPubKey := types.TM2PB.PubKey(current.GetPubKey())
+	nextPubKey := types.TM2PB.PubKey(next.GetPubKey())
+	require.NoError(t, pv.EndBlock(9, []abci.ValidatorUpdate{{PubKey: currentPubKey, Power: 10}}))
+	require.Equal(t, int64(0), pv.SwitchHeight())
+
+	require.NoError(t, pv.EndBlock(10, []abci.ValidatorUpdate{
+		{PubKey: currentPubKey, Power: 0},
+		{PubKey: nextPubKey, Power: 10},
+	}))
+	require.Equal(t, int64(12), pv.SwitchHeight())
+	// the validator set at height 11 still contains the current key
+	require.Equal(t, current.GetAddress(), pv.GetAddress())
+	require.NoError(t, pv.EndBlock(11, nil))
+	require.Equal(t, next.GetAddress(), pv.GetAddress())
+	require.Equal(t, next.GetPubKey(), pv.GetPubKey())
+
+	// the switch height survives restarts
+	pv, err = NewRotatingPV(current, next, stateFile, 11)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), pv.SwitchHeight())
+	require.Equal(t, next.GetAddress(), pv.GetAddress())
+
+	// restarting before the switch height keeps using the current key
+	pv, err = NewRotatingPV(current, next, stateFile, 10)
+	require.NoError(t, err)
+	require.Equal(t, current.GetAddress(), pv.GetAddress())
+
+	// the state file is ignored once the node is given another next key
+	other, err := GenFilePV(filepath.Join(dir, "other_priv_validator.json"))
+	require.NoError(t, err)
+	pv, err = NewRotatingPV(current, other, stateFile, 11)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), pv.SwitchHeight())
+	require.Equal(t, current.GetAddress(), pv.GetAddress())
+}
@@ -6,6 +6,7 @@ import (
 	eth_types "github.com/ethereum/go-ethereum/core/types"
 	"github.com/loomnetwork/go-loom"
 	"github.com/loomnetwork/go-loom/plugin/types"
+	evmaux "github.com/loomnetwork/loomchain/store/evm_aux"
 )
 
 type WriteReceiptHandler interface {
@@ -15,4 +16,9 @@ type WriteReceiptHandler interface {
 	CacheReceipt(
 		state State, caller, addr loom.Address, events []*types.EventData, err error, txHash []byte,
 	) ([]byte, error)
+	// CallTracingEnabled returns true if the tree of internal calls made by each EVM tx should be
+	// recorded.
+	CallTracingEnabled() bool
+	// CacheCallTrace attaches the call tree of an EVM call to the current receipt.
+	CacheCallTrace(trace *evmaux.CallFrame)
 }
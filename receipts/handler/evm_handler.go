@@ -7,6 +7,7 @@ import (
 
 	"github.com/loomnetwork/go-loom"
 	"github.com/loomnetwork/go-loom/plugin/types"
+	evmaux "github.com/loomnetwork/loomchain/store/evm_aux"
 )
 
 func (r *ReceiptHandler) GetEventsFromLogs(
@@ -34,3 +35,18 @@ func (r *ReceiptHandler) GetEventsFromLogs(
 	}
 	return events
 }
+
+func (r *ReceiptHandler) CallTracingEnabled() bool {
+	return r.callTracingEnabled
+}
+
+// CacheCallTrace attaches the call tree of an EVM call to the current receipt, if there's no current
+// receipt the trace is discarded.
+func (r *ReceiptHandler) CacheCallTrace(trace *evmaux.CallFrame) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.currentReceipt != nil {
+		r.currentCallTraces = append(r.currentCallTraces, trace)
+	}
+}
@@ -32,6 +32,12 @@ type ReceiptHandler struct {
 	receiptsCache  []*types.EvmTxReceipt
 	txHashList     [][]byte
 	currentReceipt *types.EvmTxReceipt
+
+	callTracingEnabled bool
+	// call traces of the current receipt
+	currentCallTraces []*evmaux.CallFrame
+	// call traces of the receipts in receiptsCache, keyed by tx hash
+	callTracesCache map[string][]*evmaux.CallFrame
 }
 
 func NewReceiptHandler(
@@ -46,9 +52,23 @@ func NewReceiptHandler(
 		mutex:           &sync.RWMutex{},
 		leveldbReceipts: leveldb.NewLevelDbReceipts(evmAuxStore, maxReceipts),
 		evmAuxStore:     evmAuxStore,
+		callTracesCache: map[string][]*evmaux.CallFrame{},
 	}
 }
 
+// WithCallTracing enables or disables recording of the internal calls made by EVM txs.
+func (r *ReceiptHandler) WithCallTracing(enabled bool) *ReceiptHandler {
+	r.callTracingEnabled = enabled
+	return r
+}
+
+// WithRetention sets the policies that determine which receipts should be retained after they're
+// evicted from the receipts DB.
+func (r *ReceiptHandler) WithRetention(cfg *leveldb.RetentionConfig) *ReceiptHandler {
+	r.leveldbReceipts.WithRetention(cfg)
+	return r
+}
+
 // GetReceipt looks up an EVM tx receipt by tx hash.
 // The tx hash can either be the hash of the Tendermint tx within which the EVM tx was embedded or,
 // the hash of the embedded EVM tx itself.
@@ -121,8 +141,12 @@ func (r *ReceiptHandler) CommitCurrentReceipt() {
 	if r.currentReceipt != nil {
 		r.receiptsCache = append(r.receiptsCache, r.currentReceipt)
 		r.txHashList = append(r.txHashList, r.currentReceipt.TxHash)
+		if len(r.currentCallTraces) > 0 {
+			r.callTracesCache[string(r.currentReceipt.TxHash)] = r.currentCallTraces
+		}
 		r.currentReceipt = nil
 	}
+	r.currentCallTraces = nil
 }
 
 func (r *ReceiptHandler) DiscardCurrentReceipt() {
@@ -130,18 +154,30 @@ func (r *ReceiptHandler) DiscardCurrentReceipt() {
 	defer r.mutex.Unlock()
 
 	r.currentReceipt = nil
+	r.currentCallTraces = nil
 }
 
 func (r *ReceiptHandler) CommitBlock(height int64) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	err := r.leveldbReceipts.CommitBlock(r.receiptsCache, uint64(height))
+	err := r.leveldbReceipts.CommitBlock(r.receiptsCache, r.callTracesCache, uint64(height))
 	r.txHashList = [][]byte{}
 	r.receiptsCache = []*types.EvmTxReceipt{}
+	r.callTracesCache = map[string][]*evmaux.CallFrame{}
 	return err
 }
 
+// GetCallTraces looks up the internal call traces of an EVM tx by tx hash.
+// The tx hash can either be the hash of the Tendermint tx within which the EVM tx was embedded or,
+// the hash of the embedded EVM tx itself.
+func (r *ReceiptHandler) GetCallTraces(txHash []byte) ([]*evmaux.CallFrame, error) {
+	if evmTxHash, err := r.evmAuxStore.GetChildTxHash(txHash); len(evmTxHash) > 0 && err == nil {
+		txHash = evmTxHash
+	}
+	return r.evmAuxStore.GetCallTraces(txHash)
+}
+
 // TODO: this doesn't need the entire state passed in, just the block header
 func (r *ReceiptHandler) CacheReceipt(
 	state loomchain.State, caller, addr loom.Address, events []*types.EventData, txErr error, txHash []byte,
@@ -99,6 +99,7 @@ type LevelDbReceipts struct {
 	MaxDbSize   uint64
 	evmAuxStore *evmaux.EvmAuxStore
 	tran        *leveldb.Transaction
+	retention   *RetentionConfig
 }
 
 func NewLevelDbReceipts(evmAuxStore *evmaux.EvmAuxStore, maxReceipts uint64) *LevelDbReceipts {
@@ -109,6 +110,13 @@ func NewLevelDbReceipts(evmAuxStore *evmaux.EvmAuxStore, maxReceipts uint64) *Le
 	}
 }
 
+// WithRetention sets the policies that determine which receipts should be retained after they're
+// evicted from the receipts ring.
+func (lr *LevelDbReceipts) WithRetention(cfg *RetentionConfig) *LevelDbReceipts {
+	lr.retention = cfg
+	return lr
+}
+
 func (lr LevelDbReceipts) Close() error {
 	if lr.evmAuxStore != nil {
 		return lr.evmAuxStore.Close()
@@ -116,7 +124,9 @@ func (lr LevelDbReceipts) Close() error {
 	return nil
 }
 
-func (lr *LevelDbReceipts) CommitBlock(receipts []*types.EvmTxReceipt, height uint64) error {
+func (lr *LevelDbReceipts) CommitBlock(
+	receipts []*types.EvmTxReceipt, callTraces map[string][]*evmaux.CallFrame, height uint64,
+) error {
 	if len(receipts) == 0 {
 		return nil
 	}
@@ -205,7 +215,7 @@ func (lr *LevelDbReceipts) CommitBlock(receipts []*types.EvmTxReceipt, height ui
 
 	if lr.MaxDbSize < size {
 		var numDeleted uint64
-		headHash, numDeleted, err = removeOldEntries(lr.tran, headHash, size-lr.MaxDbSize)
+		headHash, numDeleted, err = lr.removeOldEntries(headHash, size-lr.MaxDbSize)
 		if err != nil {
 			return errors.Wrap(err, "removing old receipts")
 		}
@@ -214,6 +224,11 @@ func (lr *LevelDbReceipts) CommitBlock(receipts []*types.EvmTxReceipt, height ui
 		}
 		size -= numDeleted
 	}
+	if lr.retention != nil {
+		if err := lr.pruneRetainedReceipts(height); err != nil {
+			return errors.Wrap(err, "pruning retained receipts")
+		}
+	}
 	if err := setDBParams(lr.tran, size, headHash, tailHash); err != nil {
 		return errors.Wrap(err, "saving receipt db params")
 	}
@@ -225,6 +240,10 @@ func (lr *LevelDbReceipts) CommitBlock(receipts []*types.EvmTxReceipt, height ui
 	if err := lr.evmAuxStore.SetBloomFilter(lr.tran, filter, height); err != nil {
 		return errors.Wrap(err, "set bloom filter")
 	}
+	// the call traces are written along with the receipts so neither can be persisted without the other
+	if err := lr.evmAuxStore.SetCallTraces(lr.tran, callTraces); err != nil {
+		return errors.Wrap(err, "set call traces")
+	}
 
 	if err := lr.tran.Commit(); err != nil {
 		return errors.Wrap(err, "committing level db transaction")
@@ -244,10 +263,13 @@ func (lr *LevelDbReceipts) closeTransaction() {
 	}
 }
 
-func removeOldEntries(tran *leveldb.Transaction, head []byte, number uint64) ([]byte, uint64, error) {
+// removeOldEntries removes the given number of receipts from the head of the receipts ring.
+// Receipts that must be retained according to the retention policies are detached from the ring
+// instead of being deleted.
+func (lr *LevelDbReceipts) removeOldEntries(head []byte, number uint64) ([]byte, uint64, error) {
 	itemsDeleted := uint64(0)
 	for i := uint64(0); i < number && len(head) > 0; i++ {
-		headItem, err := tran.Get(head, nil)
+		headItem, err := lr.tran.Get(head, nil)
 		if err != nil {
 			return head, itemsDeleted, errors.Wrapf(err, "get head %s", string(head))
 		}
@@ -255,9 +277,16 @@ func removeOldEntries(tran *leveldb.Transaction, head []byte, number uint64) ([]
 		if err := proto.Unmarshal(headItem, &txHeadReceiptItem); err != nil {
 			return head, itemsDeleted, errors.Wrapf(err, "unmarshal head %s", string(headItem))
 		}
-		tran.Delete(head, nil)
+		nextHead := txHeadReceiptItem.NextTxHash
+		if expiryHeight, retain := lr.retention.retainUntil(txHeadReceiptItem.Receipt); retain {
+			if err := lr.retainReceipt(&txHeadReceiptItem, expiryHeight); err != nil {
+				return head, itemsDeleted, err
+			}
+		} else if err := lr.evictReceipt(head, &txHeadReceiptItem); err != nil {
+			return head, itemsDeleted, errors.Wrapf(err, "evict head %s", string(head))
+		}
 		itemsDeleted++
-		head = txHeadReceiptItem.NextTxHash
+		head = nextHead
 	}
 	if itemsDeleted < number {
 		return head, itemsDeleted, errors.Errorf("Unable to delete %v receipts, only %v deleted", number, itemsDeleted)
@@ -28,7 +28,7 @@ func TestReceiptsCyclicDB(t *testing.T) {
 	receipts1 := common.MakeDummyReceipts(t, 5, height)
 	commit := 1 // number of commits
 	// store 5 receipts
-	require.NoError(t, handler.CommitBlock(receipts1, height))
+	require.NoError(t, handler.CommitBlock(receipts1, nil, height))
 	confirmDbConsistency(t, handler, 5, receipts1[0].TxHash, receipts1[4].TxHash, receipts1, commit)
 	confirmStateConsistency(t, evmAuxStore, receipts1, height)
 	// db reaching max
@@ -36,7 +36,7 @@ func TestReceiptsCyclicDB(t *testing.T) {
 	receipts2 := common.MakeDummyReceipts(t, 7, height)
 	commit = 2
 	// store another 7 receipts
-	require.NoError(t, handler.CommitBlock(receipts2, height))
+	require.NoError(t, handler.CommitBlock(receipts2, nil, height))
 	confirmDbConsistency(t, handler, maxSize, receipts1[2].TxHash, receipts2[6].TxHash, append(receipts1[2:5], receipts2...), commit)
 	confirmStateConsistency(t, evmAuxStore, receipts2, height)
 
@@ -45,7 +45,7 @@ func TestReceiptsCyclicDB(t *testing.T) {
 	receipts3 := common.MakeDummyReceipts(t, 5, height)
 	commit = 3
 	// store another 5 receipts
-	require.NoError(t, handler.CommitBlock(receipts3, height))
+	require.NoError(t, handler.CommitBlock(receipts3, nil, height))
 	confirmDbConsistency(t, handler, maxSize, receipts2[2].TxHash, receipts3[4].TxHash, append(receipts2[2:7], receipts3...), commit)
 	confirmStateConsistency(t, evmAuxStore, receipts3, height)
 
@@ -69,7 +69,7 @@ func TestReceiptsCommitAllInOneBlock(t *testing.T) {
 	receipts1 := common.MakeDummyReceipts(t, maxSize+1, height)
 	commit := 1
 	// store 11 receipts, which is more than max that can be stored
-	require.NoError(t, handler.CommitBlock(receipts1, height))
+	require.NoError(t, handler.CommitBlock(receipts1, nil, height))
 
 	confirmDbConsistency(t, handler, maxSize, receipts1[1].TxHash, receipts1[10].TxHash, receipts1[1:], commit)
 	confirmStateConsistency(t, evmAuxStore, receipts1, height)
@@ -146,7 +146,7 @@ func TestConfirmTransactionReceipts(t *testing.T) {
 	height := uint64(1)
 	receipts1 := common.MakeDummyReceipts(t, 5, height)
 	// store 5 receipts
-	require.NoError(t, handler.CommitBlock(receipts1, height))
+	require.NoError(t, handler.CommitBlock(receipts1, nil, height))
 	txHashes, err := evmAuxStore.GetTxHashList(height)
 	require.NoError(t, err)
 	a := []byte("0xf0675dc27bC62b584Ab2E8E1D483a55CFac9E960")
@@ -173,6 +173,79 @@ func TestConfirmTransactionReceipts(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestReceiptsRetentionPolicies(t *testing.T) {
+	evmAuxStore, err := common.NewMockEvmAuxStore()
+	require.NoError(t, err)
+
+	contractA := []byte{0xa}
+	contractB := []byte{0xb}
+	contractC := []byte{0xc}
+	maxSize := uint64(3)
+	handler := NewLevelDbReceipts(evmAuxStore, maxSize).WithRetention(&RetentionConfig{
+		Policies: map[string]*RetentionPolicy{
+			"0x0a": {Mode: RetentionModeForever},
+			"0x0b": {Mode: RetentionModeFailedOnly},
+			"0x0c": {Mode: RetentionModeMaxAge, MaxAgeBlocks: 2},
+		},
+		ArchiveEvicted: true,
+	})
+
+	height := uint64(1)
+	receipts1 := common.MakeDummyReceipts(t, 5, height)
+	receipts1[0].ContractAddress = contractA
+	receipts1[1].ContractAddress = contractB
+	receipts1[2].ContractAddress = contractB
+	receipts1[2].Status = common.StatusTxFail
+	receipts1[3].ContractAddress = contractC
+	require.NoError(t, handler.CommitBlock(receipts1, nil, height))
+
+	size, head, _, err := getDBParams(evmAuxStore)
+	require.NoError(t, err)
+	require.Equal(t, maxSize, size)
+	require.Equal(t, receipts1[2].TxHash, head)
+	// receipt for contract A should've been retained, but not the successful tx receipt for contract B
+	_, err = handler.GetReceipt(receipts1[0].TxHash)
+	require.NoError(t, err)
+	_, err = handler.GetReceipt(receipts1[1].TxHash)
+	require.Error(t, err)
+	has, err := evmAuxStore.DB().Has(EvictedReceiptKey(height, receipts1[1].TxHash), nil)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	height = 2
+	receipts2 := common.MakeDummyReceipts(t, 3, height)
+	require.NoError(t, handler.CommitBlock(receipts2, nil, height))
+	// failed tx receipt for contract B & receipt for contract C should've been retained
+	_, err = handler.GetReceipt(receipts1[2].TxHash)
+	require.NoError(t, err)
+	_, err = handler.GetReceipt(receipts1[3].TxHash)
+	require.NoError(t, err)
+	_, err = handler.GetReceipt(receipts1[4].TxHash)
+	require.Error(t, err)
+
+	height = 3
+	receipts3 := common.MakeDummyReceipts(t, 1, height)
+	require.NoError(t, handler.CommitBlock(receipts3, nil, height))
+	// receipt for contract C should've expired
+	_, err = handler.GetReceipt(receipts1[3].TxHash)
+	require.Error(t, err)
+	has, err = evmAuxStore.DB().Has(EvictedReceiptKey(1, receipts1[3].TxHash), nil)
+	require.NoError(t, err)
+	require.True(t, has)
+	_, err = handler.GetReceipt(receipts1[0].TxHash)
+	require.NoError(t, err)
+	_, err = handler.GetReceipt(receipts1[2].TxHash)
+	require.NoError(t, err)
+
+	size, head, _, err = getDBParams(evmAuxStore)
+	require.NoError(t, err)
+	require.Equal(t, maxSize, size)
+	require.Equal(t, receipts2[1].TxHash, head)
+
+	require.NoError(t, handler.Close())
+	handler.ClearData()
+}
+
 //nolint:deadcode
 func dumpDbEntries(evmAuxStore *evmaux.EvmAuxStore) error {
 	fmt.Println("\nDumping leveldb")
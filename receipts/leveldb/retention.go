@@ -0,0 +1,239 @@
+package leveldb
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain/receipts/common"
+	evmaux "github.com/loomnetwork/loomchain/store/evm_aux"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	goutil "github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// Receipts are retained indefinitely after they're evicted from the receipts ring.
+	RetentionModeForever = "forever"
+	// Receipts are retained for a fixed number of blocks after they're evicted from the receipts ring.
+	RetentionModeMaxAge = "max-age"
+	// Only receipts of failed txs are retained after they're evicted from the receipts ring.
+	RetentionModeFailedOnly = "failed-only"
+)
+
+var (
+	// Prefix of keys that track when retained receipts should be evicted,
+	// key format: retainedPrefix | expiry height | tx hash
+	retainedPrefix = []byte("rr")
+	// Prefix of keys under which evicted receipts are archived until they're exported,
+	// key format: EvictedReceiptPrefix | block height | tx hash
+	EvictedReceiptPrefix = []byte("ev")
+)
+
+// RetentionPolicy determines what happens to the receipts generated by calls to a particular
+// contract once they're evicted from the receipts ring (which only holds the most recent
+// EVMPersistentTxReceiptsMax receipts).
+type RetentionPolicy struct {
+	// forever | max-age | failed-only
+	Mode string
+	// Number of blocks receipts should be retained for, measured from the block the receipt was
+	// created in. Required by the max-age mode, optional for the failed-only mode (zero means the
+	// receipts of failed txs are retained indefinitely).
+	MaxAgeBlocks uint64
+}
+
+type RetentionConfig struct {
+	// Retention policies keyed by hex-encoded contract address (e.g. 0x1234...).
+	Policies map[string]*RetentionPolicy
+	// If true receipts evicted from the receipts DB are moved into an archive area from which
+	// they can be exported via the `loom db receipts export` command, otherwise they're deleted.
+	ArchiveEvicted bool
+}
+
+func DefaultRetentionConfig() *RetentionConfig {
+	return &RetentionConfig{
+		ArchiveEvicted: false,
+	}
+}
+
+// Clone returns a deep clone of the config.
+func (c *RetentionConfig) Clone() *RetentionConfig {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	if c.Policies != nil {
+		clone.Policies = make(map[string]*RetentionPolicy, len(c.Policies))
+		for addr, policy := range c.Policies {
+			if policy == nil {
+				clone.Policies[addr] = nil
+				continue
+			}
+			p := *policy
+			clone.Policies[addr] = &p
+		}
+	}
+	return &clone
+}
+
+// Validate checks all the retention policies are well formed.
+func (c *RetentionConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for addr, policy := range c.Policies {
+		if policy == nil {
+			return errors.Errorf("missing receipt retention policy for %s", addr)
+		}
+		switch policy.Mode {
+		case RetentionModeForever, RetentionModeFailedOnly:
+		case RetentionModeMaxAge:
+			if policy.MaxAgeBlocks == 0 {
+				return errors.Errorf("MaxAgeBlocks must be specified in receipt retention policy for %s", addr)
+			}
+		default:
+			return errors.Errorf("invalid receipt retention mode %s for %s", policy.Mode, addr)
+		}
+	}
+	return nil
+}
+
+func (c *RetentionConfig) policyFor(contractAddr []byte) *RetentionPolicy {
+	if c == nil || len(c.Policies) == 0 {
+		return nil
+	}
+	key := "0x" + hex.EncodeToString(contractAddr)
+	if policy, ok := c.Policies[key]; ok {
+		return policy
+	}
+	// viper lowercases map keys, but the config may have been constructed in code
+	for addr, policy := range c.Policies {
+		if strings.EqualFold(addr, key) {
+			return policy
+		}
+	}
+	return nil
+}
+
+// retainUntil determines whether the given receipt should be retained after it's evicted from the
+// receipts ring. If the receipt should be retained the returned height will be the height at which
+// it should be evicted, or zero if it should never be evicted.
+func (c *RetentionConfig) retainUntil(receipt *types.EvmTxReceipt) (uint64, bool) {
+	if receipt == nil {
+		return 0, false
+	}
+	policy := c.policyFor(receipt.ContractAddress)
+	if policy == nil {
+		return 0, false
+	}
+	switch policy.Mode {
+	case RetentionModeForever:
+		return 0, true
+	case RetentionModeMaxAge:
+		return uint64(receipt.BlockNumber) + policy.MaxAgeBlocks, true
+	case RetentionModeFailedOnly:
+		if receipt.Status == common.StatusTxSuccess {
+			return 0, false
+		}
+		if policy.MaxAgeBlocks > 0 {
+			return uint64(receipt.BlockNumber) + policy.MaxAgeBlocks, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+func retainedReceiptKey(expiryHeight uint64, txHash []byte) []byte {
+	return util.PrefixKey(retainedPrefix, heightKey(expiryHeight), txHash)
+}
+
+// EvictedReceiptKey returns the key under which an evicted receipt is archived.
+func EvictedReceiptKey(height uint64, txHash []byte) []byte {
+	return util.PrefixKey(EvictedReceiptPrefix, heightKey(height), txHash)
+}
+
+func heightKey(height uint64) []byte {
+	heightB := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightB, height)
+	return heightB
+}
+
+// retainReceipt detaches the given receipt list item from the receipts ring, the item is left in
+// the DB so it can still be looked up by tx hash.
+func (lr *LevelDbReceipts) retainReceipt(item *types.EvmTxReceiptListItem, expiryHeight uint64) error {
+	item.NextTxHash = nil
+	itemProto, err := proto.Marshal(item)
+	if err != nil {
+		return errors.Wrap(err, "marshal retained receipt")
+	}
+	if err := lr.tran.Put(item.Receipt.TxHash, itemProto, nil); err != nil {
+		return errors.Wrap(err, "put retained receipt")
+	}
+	if expiryHeight > 0 {
+		if err := lr.tran.Put(retainedReceiptKey(expiryHeight, item.Receipt.TxHash), []byte{1}, nil); err != nil {
+			return errors.Wrap(err, "put retained receipt expiry")
+		}
+	}
+	return nil
+}
+
+// evictReceipt removes the receipt list item stored under the given tx hash, and archives the
+// receipt if archiving is enabled.
+func (lr *LevelDbReceipts) evictReceipt(txHash []byte, item *types.EvmTxReceiptListItem) error {
+	if lr.retention != nil && lr.retention.ArchiveEvicted && item.Receipt != nil {
+		receiptProto, err := proto.Marshal(item.Receipt)
+		if err != nil {
+			return errors.Wrap(err, "marshal evicted receipt")
+		}
+		key := EvictedReceiptKey(uint64(item.Receipt.BlockNumber), txHash)
+		if err := lr.tran.Put(key, receiptProto, nil); err != nil {
+			return errors.Wrap(err, "archive evicted receipt")
+		}
+	}
+	if err := lr.tran.Delete(evmaux.CallTraceKey(txHash), nil); err != nil {
+		return errors.Wrap(err, "delete evicted call trace")
+	}
+	return lr.tran.Delete(txHash, nil)
+}
+
+// pruneRetainedReceipts evicts all the retained receipts that expire at or before the given height.
+func (lr *LevelDbReceipts) pruneRetainedReceipts(height uint64) error {
+	iter := lr.tran.NewIterator(
+		// the start key includes the separator so tx hashes that start with the prefix are excluded
+		&goutil.Range{Start: util.PrefixKey(retainedPrefix, nil), Limit: retainedReceiptKey(height+1, nil)},
+		nil,
+	)
+	defer iter.Release()
+
+	var expiredKeys [][]byte
+	for iter.Next() {
+		expiredKeys = append(expiredKeys, append([]byte{}, iter.Key()...))
+	}
+	if err := iter.Error(); err != nil {
+		return errors.Wrap(err, "iterate retained receipts")
+	}
+
+	for _, key := range expiredKeys {
+		// skip the prefix, the expiry height, and the separators that follow both
+		txHash := key[len(retainedPrefix)+1+8+1:]
+		itemProto, err := lr.tran.Get(txHash, nil)
+		if err == nil {
+			item := types.EvmTxReceiptListItem{}
+			if err := proto.Unmarshal(itemProto, &item); err != nil {
+				return errors.Wrapf(err, "unmarshal retained receipt %x", txHash)
+			}
+			if err := lr.evictReceipt(txHash, &item); err != nil {
+				return err
+			}
+		} else if err != leveldb.ErrNotFound {
+			return errors.Wrapf(err, "get retained receipt %x", txHash)
+		}
+		if err := lr.tran.Delete(key, nil); err != nil {
+			return errors.Wrap(err, "delete retained receipt expiry")
+		}
+	}
+	return nil
+}
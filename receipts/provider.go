@@ -3,6 +3,7 @@ package receipts
 import (
 	"github.com/loomnetwork/loomchain"
 	"github.com/loomnetwork/loomchain/receipts/handler"
+	"github.com/loomnetwork/loomchain/receipts/leveldb"
 	evmaux "github.com/loomnetwork/loomchain/store/evm_aux"
 )
 
@@ -15,7 +16,7 @@ type ReceiptReaderWriter interface {
 // ReceiptHandlerProvider implements loomchain.ReceiptHandlerProvider interface
 type ReceiptHandlerProvider struct {
 	eventHandler loomchain.EventHandler
-	handler      ReceiptReaderWriter
+	handler      *handler.ReceiptHandler
 	evmAuxStore  *evmaux.EvmAuxStore
 }
 
@@ -23,14 +24,23 @@ func NewReceiptHandlerProvider(
 	eventHandler loomchain.EventHandler,
 	maxPersistentReceipts uint64,
 	evmAuxStore *evmaux.EvmAuxStore,
+	retentionCfg *leveldb.RetentionConfig,
 ) *ReceiptHandlerProvider {
 	return &ReceiptHandlerProvider{
 		eventHandler: eventHandler,
 		evmAuxStore:  evmAuxStore,
-		handler:      handler.NewReceiptHandler(eventHandler, maxPersistentReceipts, evmAuxStore),
+		handler: handler.NewReceiptHandler(
+			eventHandler, maxPersistentReceipts, evmAuxStore,
+		).WithRetention(retentionCfg),
 	}
 }
 
+// WithCallTracing enables or disables recording of the internal calls made by EVM txs.
+func (h *ReceiptHandlerProvider) WithCallTracing(enabled bool) *ReceiptHandlerProvider {
+	h.handler.WithCallTracing(enabled)
+	return h
+}
+
 func (h *ReceiptHandlerProvider) Store() loomchain.ReceiptHandlerStore {
 	return h.handler
 }
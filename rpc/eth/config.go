@@ -5,10 +5,14 @@ package eth
 type Web3Config struct {
 	// GetLogsMaxBlockRange specifies the maximum number of blocks eth_getLogs will query per request
 	GetLogsMaxBlockRange uint64
+	// CallTracingEnabled specifies whether the tree of internal calls made by each EVM tx should be
+	// recorded, the recorded call trees can be queried via trace_transaction & trace_block.
+	CallTracingEnabled bool
 }
 
 func DefaultWeb3Config() *Web3Config {
 	return &Web3Config{
 		GetLogsMaxBlockRange: 20,
+		CallTracingEnabled:   false,
 	}
 }
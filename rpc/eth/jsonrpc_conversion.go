@@ -2,6 +2,7 @@ package eth
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"math/big"
 	"reflect"
 	"strconv"
@@ -107,12 +108,46 @@ type JsonTxCallObject struct {
 	Nonce    Quantity `json:"nonce,omitempty"`
 }
 
+// JsonCallFrame describes an EVM message call, and all the internal calls made during the call.
+type JsonCallFrame struct {
+	Type   string           `json:"type"`
+	From   Data             `json:"from"`
+	To     Data             `json:"to,omitempty"`
+	Value  Quantity         `json:"value,omitempty"`
+	Input  Data             `json:"input"`
+	Output Data             `json:"output"`
+	Error  string           `json:"error,omitempty"`
+	Calls  []*JsonCallFrame `json:"calls,omitempty"`
+}
+
+// JsonTxTrace contains the call traces of a single tx.
+type JsonTxTrace struct {
+	TxHash Data             `json:"txHash"`
+	Result []*JsonCallFrame `json:"result"`
+}
+
 type JsonFilter struct {
 	FromBlock BlockHeight   `json:"fromBlock,omitempty"`
 	ToBlock   BlockHeight   `json:"toBlock,omitempty"`
 	Address   interface{}   `json:"address,omitempty"` // Data or []Data
 	Topics    []interface{} `json:"topics,omitempty"`  // (Data or nil or []Data)
 	BlockHash Data          `json:"blockhash,omitempty"`
+	// The following fields are only used by newPendingTransactions subscriptions.
+	FullTransactions bool        `json:"fullTransactions,omitempty"`
+	From             interface{} `json:"from,omitempty"` // Data or []Data
+	To               interface{} `json:"to,omitempty"`   // Data or []Data
+}
+
+// UnmarshalJSON allows a boolean to be used in place of a filter, so that
+// eth_subscribe("newPendingTransactions", true) can be used to subscribe to full txs like in geth.
+func (f *JsonFilter) UnmarshalJSON(data []byte) error {
+	var fullTxs bool
+	if err := json.Unmarshal(data, &fullTxs); err == nil {
+		*f = JsonFilter{FullTransactions: fullTxs}
+		return nil
+	}
+	type jsonFilter JsonFilter
+	return json.Unmarshal(data, (*jsonFilter)(f))
 }
 
 func EncTxReceipt(receipt types.EvmTxReceipt) JsonTxReceipt {
@@ -281,51 +316,68 @@ type EthBlockFilter struct {
 	Topics    [][]string
 }
 
+// PendingTxFilter restricts the txs sent to newPendingTransactions subscribers.
+type PendingTxFilter struct {
+	// If true subscribers will receive tx objects instead of tx hashes.
+	FullTransactions bool
+	// Only txs sent by one of these accounts will be matched, any sender is matched if empty.
+	From []loom.LocalAddress
+	// Only txs sent to one of these contracts will be matched, any target is matched if empty.
+	To []loom.LocalAddress
+}
+
 type EthFilter struct {
 	EthBlockFilter
+	PendingTxFilter
 	FromBlock BlockHeight
 	ToBlock   BlockHeight
 }
 
-func DecLogFilter(filter JsonFilter) (resp EthFilter, err error) {
+// decFilterAddresses decodes a filter address field, which may be either a single address or a
+// list of addresses.
+func decFilterAddresses(value interface{}) ([]loom.LocalAddress, error) {
 	addresses := []loom.LocalAddress{}
-	if filter.Address != nil {
-		addrValue := reflect.ValueOf(filter.Address)
-		switch addrValue.Kind() {
-		case reflect.String:
-			{
-				addrValue := reflect.ValueOf(filter.Address)
-				address, err := DecDataToBytes(Data(addrValue.String()))
-				if err != nil {
-					return resp, errors.Wrapf(err, "unwrap filter address %s", addrValue.String())
-				}
-				if len(address) > 0 {
-					addresses = append(addresses, address)
-				}
+	if value == nil {
+		return addresses, nil
+	}
+	addrValue := reflect.ValueOf(value)
+	switch addrValue.Kind() {
+	case reflect.String:
+		address, err := DecDataToBytes(Data(addrValue.String()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "unwrap filter address %s", addrValue.String())
+		}
+		if len(address) > 0 {
+			addresses = append(addresses, address)
+		}
+	case reflect.Slice:
+		for i := 0; i < addrValue.Len(); i++ {
+			kind := addrValue.Index(i).Kind()
+			if kind != reflect.Ptr && kind != reflect.Interface {
+				return nil, errors.Errorf("unrecognised address format %v", value)
 			}
-		case reflect.Slice:
-			{
-				for i := 0; i < addrValue.Len(); i++ {
-					kind := addrValue.Index(i).Kind()
-					if kind != reflect.Ptr && kind != reflect.Interface {
-						return resp, errors.Errorf("unrecognised address format %v", filter.Address)
-					}
-					addr := addrValue.Index(i).Elem()
-					if addr.Kind() != reflect.String {
-						return resp, errors.Errorf("unrecognised address format %v", addr)
-					}
-					address, err := DecDataToBytes(Data(addr.String()))
-					if err != nil {
-						return resp, errors.Wrapf(err, "unwrap filter address %s", addr.String())
-					}
-					if len(address) > 0 {
-						addresses = append(addresses, address)
-					}
-				}
+			addr := addrValue.Index(i).Elem()
+			if addr.Kind() != reflect.String {
+				return nil, errors.Errorf("unrecognised address format %v", addr)
+			}
+			address, err := DecDataToBytes(Data(addr.String()))
+			if err != nil {
+				return nil, errors.Wrapf(err, "unwrap filter address %s", addr.String())
+			}
+			if len(address) > 0 {
+				addresses = append(addresses, address)
 			}
-		default:
-			return resp, errors.Errorf("filter: unrecognised address format %v", filter.Address)
 		}
+	default:
+		return nil, errors.Errorf("filter: unrecognised address format %v", value)
+	}
+	return addresses, nil
+}
+
+func DecLogFilter(filter JsonFilter) (resp EthFilter, err error) {
+	addresses, err := decFilterAddresses(filter.Address)
+	if err != nil {
+		return resp, err
 	}
 
 	topicsList := [][]string{}
@@ -372,6 +424,19 @@ func DecLogFilter(filter JsonFilter) (resp EthFilter, err error) {
 			Addresses: addresses,
 			Topics:    topicsList,
 		},
+		PendingTxFilter: PendingTxFilter{
+			FullTransactions: filter.FullTransactions,
+		},
+	}
+	if filter.From != nil {
+		if ethFilter.PendingTxFilter.From, err = decFilterAddresses(filter.From); err != nil {
+			return resp, err
+		}
+	}
+	if filter.To != nil {
+		if ethFilter.PendingTxFilter.To, err = decFilterAddresses(filter.To); err != nil {
+			return resp, err
+		}
 	}
 	if len(filter.FromBlock) > 0 {
 		ethFilter.FromBlock = filter.FromBlock
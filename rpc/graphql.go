@@ -0,0 +1,603 @@
+package rpc
+
+import (
+	"net/http"
+	"strconv"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	loom "github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin/types"
+	ltypes "github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain/config"
+	"github.com/loomnetwork/loomchain/rpc/eth"
+	"github.com/pkg/errors"
+)
+
+// Max number of blocks that can be fetched by a single blocks query.
+const graphQLMaxBlockRange = 100
+
+// The schema is based on the one specified in EIP-1767, with the addition of loom contract events
+// and contract records. Hex encoded values (Bytes32, Address, Bytes, BigInt) are returned in the
+// same format as they are by the /eth endpoint.
+const graphQLSchema = `
+scalar Bytes32
+scalar Address
+scalar Bytes
+scalar BigInt
+scalar Long
+
+schema {
+	query: Query
+}
+
+type Query {
+	# Fetches a block by number or hash, if neither are specified the latest block is returned.
+	block(number: Long, hash: Bytes32): Block
+	# Fetches a range of blocks, if to is omitted all blocks up to the latest block are returned.
+	blocks(from: Long!, to: Long): [Block!]!
+	transaction(hash: Bytes32!): Transaction
+	logs(filter: FilterCriteria!): [Log!]!
+	# Fetches loom events emitted by Go & EVM contracts within a range of blocks, contract can be
+	# either the name of a Go contract, or the address of an EVM contract.
+	contractEvents(fromBlock: Long!, toBlock: Long, contract: String): [ContractEvent!]!
+	# Fetches a contract record from the registry.
+	contract(address: String!): ContractRecord
+}
+
+type Block {
+	number: Long!
+	hash: Bytes32!
+	parent: Block
+	nonce: Bytes!
+	transactionsRoot: Bytes32!
+	stateRoot: Bytes32!
+	receiptsRoot: Bytes32!
+	miner: Address!
+	extraData: Bytes!
+	gasLimit: Long!
+	gasUsed: Long!
+	timestamp: Long!
+	logsBloom: Bytes!
+	transactionCount: Int
+	transactions: [Transaction!]
+	transactionAt(index: Int!): Transaction
+	logs(filter: BlockFilterCriteria!): [Log!]!
+}
+
+type Transaction {
+	hash: Bytes32!
+	nonce: Long!
+	index: Int
+	from: Address!
+	to: Address
+	value: BigInt!
+	gasPrice: BigInt!
+	gas: Long!
+	inputData: Bytes!
+	block: Block
+	status: Long
+	gasUsed: Long
+	cumulativeGasUsed: Long
+	createdContract: Address
+	logs: [Log!]
+}
+
+type Log {
+	index: Int!
+	account: Address!
+	topics: [Bytes32!]!
+	data: Bytes!
+	transaction: Transaction!
+}
+
+type ContractEvent {
+	blockHeight: Long!
+	blockTime: Long!
+	txHash: Bytes32!
+	pluginName: String!
+	contractAddress: String!
+	caller: String!
+	topics: [String!]!
+	encodedBody: Bytes!
+}
+
+type ContractRecord {
+	name: String!
+	address: String!
+	creator: String!
+}
+
+input BlockFilterCriteria {
+	addresses: [Address!]
+	topics: [[Bytes32!]!]
+}
+
+input FilterCriteria {
+	fromBlock: Long
+	toBlock: Long
+	addresses: [Address!]
+	topics: [[Bytes32!]!]
+}
+`
+
+// MakeGraphQLHandler returns an http handler that serves GraphQL queries. Since every field of a
+// query can hit the query service the depth & concurrency of queries is limited by the config.
+func MakeGraphQLHandler(svc QueryService, cfg *config.GraphQLConfig) http.Handler {
+	schema := graphql.MustParseSchema(
+		graphQLSchema, &graphQLResolver{svc: svc},
+		graphql.MaxDepth(cfg.MaxDepth),
+		graphql.MaxParallelism(cfg.MaxParallelism),
+	)
+	handler := &relay.Handler{Schema: schema}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// gqlLong implements the Long scalar, a 64-bit integer that can also be specified as a hex or
+// decimal string.
+type gqlLong int64
+
+func (gqlLong) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+func (l *gqlLong) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case string:
+		value, err := strconv.ParseInt(v, 0, 64)
+		if err != nil {
+			return errors.Wrapf(err, "invalid Long %s", v)
+		}
+		*l = gqlLong(value)
+	case int32:
+		*l = gqlLong(v)
+	case int64:
+		*l = gqlLong(v)
+	case float64:
+		*l = gqlLong(v)
+	default:
+		return errors.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// gqlHex implements all the hex encoded scalars (Bytes32, Address, Bytes, BigInt).
+type gqlHex string
+
+func (gqlHex) ImplementsGraphQLType(name string) bool {
+	switch name {
+	case "Bytes32", "Address", "Bytes", "BigInt":
+		return true
+	}
+	return false
+}
+
+func (h *gqlHex) UnmarshalGraphQL(input interface{}) error {
+	v, ok := input.(string)
+	if !ok {
+		return errors.Errorf("unexpected type %T for hex value", input)
+	}
+	*h = gqlHex(v)
+	return nil
+}
+
+func quantityToLong(q eth.Quantity) gqlLong {
+	value, err := eth.DecQuantityToInt(q)
+	if err != nil {
+		return 0
+	}
+	return gqlLong(value)
+}
+
+func hexList(values []eth.Data) []gqlHex {
+	result := make([]gqlHex, 0, len(values))
+	for _, v := range values {
+		result = append(result, gqlHex(v))
+	}
+	return result
+}
+
+func encAddressString(addr *ltypes.Address) string {
+	if addr == nil {
+		return ""
+	}
+	return loom.UnmarshalAddressPB(addr).String()
+}
+
+type graphQLResolver struct {
+	svc QueryService
+}
+
+func (r *graphQLResolver) Block(args struct {
+	Number *gqlLong
+	Hash   *gqlHex
+}) (*gqlBlock, error) {
+	if args.Hash != nil {
+		block, err := r.svc.EthGetBlockByHash(eth.Data(*args.Hash), true)
+		if err != nil {
+			return nil, err
+		}
+		return &gqlBlock{svc: r.svc, block: &block}, nil
+	}
+	height := eth.BlockHeight("latest")
+	if args.Number != nil {
+		height = eth.BlockHeight(eth.EncInt(int64(*args.Number)))
+	}
+	return r.blockByNumber(height)
+}
+
+func (r *graphQLResolver) blockByNumber(height eth.BlockHeight) (*gqlBlock, error) {
+	block, err := r.svc.EthGetBlockByNumber(height, true)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	return &gqlBlock{svc: r.svc, block: block}, nil
+}
+
+func (r *graphQLResolver) Blocks(args struct {
+	From gqlLong
+	To   *gqlLong
+}) ([]*gqlBlock, error) {
+	var to gqlLong
+	if args.To != nil {
+		to = *args.To
+	} else {
+		latest, err := r.svc.EthBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		to = quantityToLong(latest)
+	}
+	if args.From < 1 || to < args.From {
+		return nil, errors.Errorf("invalid block range %d - %d", args.From, to)
+	}
+	if to-args.From >= graphQLMaxBlockRange {
+		return nil, errors.Errorf("block range exceeded, maximum range: %d", graphQLMaxBlockRange)
+	}
+	blocks := make([]*gqlBlock, 0, to-args.From+1)
+	for height := args.From; height <= to; height++ {
+		block, err := r.blockByNumber(eth.BlockHeight(eth.EncInt(int64(height))))
+		if err != nil {
+			return nil, err
+		}
+		if block != nil {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func (r *graphQLResolver) Transaction(args struct{ Hash gqlHex }) (*gqlTransaction, error) {
+	tx, err := r.svc.EthGetTransactionByHash(eth.Data(args.Hash))
+	if err != nil {
+		return nil, err
+	}
+	if tx.Hash == "" {
+		return nil, nil
+	}
+	return &gqlTransaction{svc: r.svc, tx: tx}, nil
+}
+
+type gqlFilterCriteria struct {
+	FromBlock *gqlLong
+	ToBlock   *gqlLong
+	Addresses *[]gqlHex
+	Topics    *[][]gqlHex
+}
+
+func (r *graphQLResolver) Logs(args struct{ Filter gqlFilterCriteria }) ([]*gqlLog, error) {
+	filter := encGraphQLFilter(args.Filter.Addresses, args.Filter.Topics)
+	if args.Filter.FromBlock != nil {
+		filter.FromBlock = eth.BlockHeight(eth.EncInt(int64(*args.Filter.FromBlock)))
+	}
+	if args.Filter.ToBlock != nil {
+		filter.ToBlock = eth.BlockHeight(eth.EncInt(int64(*args.Filter.ToBlock)))
+	}
+	return r.logs(filter)
+}
+
+func (r *graphQLResolver) logs(filter eth.JsonFilter) ([]*gqlLog, error) {
+	logs, err := r.svc.EthGetLogs(filter)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*gqlLog, 0, len(logs))
+	for i := range logs {
+		result = append(result, &gqlLog{svc: r.svc, log: logs[i]})
+	}
+	return result, nil
+}
+
+func encGraphQLFilter(addresses *[]gqlHex, topics *[][]gqlHex) eth.JsonFilter {
+	var filter eth.JsonFilter
+	if addresses != nil && len(*addresses) > 0 {
+		addrs := make([]interface{}, 0, len(*addresses))
+		for _, addr := range *addresses {
+			addrs = append(addrs, string(addr))
+		}
+		filter.Address = addrs
+	}
+	if topics != nil {
+		for _, options := range *topics {
+			// an empty list of options matches any topic
+			if len(options) == 0 {
+				filter.Topics = append(filter.Topics, nil)
+				continue
+			}
+			topicOptions := make([]interface{}, 0, len(options))
+			for _, topic := range options {
+				topicOptions = append(topicOptions, string(topic))
+			}
+			filter.Topics = append(filter.Topics, topicOptions)
+		}
+	}
+	return filter
+}
+
+func (r *graphQLResolver) ContractEvents(args struct {
+	FromBlock gqlLong
+	ToBlock   *gqlLong
+	Contract  *string
+}) ([]*gqlContractEvent, error) {
+	var toBlock uint64
+	if args.ToBlock != nil {
+		toBlock = uint64(*args.ToBlock)
+	}
+	var contract string
+	if args.Contract != nil {
+		contract = *args.Contract
+	}
+	result, err := r.svc.ContractEvents(uint64(args.FromBlock), toBlock, contract)
+	if err != nil {
+		return nil, err
+	}
+	events := []*gqlContractEvent{}
+	if result == nil {
+		return events, nil
+	}
+	for _, event := range result.Events {
+		events = append(events, &gqlContractEvent{event: event})
+	}
+	return events, nil
+}
+
+func (r *graphQLResolver) Contract(args struct{ Address string }) (*gqlContractRecord, error) {
+	record, err := r.svc.GetContractRecord(args.Address)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return &gqlContractRecord{record: record}, nil
+}
+
+type gqlBlock struct {
+	svc   QueryService
+	block *eth.JsonBlockObject
+}
+
+func (b *gqlBlock) Number() gqlLong          { return quantityToLong(b.block.Number) }
+func (b *gqlBlock) Hash() gqlHex             { return gqlHex(b.block.Hash) }
+func (b *gqlBlock) Nonce() gqlHex            { return gqlHex(b.block.Nonce) }
+func (b *gqlBlock) TransactionsRoot() gqlHex { return gqlHex(b.block.TransactionsRoot) }
+func (b *gqlBlock) StateRoot() gqlHex        { return gqlHex(b.block.StateRoot) }
+func (b *gqlBlock) ReceiptsRoot() gqlHex     { return gqlHex(b.block.ReceiptsRoot) }
+func (b *gqlBlock) Miner() gqlHex            { return gqlHex(b.block.Miner) }
+func (b *gqlBlock) ExtraData() gqlHex        { return gqlHex(b.block.ExtraData) }
+func (b *gqlBlock) GasLimit() gqlLong        { return quantityToLong(b.block.GasLimit) }
+func (b *gqlBlock) GasUsed() gqlLong         { return quantityToLong(b.block.GasUsed) }
+func (b *gqlBlock) Timestamp() gqlLong       { return quantityToLong(b.block.Timestamp) }
+func (b *gqlBlock) LogsBloom() gqlHex        { return gqlHex(b.block.LogsBloom) }
+func (b *gqlBlock) TransactionCount() *int32 {
+	count := int32(len(b.block.Transactions))
+	return &count
+}
+
+func (b *gqlBlock) Parent() (*gqlBlock, error) {
+	number := b.Number()
+	if number <= 1 {
+		return nil, nil
+	}
+	return (&graphQLResolver{svc: b.svc}).blockByNumber(eth.BlockHeight(eth.EncInt(int64(number - 1))))
+}
+
+func (b *gqlBlock) Transactions() (*[]*gqlTransaction, error) {
+	txs := make([]*gqlTransaction, 0, len(b.block.Transactions))
+	for i := range b.block.Transactions {
+		tx, err := b.transactionAt(i)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return &txs, nil
+}
+
+func (b *gqlBlock) TransactionAt(args struct{ Index int32 }) (*gqlTransaction, error) {
+	if args.Index < 0 || int(args.Index) >= len(b.block.Transactions) {
+		return nil, nil
+	}
+	return b.transactionAt(int(args.Index))
+}
+
+func (b *gqlBlock) transactionAt(index int) (*gqlTransaction, error) {
+	switch tx := b.block.Transactions[index].(type) {
+	case eth.JsonTxObject:
+		return &gqlTransaction{svc: b.svc, tx: tx, block: b}, nil
+	case *eth.JsonTxObject:
+		return &gqlTransaction{svc: b.svc, tx: *tx, block: b}, nil
+	case eth.Data:
+		txObj, err := b.svc.EthGetTransactionByHash(tx)
+		if err != nil {
+			return nil, err
+		}
+		return &gqlTransaction{svc: b.svc, tx: txObj, block: b}, nil
+	default:
+		return nil, errors.Errorf("unexpected tx type %T in block", tx)
+	}
+}
+
+func (b *gqlBlock) Logs(args struct {
+	Filter struct {
+		Addresses *[]gqlHex
+		Topics    *[][]gqlHex
+	}
+}) ([]*gqlLog, error) {
+	filter := encGraphQLFilter(args.Filter.Addresses, args.Filter.Topics)
+	filter.FromBlock = eth.BlockHeight(b.block.Number)
+	filter.ToBlock = eth.BlockHeight(b.block.Number)
+	return (&graphQLResolver{svc: b.svc}).logs(filter)
+}
+
+type gqlTransaction struct {
+	svc     QueryService
+	tx      eth.JsonTxObject
+	block   *gqlBlock
+	receipt *eth.JsonTxReceipt
+}
+
+func (t *gqlTransaction) Hash() gqlHex      { return gqlHex(t.tx.Hash) }
+func (t *gqlTransaction) Nonce() gqlLong    { return quantityToLong(t.tx.Nonce) }
+func (t *gqlTransaction) From() gqlHex      { return gqlHex(t.tx.From) }
+func (t *gqlTransaction) Value() gqlHex     { return gqlHex(t.tx.Value) }
+func (t *gqlTransaction) GasPrice() gqlHex  { return gqlHex(t.tx.GasPrice) }
+func (t *gqlTransaction) Gas() gqlLong      { return quantityToLong(t.tx.Gas) }
+func (t *gqlTransaction) InputData() gqlHex { return gqlHex(t.tx.Input) }
+
+func (t *gqlTransaction) Index() *int32 {
+	if t.tx.TransactionIndex == "" {
+		return nil
+	}
+	index := int32(quantityToLong(t.tx.TransactionIndex))
+	return &index
+}
+
+func (t *gqlTransaction) To() *gqlHex {
+	if t.tx.To == nil {
+		return nil
+	}
+	to := gqlHex(*t.tx.To)
+	return &to
+}
+
+func (t *gqlTransaction) Block() (*gqlBlock, error) {
+	if t.block != nil {
+		return t.block, nil
+	}
+	if t.tx.BlockNumber == "" {
+		return nil, nil
+	}
+	return (&graphQLResolver{svc: t.svc}).blockByNumber(eth.BlockHeight(t.tx.BlockNumber))
+}
+
+func (t *gqlTransaction) getReceipt() (*eth.JsonTxReceipt, error) {
+	if t.receipt == nil {
+		receipt, err := t.svc.EthGetTransactionReceipt(t.tx.Hash)
+		if err != nil {
+			return nil, err
+		}
+		t.receipt = receipt
+	}
+	return t.receipt, nil
+}
+
+func (t *gqlTransaction) Status() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	status := quantityToLong(receipt.Status)
+	return &status, nil
+}
+
+func (t *gqlTransaction) GasUsed() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := quantityToLong(receipt.GasUsed)
+	return &gasUsed, nil
+}
+
+func (t *gqlTransaction) CumulativeGasUsed() (*gqlLong, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	gasUsed := quantityToLong(receipt.CumulativeGasUsed)
+	return &gasUsed, nil
+}
+
+func (t *gqlTransaction) CreatedContract() (*gqlHex, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil || receipt.ContractAddress == nil {
+		return nil, err
+	}
+	addr := gqlHex(*receipt.ContractAddress)
+	return &addr, nil
+}
+
+func (t *gqlTransaction) Logs() (*[]*gqlLog, error) {
+	receipt, err := t.getReceipt()
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	logs := make([]*gqlLog, 0, len(receipt.Logs))
+	for i := range receipt.Logs {
+		logs = append(logs, &gqlLog{svc: t.svc, log: receipt.Logs[i], tx: t})
+	}
+	return &logs, nil
+}
+
+type gqlLog struct {
+	svc QueryService
+	log eth.JsonLog
+	tx  *gqlTransaction
+}
+
+func (l *gqlLog) Index() int32     { return int32(quantityToLong(l.log.LogIndex)) }
+func (l *gqlLog) Account() gqlHex  { return gqlHex(l.log.Address) }
+func (l *gqlLog) Topics() []gqlHex { return hexList(l.log.Topics) }
+func (l *gqlLog) Data() gqlHex     { return gqlHex(l.log.Data) }
+
+func (l *gqlLog) Transaction() (*gqlTransaction, error) {
+	if l.tx != nil {
+		return l.tx, nil
+	}
+	tx, err := l.svc.EthGetTransactionByHash(l.log.TransactionHash)
+	if err != nil {
+		return nil, err
+	}
+	return &gqlTransaction{svc: l.svc, tx: tx}, nil
+}
+
+type gqlContractEvent struct {
+	event *types.EventData
+}
+
+func (e *gqlContractEvent) BlockHeight() gqlLong    { return gqlLong(e.event.BlockHeight) }
+func (e *gqlContractEvent) BlockTime() gqlLong      { return gqlLong(e.event.BlockTime) }
+func (e *gqlContractEvent) TxHash() gqlHex          { return gqlHex(eth.EncBytes(e.event.TxHash)) }
+func (e *gqlContractEvent) PluginName() string      { return e.event.PluginName }
+func (e *gqlContractEvent) ContractAddress() string { return encAddressString(e.event.Address) }
+func (e *gqlContractEvent) Caller() string          { return encAddressString(e.event.Caller) }
+func (e *gqlContractEvent) EncodedBody() gqlHex     { return gqlHex(eth.EncBytes(e.event.EncodedBody)) }
+
+func (e *gqlContractEvent) Topics() []string {
+	if e.event.Topics == nil {
+		return []string{}
+	}
+	return e.event.Topics
+}
+
+type gqlContractRecord struct {
+	record *types.ContractRecordResponse
+}
+
+func (c *gqlContractRecord) Name() string    { return c.record.ContractName }
+func (c *gqlContractRecord) Address() string { return encAddressString(c.record.ContractAddress) }
+func (c *gqlContractRecord) Creator() string { return encAddressString(c.record.CreatorAddress) }
@@ -0,0 +1,155 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/loomnetwork/go-loom/plugin/types"
+	"github.com/loomnetwork/loomchain/config"
+	"github.com/loomnetwork/loomchain/rpc/eth"
+	"github.com/stretchr/testify/require"
+)
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []interface{}   `json:"errors"`
+}
+
+func execGraphQLQuery(t *testing.T, svc QueryService, query string) graphQLResponse {
+	handler := MakeGraphQLHandler(svc, config.DefaultGraphQLConfig())
+	payload, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+	req := httptest.NewRequest("POST", "http://localhost/graphql", strings.NewReader(string(payload)))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	require.Equal(t, 200, rec.Result().StatusCode)
+
+	var resp graphQLResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	tests := []struct {
+		query  string
+		target string
+	}{
+		{`{ block(number: 5) { number hash } }`, "EthGetBlockByNumber"},
+		{`{ block(hash: "0x0123") { number transactions { hash status } } }`, "EthGetBlockByHash"},
+		{`{ transaction(hash: "0x0123") { hash from } }`, "EthGetTransactionByHash"},
+		{`{ logs(filter: { fromBlock: 1, toBlock: "0x10", topics: [[], ["0x01"]] }) { data } }`, "EthGetLogs"},
+		{`{ contractEvents(fromBlock: 1, contract: "dposV3") { pluginName topics } }`, "ContractEvents"},
+	}
+
+	qs := &MockQueryService{}
+	for _, test := range tests {
+		resp := execGraphQLQuery(t, qs, test.query)
+		require.Empty(t, resp.Errors, test.query)
+		require.Equal(t, test.target, qs.MethodsCalled[0])
+	}
+}
+
+// graphQLQueryService returns a chain of blocks that each contain a single tx with a single log.
+type graphQLQueryService struct {
+	MockQueryService
+}
+
+func (s *graphQLQueryService) EthGetBlockByNumber(height eth.BlockHeight, full bool) (*eth.JsonBlockObject, error) {
+	number := eth.Quantity(height)
+	hash := eth.Data("0xb" + strings.TrimPrefix(string(height), "0x"))
+	return &eth.JsonBlockObject{
+		Number: number,
+		Hash:   hash,
+		Transactions: []interface{}{
+			eth.JsonTxObject{
+				Hash:        eth.Data("0xa" + strings.TrimPrefix(string(height), "0x")),
+				From:        "0x01",
+				BlockNumber: number,
+			},
+		},
+	}, nil
+}
+
+func (s *graphQLQueryService) EthGetTransactionReceipt(hash eth.Data) (*eth.JsonTxReceipt, error) {
+	return &eth.JsonTxReceipt{
+		Status: eth.EncInt(1),
+		Logs: []eth.JsonLog{
+			{LogIndex: eth.EncInt(0), Address: "0x02", Data: "0x1234", Topics: []eth.Data{"0x03"}},
+		},
+	}, nil
+}
+
+func (s *graphQLQueryService) ContractEvents(
+	fromBlock uint64, toBlock uint64, contract string,
+) (*types.ContractEventsResult, error) {
+	return &types.ContractEventsResult{
+		Events: []*types.EventData{
+			{BlockHeight: fromBlock, PluginName: contract, Topics: []string{"event:one"}},
+		},
+	}, nil
+}
+
+func TestGraphQLResolvers(t *testing.T) {
+	qs := &graphQLQueryService{}
+
+	resp := execGraphQLQuery(t, qs, `{
+		block(number: 5) {
+			number
+			hash
+			parent { number }
+			transactionCount
+			transactions { hash from status logs { index account data topics } }
+		}
+	}`)
+	require.Empty(t, resp.Errors)
+	require.JSONEq(t, `{
+		"block": {
+			"number": 5,
+			"hash": "0xb5",
+			"parent": { "number": 4 },
+			"transactionCount": 1,
+			"transactions": [{
+				"hash": "0xa5",
+				"from": "0x01",
+				"status": 1,
+				"logs": [{ "index": 0, "account": "0x02", "data": "0x1234", "topics": ["0x03"] }]
+			}]
+		}
+	}`, string(resp.Data))
+
+	resp = execGraphQLQuery(t, qs, `{ blocks(from: 2, to: 3) { number transactionAt(index: 0) { hash } } }`)
+	require.Empty(t, resp.Errors)
+	require.JSONEq(t, `{
+		"blocks": [
+			{ "number": 2, "transactionAt": { "hash": "0xa2" } },
+			{ "number": 3, "transactionAt": { "hash": "0xa3" } }
+		]
+	}`, string(resp.Data))
+
+	resp = execGraphQLQuery(t, qs, `{ contractEvents(fromBlock: 7, contract: "dposV3") { blockHeight pluginName topics } }`)
+	require.Empty(t, resp.Errors)
+	require.JSONEq(t, `{
+		"contractEvents": [{ "blockHeight": 7, "pluginName": "dposV3", "topics": ["event:one"] }]
+	}`, string(resp.Data))
+
+	// block ranges are limited
+	resp = execGraphQLQuery(t, qs, `{ blocks(from: 1, to: 1000) { number } }`)
+	require.NotEmpty(t, resp.Errors)
+}
+
+func TestGraphQLMaxDepth(t *testing.T) {
+	qs := &graphQLQueryService{}
+	depth := config.DefaultGraphQLConfig().MaxDepth
+
+	query := "{ block(number: 100) { " + strings.Repeat("parent { ", depth-2) + "number" +
+		strings.Repeat(" }", depth-2) + " } }"
+	resp := execGraphQLQuery(t, qs, query)
+	require.Empty(t, resp.Errors)
+
+	query = "{ block(number: 100) { " + strings.Repeat("parent { ", depth) + "number" +
+		strings.Repeat(" }", depth) + " } }"
+	resp = execGraphQLQuery(t, qs, query)
+	require.NotEmpty(t, resp.Errors)
+}
@@ -69,6 +69,18 @@ func (m InstrumentingMiddleware) Nonce(key, account string) (resp uint64, err er
 	return
 }
 
+// PendingNonce call service PendingNonce method and captures metrics
+func (m InstrumentingMiddleware) PendingNonce(key, account string) (resp uint64, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "PendingNonce", "error", fmt.Sprint(err != nil)}
+		m.requestCount.With(lvs...).Add(1)
+		m.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	resp, err = m.next.PendingNonce(key, account)
+	return
+}
+
 func (m InstrumentingMiddleware) Subscribe(wsCtx rpctypes.WSRPCContext, contracts []string) (*WSEmptyResult, error) {
 	return m.next.Subscribe(wsCtx, contracts)
 }
@@ -594,3 +606,25 @@ func (m InstrumentingMiddleware) EthGetTransactionCount(
 	resp, err = m.next.EthGetTransactionCount(local, block)
 	return
 }
+
+func (m InstrumentingMiddleware) TraceTransaction(hash eth.Data) (resp []*eth.JsonCallFrame, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "TraceTransaction", "error", fmt.Sprint(err != nil)}
+		m.requestCount.With(lvs...).Add(1)
+		m.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	resp, err = m.next.TraceTransaction(hash)
+	return
+}
+
+func (m InstrumentingMiddleware) TraceBlock(block eth.BlockHeight) (resp []*eth.JsonTxTrace, err error) {
+	defer func(begin time.Time) {
+		lvs := []string{"method", "TraceBlock", "error", fmt.Sprint(err != nil)}
+		m.requestCount.With(lvs...).Add(1)
+		m.requestLatency.With(lvs...).Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	resp, err = m.next.TraceBlock(block)
+	return
+}
@@ -39,6 +39,13 @@ func (m *MockQueryService) Nonce(key, account string) (uint64, error) {
 	return 0, nil
 }
 
+func (m *MockQueryService) PendingNonce(key, account string) (uint64, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.MethodsCalled = append([]string{"PendingNonce"}, m.MethodsCalled...)
+	return 0, nil
+}
+
 func (m *MockQueryService) Subscribe(wsCtx rpctypes.WSRPCContext, topics []string) (*WSEmptyResult, error) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
@@ -378,3 +385,17 @@ func (m *MockQueryService) EvmUnSubscribe(id string) (bool, error) {
 	m.MethodsCalled = append([]string{"EvmUnSubscribe"}, m.MethodsCalled...)
 	return true, nil
 }
+
+func (m *MockQueryService) TraceTransaction(hash eth.Data) ([]*eth.JsonCallFrame, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.MethodsCalled = append([]string{"TraceTransaction"}, m.MethodsCalled...)
+	return nil, nil
+}
+
+func (m *MockQueryService) TraceBlock(block eth.BlockHeight) ([]*eth.JsonTxTrace, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.MethodsCalled = append([]string{"TraceBlock"}, m.MethodsCalled...)
+	return nil, nil
+}
@@ -136,6 +136,8 @@ type QueryServer struct {
 	Web3Cfg           *eth.Web3Config
 	totalStakedAmount *totalStakedAmount
 	DPOSCfg           *config.DPOSConfig
+	// If this is nil pending nonces will be the same as the committed nonces.
+	NonceQueue *auth.NonceQueue
 }
 
 type totalStakedAmount struct {
@@ -383,25 +385,9 @@ func (s *QueryServer) createStaticContractCtx(state loomchain.State, name string
 // NOTE: Either the key or the account must be provided. The account (if not empty) is used in
 //       preference to the key.
 func (s *QueryServer) Nonce(key, account string) (uint64, error) {
-	var addr loom.Address
-
-	if key != "" && account == "" {
-		k, err := hex.DecodeString(key)
-		if err != nil {
-			return 0, err
-		}
-		addr = loom.Address{
-			ChainID: s.ChainID,
-			Local:   loom.LocalAddressFromPublicKey(k),
-		}
-	} else if account != "" {
-		var err error
-		addr, err = loom.ParseAddress(account)
-		if err != nil {
-			return 0, err
-		}
-	} else {
-		return 0, errors.New("no key or account specified")
+	addr, err := s.nonceAccount(key, account)
+	if err != nil {
+		return 0, err
 	}
 
 	snapshot := s.StateProvider.ReadOnlyState()
@@ -415,6 +401,51 @@ func (s *QueryServer) Nonce(key, account string) (uint64, error) {
 	return auth.Nonce(snapshot, resolvedAddr), nil
 }
 
+// PendingNonce returns the nonce of the last tx sent by the given account that was accepted into
+// the mempool of this node, or the nonce of the last committed tx if there are no such txs.
+// NOTE: Either the key or the account must be provided. The account (if not empty) is used in
+//       preference to the key.
+func (s *QueryServer) PendingNonce(key, account string) (uint64, error) {
+	addr, err := s.nonceAccount(key, account)
+	if err != nil {
+		return 0, err
+	}
+
+	snapshot := s.StateProvider.ReadOnlyState()
+	defer snapshot.Release()
+
+	resolvedAddr, err := auth.ResolveAccountAddress(addr, snapshot, s.AuthCfg, s.createAddressMapperCtx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to resolve account address")
+	}
+
+	return s.pendingNonce(snapshot, resolvedAddr), nil
+}
+
+func (s *QueryServer) pendingNonce(state loomchain.ReadOnlyState, addr loom.Address) uint64 {
+	nonce := auth.Nonce(state, addr)
+	if s.NonceQueue == nil {
+		return nonce
+	}
+	return s.NonceQueue.PendingNonce(addr, nonce)
+}
+
+func (s *QueryServer) nonceAccount(key, account string) (loom.Address, error) {
+	if key != "" && account == "" {
+		k, err := hex.DecodeString(key)
+		if err != nil {
+			return loom.Address{}, err
+		}
+		return loom.Address{
+			ChainID: s.ChainID,
+			Local:   loom.LocalAddressFromPublicKey(k),
+		}, nil
+	} else if account != "" {
+		return loom.ParseAddress(account)
+	}
+	return loom.Address{}, errors.New("no key or account specified")
+}
+
 func (s *QueryServer) Resolve(name string) (string, error) {
 	snapshot := s.StateProvider.ReadOnlyState()
 	defer snapshot.Release()
@@ -779,6 +810,74 @@ func (s *QueryServer) GetEvmBlockByNumber(number string, full bool) ([]byte, err
 	}
 }
 
+// TraceTransaction returns the internal calls made by an EVM tx, call tracing must be enabled
+// via Web3.CallTracingEnabled in loom.yml for traces to be recorded.
+func (s *QueryServer) TraceTransaction(hash eth.Data) ([]*eth.JsonCallFrame, error) {
+	txHash, err := eth.DecDataToBytes(hash)
+	if err != nil {
+		return nil, err
+	}
+	if evmTxHash, err := s.EvmAuxStore.GetChildTxHash(txHash); err == nil && len(evmTxHash) > 0 {
+		txHash = evmTxHash
+	}
+	frames, err := s.EvmAuxStore.GetCallTraces(txHash)
+	if err != nil {
+		return nil, err
+	}
+	return encCallFrames(frames), nil
+}
+
+// TraceBlock returns the internal calls made by all the EVM txs in a block.
+func (s *QueryServer) TraceBlock(block eth.BlockHeight) ([]*eth.JsonTxTrace, error) {
+	snapshot := s.StateProvider.ReadOnlyState()
+	defer snapshot.Release()
+
+	height, err := eth.DecBlockHeight(snapshot.Block().Height, block)
+	if err != nil {
+		return nil, err
+	}
+	txHashes, err := s.EvmAuxStore.GetTxHashList(height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load tx hashes for block %d", height)
+	}
+	traces := make([]*eth.JsonTxTrace, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		frames, err := s.EvmAuxStore.GetCallTraces(txHash)
+		if err != nil {
+			return nil, err
+		}
+		traces = append(traces, &eth.JsonTxTrace{
+			TxHash: eth.EncBytes(txHash),
+			Result: encCallFrames(frames),
+		})
+	}
+	return traces, nil
+}
+
+func encCallFrames(frames []*evmaux.CallFrame) []*eth.JsonCallFrame {
+	result := make([]*eth.JsonCallFrame, 0, len(frames))
+	for _, frame := range frames {
+		jFrame := &eth.JsonCallFrame{
+			Type:   frame.Type,
+			From:   eth.EncBytes(frame.From),
+			Input:  eth.EncBytes(frame.Input),
+			Output: eth.EncBytes(frame.Output),
+			Error:  frame.Error,
+		}
+		if len(frame.To) > 0 {
+			jFrame.To = eth.EncBytes(frame.To)
+		}
+		if frame.Value != nil {
+			jFrame.Value = eth.EncBigInt(*frame.Value)
+		}
+		if len(frame.Calls) > 0 {
+			jFrame.Calls = encCallFrames(frame.Calls)
+		}
+		result = append(result, jFrame)
+	}
+	return result
+}
+
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getblockbyhash
 func (s *QueryServer) GetEvmBlockByHash(hash []byte, full bool) ([]byte, error) {
 	snapshot := s.StateProvider.ReadOnlyState()
@@ -1113,10 +1212,10 @@ func (s *QueryServer) EthGetTransactionCount(address eth.Data, block eth.BlockHe
 	}
 
 	// Currently loom nodes don't expose pending state to clients, but various web3 libs may call
-	// eth_getTransactionCount with "pending" so to make them work we just return the latest nonce
-	// based on the last committed block.
+	// eth_getTransactionCount with "pending", so to make them work we return the latest nonce
+	// based on the last committed block, and any txs this node accepted into its mempool since.
 	if block == "pending" {
-		block = "latest"
+		return eth.EncUint(s.pendingNonce(snapshot, resolvedAddr)), nil
 	}
 
 	height, err := eth.DecBlockHeight(snapshot.Block().Height, block)
@@ -18,6 +18,7 @@ import (
 	"github.com/loomnetwork/loomchain/eth/subs"
 	"github.com/loomnetwork/loomchain/log"
 	"github.com/loomnetwork/loomchain/rpc/eth"
+	"github.com/loomnetwork/loomchain/throttle"
 	"github.com/loomnetwork/loomchain/vm"
 )
 
@@ -26,6 +27,7 @@ type QueryService interface {
 	Query(caller, contract string, query []byte, vmType vm.VMType) ([]byte, error)
 	Resolve(name string) (string, error)
 	Nonce(key, account string) (uint64, error)
+	PendingNonce(key, account string) (uint64, error)
 	Subscribe(wsCtx rpctypes.WSRPCContext, topics []string) (*WSEmptyResult, error)
 	UnSubscribe(wsCtx rpctypes.WSRPCContext, topics string) (*WSEmptyResult, error)
 	QueryEnv() (*config.EnvInfo, error)
@@ -61,6 +63,9 @@ type QueryService interface {
 	EthGetTransactionCount(local eth.Data, block eth.BlockHeight) (eth.Quantity, error)
 	EthAccounts() ([]eth.Data, error)
 
+	TraceTransaction(hash eth.Data) ([]*eth.JsonCallFrame, error)
+	TraceBlock(block eth.BlockHeight) ([]*eth.JsonTxTrace, error)
+
 	ContractEvents(fromBlock uint64, toBlock uint64, contract string) (*types.ContractEventsResult, error)
 	GetContractRecord(contractAddr string) (*types.ContractRecordResponse, error)
 	DPOSTotalStaked() (*DPOSTotalStakedResponse, error)
@@ -113,6 +118,7 @@ func MakeQueryServiceHandler(svc QueryService, logger log.TMLogger, bus *QueryEv
 	routes["query"] = rpcserver.NewRPCFunc(svc.Query, "caller,contract,query,vmType")
 	routes["env"] = rpcserver.NewRPCFunc(svc.QueryEnv, "")
 	routes["nonce"] = rpcserver.NewRPCFunc(svc.Nonce, "key,account")
+	routes["pending_nonce"] = rpcserver.NewRPCFunc(svc.PendingNonce, "key,account")
 	routes["subevents"] = rpcserver.NewWSRPCFunc(svc.Subscribe, "topics")
 	routes["unsubevents"] = rpcserver.NewWSRPCFunc(svc.UnSubscribe, "topic")
 	routes["resolve"] = rpcserver.NewRPCFunc(svc.Resolve, "name")
@@ -190,6 +196,8 @@ func createDefaultEthRoutes(svc QueryService, chainID string) map[string]eth.RPC
 	routes["eth_gasPrice"] = eth.NewRPCFunc(svc.EthGasPrice, "")
 	routes["net_version"] = eth.NewRPCFunc(svc.EthNetVersion, "")
 	routes["eth_getTransactionCount"] = eth.NewRPCFunc(svc.EthGetTransactionCount, "local,block")
+	routes["trace_transaction"] = eth.NewRPCFunc(svc.TraceTransaction, "hash")
+	routes["trace_block"] = eth.NewRPCFunc(svc.TraceBlock, "block")
 	routes["eth_sendRawTransaction"] = NewSendRawTransactionRPCFunc(chainID, rpccore.BroadcastTxSync)
 	return routes
 }
@@ -212,8 +220,9 @@ func MakeEthQueryServiceHandler(logger log.TMLogger, hub *Hub, routes map[string
 	return mux
 }
 
-// MakeUnsafeQueryServiceHandler returns a http handler for unsafe RPC routes
-func MakeUnsafeQueryServiceHandler(logger log.TMLogger) http.Handler {
+// MakeUnsafeQueryServiceHandler returns a http handler for unsafe RPC routes, the denylist routes
+// are only registered if the denylist is enabled.
+func MakeUnsafeQueryServiceHandler(logger log.TMLogger, denylist *throttle.Denylist) http.Handler {
 	codec := amino.NewCodec()
 	mux := http.NewServeMux()
 	routes := map[string]*rpcserver.RPCFunc{}
@@ -226,6 +235,20 @@ func MakeUnsafeQueryServiceHandler(logger log.TMLogger) http.Handler {
 	routes["unsafe_stop_cpu_profiler"] = rpcserver.NewRPCFunc(rpccore.UnsafeStopCPUProfiler, "")
 	routes["unsafe_write_heap_profile"] = rpcserver.NewRPCFunc(rpccore.UnsafeWriteHeapProfile, "filename")
 
+	if denylist != nil {
+		routes["unsafe_reload_denylist"] = rpcserver.NewRPCFunc(func() (*throttle.DenylistEntries, error) {
+			if err := denylist.Reload(); err != nil {
+				return nil, err
+			}
+			entries := denylist.Entries()
+			return &entries, nil
+		}, "")
+		routes["unsafe_denylist"] = rpcserver.NewRPCFunc(func() (*throttle.DenylistEntries, error) {
+			entries := denylist.Entries()
+			return &entries, nil
+		}, "")
+	}
+
 	rpcserver.RegisterRPCFuncs(mux, routes, codec, logger)
 	return mux
 }
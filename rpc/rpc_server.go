@@ -5,7 +5,9 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/loomnetwork/loomchain/config"
 	"github.com/loomnetwork/loomchain/log"
+	"github.com/loomnetwork/loomchain/throttle"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	amino "github.com/tendermint/go-amino"
@@ -38,16 +40,18 @@ func init() {
 // RPCServer starts up HTTP servers that handle client requests.
 func RPCServer(
 	qsvc QueryService, chainID string, logger log.TMLogger, bus *QueryEventBus, bindAddr string,
-	enableUnsafeRPC bool, unsafeRPCBindAddress string,
+	enableUnsafeRPC bool, unsafeRPCBindAddress string, denylist *throttle.Denylist,
+	graphQLCfg *config.GraphQLConfig,
 ) error {
 	queryHandler := MakeQueryServiceHandler(qsvc, logger, bus)
 	hub := newHub()
 	go hub.run()
 	ethHandler := MakeEthQueryServiceHandler(logger, hub, createDefaultEthRoutes(qsvc, chainID))
 
-	// Add the nonce route to the TM routes so clients can query the nonce from the /websocket
+	// Add the nonce routes to the TM routes so clients can query the nonce from the /websocket
 	// and /rpc endpoints.
 	rpccore.Routes["nonce"] = rpcserver.NewRPCFunc(qsvc.Nonce, "key,account")
+	rpccore.Routes["pending_nonce"] = rpcserver.NewRPCFunc(qsvc.PendingNonce, "key,account")
 
 	wm := rpcserver.NewWebsocketManager(rpccore.Routes, cdc, rpcserver.EventSubscriber(bus))
 	wm.SetLogger(logger)
@@ -57,6 +61,9 @@ func RPCServer(
 	mux.Handle("/query", stripPrefix("/query", queryHandler)) //backwards compatibility
 	mux.Handle("/queryws", queryHandler)
 	mux.Handle("/eth", ethHandler)
+	if graphQLCfg != nil && graphQLCfg.Enabled {
+		mux.Handle("/graphql", CORSMethodMiddleware(MakeGraphQLHandler(qsvc, graphQLCfg)))
+	}
 	rpcmux := http.NewServeMux()
 	rpcserver.RegisterRPCFuncs(rpcmux, rpccore.Routes, cdc, logger)
 	mux.Handle("/rpc/", stripPrefix("/rpc", CORSMethodMiddleware(rpcmux)))
@@ -94,7 +101,7 @@ func RPCServer(
 
 	if enableUnsafeRPC {
 		unsafeLogger := logger.With("interface", "unsafe")
-		unsafeHandler := MakeUnsafeQueryServiceHandler(unsafeLogger)
+		unsafeHandler := MakeUnsafeQueryServiceHandler(unsafeLogger, denylist)
 		unsafeListener, err := rpcserver.Listen(
 			unsafeRPCBindAddress,
 			rpcserver.Config{MaxOpenConnections: 0},
@@ -0,0 +1,64 @@
+package evmaux
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+var callTracePrefix = []byte("ct")
+
+// CallTraceKey returns the key under which the call traces of an EVM tx are stored.
+func CallTraceKey(txHash []byte) []byte {
+	return util.PrefixKey(callTracePrefix, txHash)
+}
+
+// CallFrame describes an EVM message call (or contract creation), and all the internal calls
+// that were made while it was executing.
+type CallFrame struct {
+	// CALL | CALLCODE | DELEGATECALL | STATICCALL | CREATE | CREATE2 | SELFDESTRUCT
+	Type   string       `json:"type"`
+	From   []byte       `json:"from"`
+	To     []byte       `json:"to,omitempty"`
+	Value  *big.Int     `json:"value,omitempty"`
+	Input  []byte       `json:"input,omitempty"`
+	Output []byte       `json:"output,omitempty"`
+	Error  string       `json:"error,omitempty"`
+	Calls  []*CallFrame `json:"calls,omitempty"`
+}
+
+// SetCallTraces adds the call traces of EVM txs to the given DB transaction, the traces are keyed
+// by EVM tx hash. A single tx may have multiple top-level call frames if a Go contract calls into
+// the EVM more than once while processing the tx.
+func (s *EvmAuxStore) SetCallTraces(tran *leveldb.Transaction, traces map[string][]*CallFrame) error {
+	for txHash, frames := range traces {
+		data, err := json.Marshal(frames)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal call trace")
+		}
+		if err := tran.Put(CallTraceKey([]byte(txHash)), data, nil); err != nil {
+			return errors.Wrap(err, "failed to save call trace")
+		}
+	}
+	return nil
+}
+
+// GetCallTraces returns the call traces of the EVM tx with the given hash, nil will be returned
+// if no traces were recorded for the tx.
+func (s *EvmAuxStore) GetCallTraces(txHash []byte) ([]*CallFrame, error) {
+	data, err := s.db.Get(CallTraceKey(txHash), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var frames []*CallFrame
+	if err := json.Unmarshal(data, &frames); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal call trace")
+	}
+	return frames, nil
+}
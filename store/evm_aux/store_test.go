@@ -3,6 +3,7 @@ package evmaux
 import (
 	"bytes"
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -74,3 +75,32 @@ func TestBloomFilterOperation(t *testing.T) {
 	require.Equal(t, true, bytes.Equal(bf, bf1))
 	evmAuxStore.ClearData()
 }
+
+func TestCallTraceOperation(t *testing.T) {
+	evmAuxStore, err := LoadStore()
+	require.NoError(t, err)
+	frames, err := evmAuxStore.GetCallTraces([]byte("hash1"))
+	require.NoError(t, err)
+	require.Nil(t, frames)
+	traces := map[string][]*CallFrame{
+		"hash1": {
+			{
+				Type:  "CALL",
+				From:  []byte("caller"),
+				To:    []byte("contract1"),
+				Value: big.NewInt(10),
+				Calls: []*CallFrame{
+					{Type: "STATICCALL", From: []byte("contract1"), To: []byte("contract2"), Output: []byte{1}},
+				},
+			},
+		},
+	}
+	tran, err := evmAuxStore.DB().OpenTransaction()
+	require.NoError(t, err)
+	require.NoError(t, evmAuxStore.SetCallTraces(tran, traces))
+	require.NoError(t, tran.Commit())
+	frames, err = evmAuxStore.GetCallTraces([]byte("hash1"))
+	require.NoError(t, err)
+	require.Equal(t, traces["hash1"], frames)
+	evmAuxStore.ClearData()
+}
@@ -0,0 +1,101 @@
+package throttle
+
+import (
+	"encoding/binary"
+
+	"github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/util"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/auth"
+	"github.com/loomnetwork/loomchain/builtin/plugins/chainconfig"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/pkg/errors"
+)
+
+func blockTxLimiterKey(addr loom.Address) []byte {
+	return util.PrefixKey([]byte("txlimit"), addr.Bytes())
+}
+
+// txLimiterBucket tracks the number of txs an account sent within a block range.
+type txLimiterBucket struct {
+	// Index of the block range the bucket belongs to, i.e. block height / block range.
+	window uint64
+	txs    uint64
+}
+
+func loadTxLimiterBucket(kvStore store.KVReader, addr loom.Address) txLimiterBucket {
+	data := kvStore.Get(blockTxLimiterKey(addr))
+	if len(data) != 16 {
+		return txLimiterBucket{}
+	}
+	return txLimiterBucket{
+		window: binary.BigEndian.Uint64(data[:8]),
+		txs:    binary.BigEndian.Uint64(data[8:]),
+	}
+}
+
+func saveTxLimiterBucket(kvStore store.KVWriter, addr loom.Address, bucket txLimiterBucket) {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data[:8], bucket.window)
+	binary.BigEndian.PutUint64(data[8:], bucket.txs)
+	kvStore.Set(blockTxLimiterKey(addr), data)
+}
+
+// NewBlockTxLimiterMiddleware creates middleware that limits the number of txs (all types) an
+// account can send within a fixed range of blocks. Unlike the TxLimiterMiddleware the tx counters
+// are persisted in the app state, the limits are stored in the ChainConfig contract, and the limits
+// are enforced in DeliverTx, so all nodes will always agree on which txs should be rejected.
+// In CheckTx the limits are checked against the counters from the last committed block.
+//
+// Similarly to the nonce middleware the counters are written directly to the given store so that
+// txs that fail after passing through this middleware are still counted.
+func NewBlockTxLimiterMiddleware(
+	createChainConfigCtx func(state loomchain.State) (contractpb.StaticContext, error),
+	kvStore store.KVStore,
+) loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (loomchain.TxHandlerResult, error) {
+		if !state.FeatureEnabled(features.BlockTxLimiterFeature, false) {
+			return next(state, txBytes, isCheckTx)
+		}
+
+		ctx, err := createChainConfigCtx(state)
+		if err != nil {
+			return loomchain.TxHandlerResult{}, errors.Wrap(err, "failed to create ChainConfig contract context")
+		}
+		limits, err := chainconfig.GetBlockTxLimits(ctx)
+		if err != nil {
+			return loomchain.TxHandlerResult{}, err
+		}
+		// the limiter is disabled until the limits are set
+		if limits == nil || limits.BlockRange == 0 || limits.MaxTxsPerRange == 0 {
+			return next(state, txBytes, isCheckTx)
+		}
+
+		origin := auth.Origin(state.Context())
+		if origin.IsEmpty() {
+			return loomchain.TxHandlerResult{}, errors.New("throttle: transaction has no origin [block-tx-limiter]")
+		}
+
+		window := uint64(state.Block().Height) / limits.BlockRange
+		bucket := loadTxLimiterBucket(kvStore, origin)
+		if bucket.window != window {
+			bucket = txLimiterBucket{window: window}
+		}
+		if bucket.txs >= limits.MaxTxsPerRange {
+			return loomchain.TxHandlerResult{}, ErrTxLimitReached
+		}
+
+		if !isCheckTx {
+			bucket.txs++
+			saveTxLimiterBucket(kvStore, origin, bucket)
+		}
+		return next(state, txBytes, isCheckTx)
+	})
+}
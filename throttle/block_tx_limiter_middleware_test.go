@@ -0,0 +1,71 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loomnetwork/go-loom"
+	goloomplugin "github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/loomchain"
+	loomAuth "github.com/loomnetwork/loomchain/auth"
+	"github.com/loomnetwork/loomchain/builtin/plugins/chainconfig"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestBlockTxLimiterMiddleware(t *testing.T) {
+	origin := loom.MustParseAddress("chain:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	kvStore := store.NewMemStore()
+	fakeCtx := goloomplugin.CreateFakeContext(origin, origin)
+	chainConfigAddr := fakeCtx.CreateContract(chainconfig.Contract)
+	chainConfigCtx := contractpb.WrapPluginContext(fakeCtx.WithAddress(chainConfigAddr))
+	require.NoError(t, (&chainconfig.ChainConfig{}).Init(chainConfigCtx, &chainconfig.InitRequest{
+		Owner: origin.MarshalPB(),
+	}))
+	mw := NewBlockTxLimiterMiddleware(
+		func(state loomchain.State) (contractpb.StaticContext, error) { return chainConfigCtx, nil },
+		kvStore,
+	)
+
+	processTx := func(height int64, isCheckTx bool, txFails bool) error {
+		state := loomchain.NewStoreState(nil, kvStore, abci.Header{Height: height}, nil, nil)
+		state.SetFeature(features.BlockTxLimiterFeature, true)
+		ctx := context.WithValue(state.Context(), loomAuth.ContextKeyOrigin, origin)
+		_, err := mw.ProcessTx(state.WithContext(ctx), nil,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				if txFails {
+					return loomchain.TxHandlerResult{}, ErrContractNotWhitelisted
+				}
+				return loomchain.TxHandlerResult{}, nil
+			}, isCheckTx,
+		)
+		return err
+	}
+
+	// no limits are enforced until they're set in the ChainConfig contract
+	for i := 0; i < 3; i++ {
+		require.NoError(t, processTx(1, false, false))
+	}
+	require.NoError(t, (&chainconfig.ChainConfig{}).SetBlockTxLimits(chainConfigCtx, &chainconfig.SetBlockTxLimitsRequest{
+		Limits: &chainconfig.BlockTxLimits{BlockRange: 10, MaxTxsPerRange: 2},
+	}))
+
+	// CheckTx doesn't update the counters
+	require.NoError(t, processTx(10, true, false))
+	require.NoError(t, processTx(10, true, false))
+	require.NoError(t, processTx(10, true, false))
+
+	require.NoError(t, processTx(10, false, false))
+	// failed txs still count towards the limit
+	require.Equal(t, ErrContractNotWhitelisted, processTx(12, false, true))
+	require.Equal(t, ErrTxLimitReached, processTx(15, true, false))
+	require.Equal(t, ErrTxLimitReached, processTx(19, false, false))
+
+	// the limit is reset in the next block range
+	require.NoError(t, processTx(20, false, false))
+	require.NoError(t, processTx(29, false, false))
+	require.Equal(t, ErrTxLimitReached, processTx(29, false, false))
+}
@@ -1,6 +1,7 @@
 package throttle
 
 import (
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -81,12 +82,39 @@ type contractTxLimiter struct {
 	// track of no. of txns in previous blocks per contract
 	contractStatsMap    map[string]*contractStats
 	tierMap             map[udw.TierID]udw.Tier
+	tierLimitsMap       map[udw.TierID]*udw.TierLimits
 	tierDataLastUpdated int64
 }
 
 type contractStats struct {
 	txn         int64
 	blockHeight int64
+	// no. of txns per contract method within the current block range
+	methodTxns map[string]int64
+	// gas consumed by txns within the current block range
+	gasUsed uint64
+}
+
+func (txl *contractTxLimiter) blockRange(tier udw.Tier) int64 {
+	if tier.BlockRange > 0 {
+		return int64(tier.BlockRange)
+	}
+	return int64(4096) // prevent divide by zero just in case tier doesn't have a range set
+}
+
+// currentStats returns the stats for the block range the given block height falls into, or nil
+// if no txs have been sent to the contract within that block range.
+func (txl *contractTxLimiter) currentStats(contractAddr loom.Address, curBlockHeight int64) *contractStats {
+	blockTx, ok := txl.contractStatsMap[contractAddr.String()]
+	if !ok {
+		return nil
+	}
+	tierID := txl.contractToTierMap[contractAddr.String()]
+	tier := txl.tierMap[tierID]
+	if blockTx.blockHeight <= (curBlockHeight - txl.blockRange(tier)) {
+		return nil
+	}
+	return blockTx
 }
 
 func (txl *contractTxLimiter) isAccountLimitReached(contractAddr loom.Address, curBlockHeight int64) bool {
@@ -103,21 +131,68 @@ func (txl *contractTxLimiter) isAccountLimitReached(contractAddr loom.Address, c
 	return true
 }
 
-func (txl *contractTxLimiter) updateState(contractAddr loom.Address, curBlockHeight int64) {
-	blockTx, ok := txl.contractStatsMap[contractAddr.String()]
-	tierID := txl.contractToTierMap[contractAddr.String()]
-	tier := txl.tierMap[tierID]
-	blockRange := int64(4096) // prevent divide by zero just in case tier doesn't have a range set
-	if tier.BlockRange > 0 {
-		blockRange = int64(tier.BlockRange)
+// isMethodLimitReached checks the per-method tx limits & gas budget of the contract's tier.
+func (txl *contractTxLimiter) isMethodLimitReached(
+	contractAddr loom.Address, method string, curBlockHeight int64,
+) bool {
+	limits, ok := txl.tierLimitsMap[txl.contractToTierMap[contractAddr.String()]]
+	if !ok {
+		return false
+	}
+	blockTx := txl.currentStats(contractAddr, curBlockHeight)
+	if blockTx == nil {
+		return false
 	}
-	if !ok || blockTx.blockHeight <= (curBlockHeight-blockRange) {
-		// resetting the blockHeight to lower bound of range instead of curblockheight
-		rangeStart := (((curBlockHeight - 1) / blockRange) * blockRange) + 1
-		txl.contractStatsMap[contractAddr.String()] = &contractStats{1, rangeStart}
-		return
+	if limits.GasBudget > 0 && blockTx.gasUsed >= limits.GasBudget {
+		return true
+	}
+	for _, limit := range limits.MethodLimits {
+		if limit.Method == method {
+			return blockTx.methodTxns[method] >= int64(limit.MaxTxs)
+		}
+	}
+	return false
+}
+
+func (txl *contractTxLimiter) updateState(contractAddr loom.Address, method string, curBlockHeight int64) {
+	blockTx := txl.currentStats(contractAddr, curBlockHeight)
+	if blockTx == nil {
+		blockTx = txl.resetStats(contractAddr, curBlockHeight)
 	}
 	blockTx.txn++
+	blockTx.methodTxns[method]++
+}
+
+// recordGasUsage adds the gas consumed by a tx to the stats of the contract the tx was sent to.
+func (txl *contractTxLimiter) recordGasUsage(contractAddr loom.Address, curBlockHeight int64, gasUsed uint64) {
+	blockTx := txl.currentStats(contractAddr, curBlockHeight)
+	if blockTx == nil {
+		blockTx = txl.resetStats(contractAddr, curBlockHeight)
+	}
+	blockTx.gasUsed += gasUsed
+}
+
+func (txl *contractTxLimiter) resetStats(contractAddr loom.Address, curBlockHeight int64) *contractStats {
+	tierID := txl.contractToTierMap[contractAddr.String()]
+	blockRange := txl.blockRange(txl.tierMap[tierID])
+	// resetting the blockHeight to lower bound of range instead of curblockheight
+	rangeStart := (((curBlockHeight - 1) / blockRange) * blockRange) + 1
+	blockTx := &contractStats{
+		blockHeight: rangeStart,
+		methodTxns:  make(map[string]int64),
+	}
+	txl.contractStatsMap[contractAddr.String()] = blockTx
+	return blockTx
+}
+
+// hasGasBudget returns true if the tier of the given contract limits the gas txs sent to it can use.
+func (txl *contractTxLimiter) hasGasBudget(contractAddr loom.Address) bool {
+	tierID, ok := txl.contractToTierMap[contractAddr.String()]
+	if !ok {
+		return false
+	}
+	limits, ok := txl.tierLimitsMap[tierID]
+	return ok && limits.GasBudget > 0
 }
 
 func loadContractTierMap(ctx contractpb.StaticContext) (*udw.ContractInfo, error) {
@@ -140,8 +215,65 @@ func loadTierMap(ctx contractpb.StaticContext) (map[udwtypes.TierID]udwtypes.Tie
 	return tierMap, err
 }
 
+// parseContractCall extracts the address of the EVM contract, and the method being called, from a
+// call tx. Returns false if the tx isn't an EVM call tx, or is an Ethereum contract deployment tx.
+// Go contract calls are ignored since only EVM contracts are registered with the limiter.
+func parseContractCall(txBytes []byte) (contractAddr loom.Address, method string, isCall bool, err error) {
+	var nonceTx auth.NonceTx
+	if err := proto.Unmarshal(txBytes, &nonceTx); err != nil {
+		return contractAddr, "", false, errors.Wrap(err, "throttle: unwrap nonce Tx")
+	}
+	var tx loomchain.Transaction
+	if err := proto.Unmarshal(nonceTx.Inner, &tx); err != nil {
+		return contractAddr, "", false, errors.New("throttle: unmarshal tx")
+	}
+
+	var msg vm.MessageTx
+	switch ltypes.TxID(tx.Id) {
+	case ltypes.TxID_CALL:
+		if err := proto.Unmarshal(tx.Data, &msg); err != nil {
+			return contractAddr, "", false, errors.Wrapf(err, "unmarshal message tx %v", tx.Data)
+		}
+		var callTx vm.CallTx
+		if err := proto.Unmarshal(msg.Data, &callTx); err != nil {
+			return contractAddr, "", false, errors.Wrapf(err, "unmarshal call tx %v", msg.Data)
+		}
+		switch callTx.VmType {
+		case vm.VMType_EVM:
+			if len(callTx.Input) >= 4 {
+				method = "0x" + hex.EncodeToString(callTx.Input[:4])
+			}
+		default:
+			return contractAddr, "", false, nil
+		}
+
+	case ltypes.TxID_ETHEREUM:
+		if err := proto.Unmarshal(tx.Data, &msg); err != nil {
+			return contractAddr, "", false, errors.Wrapf(err, "unmarshal message tx %v", tx.Data)
+		}
+		isDeploy, err := isEthDeploy(msg.Data)
+		if err != nil {
+			return contractAddr, "", false, err
+		}
+		if isDeploy {
+			return contractAddr, "", false, nil
+		}
+		if method, err = ethTxMethod(msg.Data); err != nil {
+			return contractAddr, "", false, err
+		}
+
+	default:
+		return contractAddr, "", false, nil
+	}
+	return loom.UnmarshalAddressPB(msg.To), method, true, nil
+}
+
 // NewContractTxLimiterMiddleware creates a middleware function that limits how many call txs can be
-// sent to an EVM contract within a pre-configured block range.
+// sent to an EVM contract within a pre-configured block range. In addition to the overall limit each
+// tier can limit the number of txs sent to individual contract methods, and the amount of gas all
+// the txs sent to a contract can consume, within the same block range. The gas consumed by each tx
+// is only known after the tx is executed, so it's recorded in DeliverTx, and checked in CheckTx
+// against the totals recorded for the current block range.
 func NewContractTxLimiterMiddleware(cfg *ContractTxLimiterConfig,
 	createUserDeployerWhitelistCtx func(state loomchain.State) (contractpb.Context, error),
 ) loomchain.TxMiddlewareFunc {
@@ -155,44 +287,29 @@ func NewContractTxLimiterMiddleware(cfg *ContractTxLimiterConfig,
 		isCheckTx bool,
 	) (res loomchain.TxHandlerResult, err error) {
 		if !isCheckTx {
-			return next(state, txBytes, isCheckTx)
-		}
-		var nonceTx auth.NonceTx
-		if err := proto.Unmarshal(txBytes, &nonceTx); err != nil {
-			return res, errors.Wrap(err, "throttle: unwrap nonce Tx")
-		}
-		var tx loomchain.Transaction
-		if err := proto.Unmarshal(nonceTx.Inner, &tx); err != nil {
-			return res, errors.New("throttle: unmarshal tx")
-		}
-
-		var msg vm.MessageTx
-		switch ltypes.TxID(tx.Id) {
-		case ltypes.TxID_CALL:
-			if err := proto.Unmarshal(tx.Data, &msg); err != nil {
-				return res, errors.Wrapf(err, "unmarshal message tx %v", tx.Data)
-			}
-			var callTx vm.CallTx
-			if err := proto.Unmarshal(msg.Data, &callTx); err != nil {
-				return res, errors.Wrapf(err, "unmarshal call tx %v", msg.Data)
-			}
-			if callTx.VmType != vm.VMType_EVM {
+			if len(txl.tierLimitsMap) == 0 {
 				return next(state, txBytes, isCheckTx)
 			}
-
-		case ltypes.TxID_ETHEREUM:
-			if err := proto.Unmarshal(tx.Data, &msg); err != nil {
-				return res, errors.Wrapf(err, "unmarshal message tx %v", tx.Data)
-			}
-			isDeploy, err := isEthDeploy(msg.Data)
-			if err != nil {
-				return res, err
-			}
-			if isDeploy {
+			contractAddr, _, isCall, err := parseContractCall(txBytes)
+			if err != nil || !isCall || !txl.hasGasBudget(contractAddr) {
 				return next(state, txBytes, isCheckTx)
 			}
+			gasMeter := loomchain.GasMeterFromContext(state.Context())
+			if gasMeter == nil {
+				gasMeter = &loomchain.GasMeter{}
+				state = state.WithContext(loomchain.WithGasMeter(state.Context(), gasMeter))
+			}
+			gasBefore := gasMeter.GasUsed()
+			r, err := next(state, txBytes, isCheckTx)
+			txl.recordGasUsage(contractAddr, state.Block().Height, gasMeter.GasUsed()-gasBefore)
+			return r, err
+		}
 
-		default:
+		contractAddr, method, isCall, err := parseContractCall(txBytes)
+		if err != nil {
+			return res, err
+		}
+		if !isCall {
 			return next(state, txBytes, isCheckTx)
 		}
 
@@ -213,7 +330,6 @@ func NewContractTxLimiterMiddleware(cfg *ContractTxLimiterConfig,
 			txl.inactiveDeployerContracts = contractInfo.InactiveDeployerContracts
 			// TxLimiter.contractDataLastUpdated will be updated after updating contractToTierMap
 		}
-		contractAddr := loom.UnmarshalAddressPB(msg.To)
 		// contracts which are deployed by deleted deployers should be throttled
 		if txl.inactiveDeployerContracts[contractAddr.String()] {
 			return res, ErrInactiveDeployer
@@ -233,6 +349,10 @@ func NewContractTxLimiterMiddleware(cfg *ContractTxLimiterConfig,
 			if err != nil {
 				return res, errors.Wrap(err, "throttle: GetTierMap error")
 			}
+			txl.tierLimitsMap, err = udw.GetTierLimitsMap(ctx)
+			if err != nil {
+				return res, errors.Wrap(err, "throttle: GetTierLimitsMap error")
+			}
 			txl.tierDataLastUpdated = time.Now().Unix()
 		}
 		// ensure that tier corresponding to contract available in tierMap
@@ -249,10 +369,11 @@ func NewContractTxLimiterMiddleware(cfg *ContractTxLimiterConfig,
 			txl.tierMap[contractTierID] = tierInfo
 		}
 
-		if txl.isAccountLimitReached(contractAddr, state.Block().Height) {
+		if txl.isAccountLimitReached(contractAddr, state.Block().Height) ||
+			txl.isMethodLimitReached(contractAddr, method, state.Block().Height) {
 			return loomchain.TxHandlerResult{}, ErrTxLimitReached
 		}
-		txl.updateState(contractAddr, state.Block().Height)
+		txl.updateState(contractAddr, method, state.Block().Height)
 
 		return next(state, txBytes, isCheckTx)
 	})
@@ -164,3 +164,97 @@ func TestContractTxLimiterMiddleware(t *testing.T) {
 	processMiddleware(state, txSignedEVM1.Inner)
 	require.Equal(t, allowed, false)
 }
+
+func TestContractTxLimiterMethodLimits(t *testing.T) {
+	fakeCtx := goloomplugin.CreateFakeContext(addr1, addr1)
+	fakeCtx.SetFeature(features.CoinVersion1_1Feature, true)
+	fakeCtx.SetFeature(features.UserDeployerWhitelistVersion1_1Feature, true)
+	fakeCtx.SetFeature(features.UserDeployerWhitelistVersion1_3Feature, true)
+	udwAddr := fakeCtx.CreateContract(udw.Contract)
+	udwContext := fakeCtx.WithAddress(udwAddr)
+	udwContract := &udw.UserDeployerWhitelist{}
+	require.NoError(t, udwContract.Init(contractpb.WrapPluginContext(udwContext), &udwtypes.InitRequest{
+		Owner: owner.MarshalPB(),
+		TierInfo: []*udwtypes.TierInfo{
+			{TierID: udwtypes.TierID_DEFAULT, Fee: 100, Name: "Tier1", BlockRange: 10, MaxTxs: 30},
+		},
+	}))
+
+	coinContract := &coin.Coin{}
+	coinAddr := fakeCtx.CreateContract(coin.Contract)
+	coinCtx := fakeCtx.WithAddress(coinAddr)
+	require.NoError(t, coinContract.Init(contractpb.WrapPluginContext(coinCtx), &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{
+			{Owner: user, Balance: uint64(300)},
+		},
+	}))
+	ret := loom.NewBigUIntFromInt(10)
+	ret.Exp(ret, loom.NewBigUIntFromInt(18), nil)
+	ret.Mul(ret, loom.NewBigUIntFromInt(1000))
+	require.NoError(t, coinContract.Approve(contractpb.WrapPluginContext(coinCtx.WithSender(addr3)), &coin.ApproveRequest{
+		Spender: udwAddr.MarshalPB(),
+		Amount:  &types.BigUInt{Value: *ret},
+	}))
+	require.NoError(t, udwContract.AddUserDeployer(contractpb.WrapPluginContext(udwContext.WithSender(addr3)),
+		&udwtypes.WhitelistUserDeployerRequest{
+			DeployerAddr: addr1.MarshalPB(),
+			TierID:       0,
+		}))
+	require.NoError(t, udw.RecordEVMContractDeployment(contractpb.WrapPluginContext(udwContext.WithSender(addr3)),
+		addr1, contractAddr))
+
+	// mockSignedTx calls the EVM contract with "origin" as the input
+	require.NoError(t, udwContract.SetTierLimits(contractpb.WrapPluginContext(udwContext.WithSender(owner)),
+		&udw.SetTierLimitsRequest{
+			TierId:       int32(udwtypes.TierID_DEFAULT),
+			MethodLimits: []*udw.MethodTxLimit{{Method: "0x6f726967", MaxTxs: 3}},
+		}))
+
+	txSignedEVM1 := mockSignedTx(t, uint64(1), types.TxID_CALL, vm.VMType_EVM, contractAddr)
+	contractTxLimiterMiddleware := NewContractTxLimiterMiddleware(DefaultContractTxLimiterConfig(),
+		func(state loomchain.State) (contractpb.Context, error) {
+			return contractpb.WrapPluginContext(udwContext), nil
+		},
+	)
+	processTx := func(height int64, isCheckTx bool, gasUsed uint64) error {
+		state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{Height: height}, nil, nil)
+		_, err := contractTxLimiterMiddleware.ProcessTx(
+			state,
+			txSignedEVM1.Inner,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (res loomchain.TxHandlerResult, err error) {
+				if gasMeter := loomchain.GasMeterFromContext(state.Context()); gasMeter != nil {
+					gasMeter.ConsumeGas(gasUsed)
+				}
+				return loomchain.TxHandlerResult{}, nil
+			},
+			isCheckTx,
+		)
+		return err
+	}
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, processTx(5, true, 0))
+	}
+	require.Equal(t, ErrTxLimitReached, processTx(5, true, 0))
+	// method limit is reset in the next block range
+	require.NoError(t, processTx(11, true, 0))
+
+	require.NoError(t, udwContract.SetTierLimits(contractpb.WrapPluginContext(udwContext.WithSender(owner)),
+		&udw.SetTierLimitsRequest{
+			TierId:    int32(udwtypes.TierID_DEFAULT),
+			GasBudget: 1000,
+		}))
+	contractTxLimiterMiddleware = NewContractTxLimiterMiddleware(DefaultContractTxLimiterConfig(),
+		func(state loomchain.State) (contractpb.Context, error) {
+			return contractpb.WrapPluginContext(udwContext), nil
+		},
+	)
+	require.NoError(t, processTx(5, true, 0))
+	require.NoError(t, processTx(5, false, 600))
+	require.NoError(t, processTx(5, true, 0))
+	require.NoError(t, processTx(5, false, 600))
+	// gas budget exhausted
+	require.Equal(t, ErrTxLimitReached, processTx(5, true, 0))
+	// gas budget is reset in the next block range
+	require.NoError(t, processTx(11, true, 0))
+}
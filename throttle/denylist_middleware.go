@@ -0,0 +1,266 @@
+package throttle
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	"github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/auth"
+	udw "github.com/loomnetwork/loomchain/builtin/plugins/user_deployer_whitelist"
+	"github.com/loomnetwork/loomchain/log"
+	"github.com/pkg/errors"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	ErrOriginDenied   = errors.New("tx origin is on the denylist")
+	ErrContractDenied = errors.New("contract is on the denylist")
+	ErrMethodDenied   = errors.New("contract method is on the denylist")
+)
+
+var (
+	deniedTxCount metrics.Counter
+)
+
+func init() {
+	deniedTxCount = kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
+		Namespace: "loomchain",
+		Subsystem: "denylist_middleware",
+		Name:      "denied_tx_count",
+		Help:      "Number of txs rejected by the denylist.",
+	}, []string{"reason"})
+}
+
+type DenylistConfig struct {
+	// Enables the middleware
+	Enabled bool
+	// Path to the JSON file containing the denylist, relative paths are relative to the node
+	// root directory.
+	File string
+	// Number of seconds between checks for changes to the denylist file, zero disables automatic
+	// reloading, in which case the file can only be reloaded via the unsafe RPC interface.
+	ReloadInterval int64
+}
+
+func DefaultDenylistConfig() *DenylistConfig {
+	return &DenylistConfig{
+		Enabled:        false,
+		File:           "denylist.json",
+		ReloadInterval: 30,
+	}
+}
+
+// Clone returns a deep clone of the config.
+func (c *DenylistConfig) Clone() *DenylistConfig {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	return &clone
+}
+
+// DenylistEntries is the format of the denylist file.
+type DenylistEntries struct {
+	// Addresses of accounts that shouldn't be allowed to send any txs, e.g. default:0x...
+	Origins []string
+	// Addresses of contracts that shouldn't be allowed to receive any txs.
+	Contracts []string
+	// 4-byte EVM function selectors (e.g. 0xa9059cbb), or Go contract method names, of methods
+	// that shouldn't be callable on any contract.
+	Methods []string
+}
+
+// Denylist is a node-local list of origins, contracts & methods that shouldn't be allowed through
+// the mempool. The list can be reloaded from disk while the node is running.
+type Denylist struct {
+	path string
+
+	mutex     sync.RWMutex
+	modTime   time.Time
+	entries   DenylistEntries
+	origins   map[string]bool
+	contracts map[string]bool
+	methods   map[string]bool
+}
+
+// NewDenylist loads the denylist from the given file, if the file doesn't exist the denylist will
+// be empty until the file is created and reloaded.
+func NewDenylist(path string) (*Denylist, error) {
+	d := &Denylist{
+		path:      path,
+		origins:   map[string]bool{},
+		contracts: map[string]bool{},
+		methods:   map[string]bool{},
+	}
+	if err := d.Reload(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Reload reloads the denylist from disk, if the file can't be loaded the previously loaded denylist
+// remains in effect.
+func (d *Denylist) Reload() error {
+	info, err := os.Stat(d.path)
+	if os.IsNotExist(err) {
+		return d.set(DenylistEntries{}, time.Time{})
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to stat denylist file %s", d.path)
+	}
+	data, err := ioutil.ReadFile(d.path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read denylist file %s", d.path)
+	}
+	var entries DenylistEntries
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return errors.Wrapf(err, "failed to parse denylist file %s", d.path)
+	}
+	return d.set(entries, info.ModTime())
+}
+
+// set replaces the current denylist with the given entries.
+func (d *Denylist) set(entries DenylistEntries, modTime time.Time) error {
+	origins, err := parseDenylistAddresses(entries.Origins)
+	if err != nil {
+		return errors.Wrap(err, "invalid origin")
+	}
+	contracts, err := parseDenylistAddresses(entries.Contracts)
+	if err != nil {
+		return errors.Wrap(err, "invalid contract")
+	}
+	methods := make(map[string]bool, len(entries.Methods))
+	for _, method := range entries.Methods {
+		methods[udw.NormalizeMethod(method)] = true
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.modTime = modTime
+	d.entries = entries
+	d.origins = origins
+	d.contracts = contracts
+	d.methods = methods
+	log.Info("Loaded denylist",
+		"file", d.path, "origins", len(origins), "contracts", len(contracts), "methods", len(methods),
+	)
+	return nil
+}
+
+// Entries returns the entries of the currently loaded denylist.
+func (d *Denylist) Entries() DenylistEntries {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	return d.entries
+}
+
+// AutoReload periodically checks if the denylist file has been modified and reloads it if it has,
+// this function blocks until the stop channel is closed.
+func (d *Denylist) AutoReload(interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			info, err := os.Stat(d.path)
+			if err != nil && !os.IsNotExist(err) {
+				log.Error("Failed to check denylist file", "file", d.path, "err", err)
+				continue
+			}
+			var modTime time.Time
+			if err == nil {
+				modTime = info.ModTime()
+			}
+			d.mutex.RLock()
+			changed := !modTime.Equal(d.modTime)
+			d.mutex.RUnlock()
+			if !changed {
+				continue
+			}
+			if err := d.Reload(); err != nil {
+				log.Error("Failed to reload denylist", "file", d.path, "err", err)
+			}
+		}
+	}
+}
+
+func (d *Denylist) check(origin, contract loom.Address, method string) error {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	if d.origins[origin.String()] {
+		return ErrOriginDenied
+	}
+	if contract.IsEmpty() {
+		return nil
+	}
+	if d.contracts[contract.String()] {
+		return ErrContractDenied
+	}
+	if method != "" && d.methods[method] {
+		return ErrMethodDenied
+	}
+	return nil
+}
+
+func parseDenylistAddresses(addrs []string) (map[string]bool, error) {
+	parsed := make(map[string]bool, len(addrs))
+	for _, addrStr := range addrs {
+		addr, err := loom.ParseAddress(addrStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse address %s", addrStr)
+		}
+		parsed[addr.String()] = true
+	}
+	return parsed, nil
+}
+
+// NewDenylistMiddleware creates middleware that rejects txs from denied origins, and txs calling
+// denied contracts or contract methods. This middleware only runs in CheckTx so it doesn't affect
+// consensus, it just keeps unwanted txs out of the mempool of the node.
+func NewDenylistMiddleware(denylist *Denylist) loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (res loomchain.TxHandlerResult, err error) {
+		if !isCheckTx {
+			return next(state, txBytes, isCheckTx)
+		}
+
+		origin := auth.Origin(state.Context())
+		if origin.IsEmpty() {
+			return res, errors.New("throttle: transaction has no origin [denylist]")
+		}
+
+		contractAddr, method, isCall, err := parseContractCall(txBytes)
+		if err != nil {
+			return res, err
+		}
+		if !isCall {
+			contractAddr = loom.Address{}
+		}
+
+		if err := denylist.check(origin, contractAddr, method); err != nil {
+			reason := "origin"
+			if err == ErrContractDenied {
+				reason = "contract"
+			} else if err == ErrMethodDenied {
+				reason = "method"
+			}
+			deniedTxCount.With("reason", reason).Add(1)
+			log.Info("Denylist rejected tx",
+				"reason", reason, "origin", origin.String(), "contract", contractAddr.String(), "method", method,
+			)
+			return res, err
+		}
+		return next(state, txBytes, isCheckTx)
+	})
+}
@@ -0,0 +1,79 @@
+// +build evm
+
+package throttle
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain"
+	loomAuth "github.com/loomnetwork/loomchain/auth"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/loomnetwork/loomchain/vm"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestDenylistMiddleware(t *testing.T) {
+	dir, err := ioutil.TempDir("", "denylist")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	denylistPath := filepath.Join(dir, "denylist.json")
+
+	// missing file results in an empty denylist
+	denylist, err := NewDenylist(denylistPath)
+	require.NoError(t, err)
+	mw := NewDenylistMiddleware(denylist)
+
+	origin := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4")
+	target := loom.MustParseAddress("default:0x5cecd1f7261e1f4c684e297be3edf03b825e01ab")
+	processTx := func(from, to loom.Address, isCheckTx bool) error {
+		state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{Height: 1}, nil, nil)
+		ctx := context.WithValue(state.Context(), loomAuth.ContextKeyOrigin, from)
+		tx := mockSignedTx(t, uint64(1), types.TxID_CALL, vm.VMType_EVM, to)
+		_, err := mw.ProcessTx(state.WithContext(ctx), tx.Inner,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				return loomchain.TxHandlerResult{}, nil
+			}, isCheckTx,
+		)
+		return err
+	}
+	writeDenylist := func(data string) {
+		require.NoError(t, ioutil.WriteFile(denylistPath, []byte(data), 0644))
+	}
+
+	require.NoError(t, processTx(origin, target, true))
+
+	writeDenylist(`{"Origins": ["default:0x5cecd1f7261e1f4c684e297be3edf03b825e01c4"]}`)
+	require.NoError(t, denylist.Reload())
+	require.Equal(t, ErrOriginDenied, processTx(origin, target, true))
+	require.NoError(t, processTx(target, target, true))
+	// DeliverTx is never affected by the denylist
+	require.NoError(t, processTx(origin, target, false))
+
+	writeDenylist(`{"Contracts": ["default:0x5cecd1f7261e1f4c684e297be3edf03b825e01ab"]}`)
+	require.NoError(t, denylist.Reload())
+	require.Equal(t, ErrContractDenied, processTx(origin, target, true))
+	require.NoError(t, processTx(origin, origin, true))
+
+	// mockSignedTx calls the EVM contract with "origin" as the input
+	writeDenylist(`{"Methods": ["0x6F726967"]}`)
+	require.NoError(t, denylist.Reload())
+	require.Equal(t, ErrMethodDenied, processTx(origin, target, true))
+	require.Equal(t, ErrMethodDenied, processTx(origin, origin, true))
+
+	// previously loaded denylist remains in effect if the file is invalid
+	writeDenylist(`{"Origins": ["not an address"]}`)
+	require.Error(t, denylist.Reload())
+	require.Equal(t, ErrMethodDenied, processTx(origin, target, true))
+	require.Equal(t, []string{"0x6F726967"}, denylist.Entries().Methods)
+
+	require.NoError(t, os.Remove(denylistPath))
+	require.NoError(t, denylist.Reload())
+	require.NoError(t, processTx(origin, target, true))
+}
@@ -3,6 +3,8 @@
 package throttle
 
 import (
+	"encoding/hex"
+
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/pkg/errors"
@@ -15,3 +17,16 @@ func isEthDeploy(txBytes []byte) (bool, error) {
 	}
 	return tx.To() == nil, nil
 }
+
+// ethTxMethod returns the 4-byte function selector of the contract method called by the given
+// Ethereum tx, or an empty string if the tx data is too short to contain a selector.
+func ethTxMethod(txBytes []byte) (string, error) {
+	var tx types.Transaction
+	if err := rlp.DecodeBytes(txBytes, &tx); err != nil {
+		return "", errors.Wrap(err, "decoding ethereum transaction")
+	}
+	if len(tx.Data()) < 4 {
+		return "", nil
+	}
+	return "0x" + hex.EncodeToString(tx.Data()[:4]), nil
+}
@@ -9,3 +9,7 @@ import (
 func isEthDeploy(_ []byte) (bool, error) {
 	return false, errors.New("ethereum transactions not supported in non evm build")
 }
+
+func ethTxMethod(_ []byte) (string, error) {
+	return "", errors.New("ethereum transactions not supported in non evm build")
+}
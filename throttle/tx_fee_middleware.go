@@ -0,0 +1,238 @@
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/loomnetwork/go-loom"
+	"github.com/loomnetwork/go-loom/common"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/loomchain"
+	"github.com/loomnetwork/loomchain/auth"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
+	"github.com/loomnetwork/loomchain/builtin/plugins/ethcoin"
+	"github.com/loomnetwork/loomchain/builtin/plugins/tx_fees"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"golang.org/x/crypto/ed25519"
+)
+
+var (
+	ErrInsufficientFee = errors.New("fee payer balance is too low to pay the tx fee")
+)
+
+type contextKey string
+
+var contextKeyFeePayer = contextKey("fee-payer")
+
+// FeePayer returns the account that agreed to pay the fee for the current tx, or an empty address
+// if the tx isn't sponsored.
+func FeePayer(ctx context.Context) loom.Address {
+	payer, _ := ctx.Value(contextKeyFeePayer).(loom.Address)
+	return payer
+}
+
+// NewFeePayerMiddleware creates middleware that verifies the co-signature of the fee payer on
+// sponsored txs (see tx_fees.FeePayerSignBytes), and records the fee payer address in the state context so the tx fee middleware
+// can charge the fee to the payer instead of the tx origin. This middleware must run before the
+// auth middleware because it needs to see the outermost tx envelope.
+func NewFeePayerMiddleware() loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (loomchain.TxHandlerResult, error) {
+		var r loomchain.TxHandlerResult
+		if !state.FeatureEnabled(features.TxFeesFeature, false) {
+			return next(state, txBytes, isCheckTx)
+		}
+
+		var tx tx_fees.SponsoredTx
+		// Malformed txs will be rejected by the auth middleware
+		if err := proto.Unmarshal(txBytes, &tx); err != nil || tx.FeePayer == nil {
+			return next(state, txBytes, isCheckTx)
+		}
+
+		payer := tx.FeePayer
+		if len(payer.PublicKey) != ed25519.PublicKeySize {
+			return r, errors.New("invalid fee payer public key length")
+		}
+		if len(payer.Signature) != ed25519.SignatureSize {
+			return r, errors.New("invalid fee payer signature length")
+		}
+		var nonceTx auth.NonceTx
+		if err := proto.Unmarshal(tx.Inner, &nonceTx); err != nil {
+			return r, errors.Wrap(err, "failed to unmarshal sponsored tx")
+		}
+		signBytes := tx_fees.FeePayerSignBytes(
+			state.Block().ChainID, tx.PublicKey, nonceTx.Sequence, tx.Inner,
+		)
+		if !ed25519.Verify(payer.PublicKey, signBytes, payer.Signature) {
+			return r, errors.New("invalid fee payer signature")
+		}
+
+		payerAddr := loom.Address{
+			ChainID: state.Block().ChainID,
+			Local:   loom.LocalAddressFromPublicKey(payer.PublicKey),
+		}
+		ctx := context.WithValue(state.Context(), contextKeyFeePayer, payerAddr)
+		return next(state.WithContext(ctx), txBytes, isCheckTx)
+	})
+}
+
+// NewTxFeeMiddleware creates middleware that charges each tx the fee specified by the fee schedule
+// stored in the TxFees contract, LOOM fees are transferred to the DPOS contract so they can be
+// distributed with the rest of the rewards, ETH fees are transferred to the treasury specified by
+// the fee schedule. In CheckTx txs are rejected if the fee payer can't
+// afford the flat tx fee, the gas fee is only known after the tx is executed in DeliverTx. If the
+// fee payer can't afford the total fee in DeliverTx the tx fails and all its changes are reverted.
+// Txs that fail in DeliverTx are still charged for the gas they used, the fee is written directly
+// to kvStore so it isn't reverted along with the rest of the tx (the same way the nonce handler
+// increments nonces of failed txs).
+// The fee is charged to the tx origin unless the tx is sponsored by a fee payer, or submitted by a
+// relayer.
+func NewTxFeeMiddleware(
+	kvStore store.KVStore,
+	createTxFeesCtx func(state loomchain.State) (contractpb.StaticContext, error),
+	createCoinCtx func(state loomchain.State) (contractpb.Context, error),
+	createEthCoinCtx func(state loomchain.State) (contractpb.Context, error),
+) loomchain.TxMiddlewareFunc {
+	return loomchain.TxMiddlewareFunc(func(
+		state loomchain.State,
+		txBytes []byte,
+		next loomchain.TxHandlerFunc,
+		isCheckTx bool,
+	) (res loomchain.TxHandlerResult, err error) {
+		if !state.FeatureEnabled(features.TxFeesFeature, false) {
+			return next(state, txBytes, isCheckTx)
+		}
+
+		feesCtx, err := createTxFeesCtx(state)
+		if err != nil {
+			return res, errors.Wrap(err, "failed to create TxFees contract context")
+		}
+		cfg, err := tx_fees.GetFeeConfig(feesCtx)
+		if err != nil {
+			return res, errors.Wrap(err, "failed to load fee config")
+		}
+
+		payer := FeePayer(state.Context())
+		if payer.IsEmpty() {
+			payer = auth.Relayer(state.Context())
+		}
+		if payer.IsEmpty() {
+			payer = auth.Origin(state.Context())
+		}
+		if payer.IsEmpty() {
+			return res, errors.New("throttle: transaction has no origin [tx-fee]")
+		}
+
+		var createCtx func(state loomchain.State) (contractpb.Context, error)
+		var balanceOf func(ctx contractpb.StaticContext, owner loom.Address) (*loom.BigUInt, error)
+		var transfer func(ctx contractpb.Context, from, to loom.Address, amount *loom.BigUInt) error
+		switch cfg.Denom {
+		case tx_fees.Denom_COIN:
+			createCtx, balanceOf, transfer = createCoinCtx, coin.BalanceOf, coin.Transfer
+		case tx_fees.Denom_ETHCOIN:
+			createCtx, balanceOf, transfer = createEthCoinCtx, ethcoin.BalanceOf, ethcoin.Transfer
+		default:
+			return res, fmt.Errorf("unsupported fee denomination %v", cfg.Denom)
+		}
+
+		if isCheckTx {
+			minFee := tx_fees.ComputeFee(cfg, 0)
+			if minFee.Cmp(common.BigZero()) > 0 {
+				ctx, err := createCtx(state)
+				if err != nil {
+					return res, errors.Wrap(err, "failed to create fee contract context")
+				}
+				balance, err := balanceOf(ctx, payer)
+				if err != nil {
+					return res, errors.Wrapf(err, "failed to load balance of %s", payer.String())
+				}
+				if balance.Cmp(minFee) < 0 {
+					return res, ErrInsufficientFee
+				}
+			}
+			return next(state, txBytes, isCheckTx)
+		}
+
+		// Reuse the gas meter set up by a preceding middleware (if any) so it still sees all the gas
+		// consumed by the tx.
+		gasMeter := loomchain.GasMeterFromContext(state.Context())
+		if gasMeter == nil {
+			gasMeter = &loomchain.GasMeter{}
+			state = state.WithContext(loomchain.WithGasMeter(state.Context(), gasMeter))
+		}
+		gasBefore := gasMeter.GasUsed()
+		r, txErr := next(state, txBytes, isCheckTx)
+		fee := tx_fees.ComputeFee(cfg, gasMeter.GasUsed()-gasBefore)
+		if fee.Cmp(common.BigZero()) == 0 {
+			return r, txErr
+		}
+
+		feeState := state
+		if txErr != nil {
+			feeState = persistentState(state, kvStore)
+		}
+		if err := chargeFee(feeState, cfg, payer, fee, createCtx, transfer); err != nil {
+			// A failed tx is rejected with its own error even if the fee payer can't afford the fee.
+			if txErr != nil {
+				return r, txErr
+			}
+			return r, err
+		}
+		return r, txErr
+	})
+}
+
+func chargeFee(
+	state loomchain.State,
+	cfg *tx_fees.FeeConfig,
+	payer loom.Address,
+	fee *loom.BigUInt,
+	createCtx func(state loomchain.State) (contractpb.Context, error),
+	transfer func(ctx contractpb.Context, from, to loom.Address, amount *loom.BigUInt) error,
+) error {
+	ctx, err := createCtx(state)
+	if err != nil {
+		return errors.Wrap(err, "failed to create fee contract context")
+	}
+	var recipient loom.Address
+	if cfg.Denom == tx_fees.Denom_COIN {
+		recipient, err = ctx.Resolve("dposV3")
+		if err != nil {
+			return errors.Wrap(err, "failed to resolve DPOS contract address")
+		}
+	} else if cfg.Treasury != nil {
+		recipient = loom.UnmarshalAddressPB(cfg.Treasury)
+	}
+	if recipient.IsEmpty() {
+		return errors.New("tx fee recipient not set")
+	}
+	if err := transfer(ctx, payer, recipient, fee); err != nil {
+		if err == coin.ErrSenderBalanceTooLow || err == ethcoin.ErrSenderBalanceTooLow {
+			return ErrInsufficientFee
+		}
+		return errors.Wrap(err, "failed to transfer tx fee")
+	}
+	return nil
+}
+
+// persistentState returns a copy of the given state that reads & writes kvStore directly, so any
+// changes made via the returned state persist even if the current tx fails.
+func persistentState(state loomchain.State, kvStore store.KVStore) loomchain.State {
+	block := state.Block()
+	header := abci.Header{
+		ChainID: block.ChainID,
+		Height:  block.Height,
+		Time:    time.Unix(block.Time, 0),
+	}
+	return loomchain.NewStoreState(state.Context(), kvStore, header, block.CurrentHash, nil).
+		WithOnChainConfig(state.Config())
+}
@@ -0,0 +1,134 @@
+package throttle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/loomnetwork/go-loom"
+	goloomplugin "github.com/loomnetwork/go-loom/plugin"
+	"github.com/loomnetwork/go-loom/plugin/contractpb"
+	"github.com/loomnetwork/go-loom/types"
+	"github.com/loomnetwork/loomchain"
+	loomAuth "github.com/loomnetwork/loomchain/auth"
+	"github.com/loomnetwork/loomchain/builtin/plugins/coin"
+	"github.com/loomnetwork/loomchain/builtin/plugins/dposv3"
+	"github.com/loomnetwork/loomchain/builtin/plugins/tx_fees"
+	"github.com/loomnetwork/loomchain/features"
+	"github.com/loomnetwork/loomchain/store"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestTxFeeMiddleware(t *testing.T) {
+	user := loom.MustParseAddress("chain:0xb16a379ec18d4093666f8f38b11a3071c920207d")
+	payerPubKey, payerPrivKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	payer := loom.Address{ChainID: "chain", Local: loom.LocalAddressFromPublicKey(payerPubKey)}
+
+	fakeCtx := goloomplugin.CreateFakeContext(user, user)
+	coinAddr := fakeCtx.CreateContract(coin.Contract)
+	coinCtx := contractpb.WrapPluginContext(fakeCtx.WithAddress(coinAddr))
+	require.NoError(t, (&coin.Coin{}).Init(coinCtx, &coin.InitRequest{
+		Accounts: []*coin.InitialAccount{{Owner: payer.MarshalPB(), Balance: 1}},
+	}))
+	dposAddr := fakeCtx.CreateContract(dposv3.Contract)
+	feesAddr := fakeCtx.CreateContract(tx_fees.Contract)
+	feesCtx := contractpb.WrapPluginContext(fakeCtx.WithAddress(feesAddr))
+	require.NoError(t, (&tx_fees.TxFees{}).Init(feesCtx, &tx_fees.InitRequest{
+		Owner: user.MarshalPB(),
+		Config: &tx_fees.FeeConfig{
+			Denom:    tx_fees.Denom_COIN,
+			TxFee:    &types.BigUInt{Value: *loom.NewBigUIntFromInt(1000)},
+			GasPrice: &types.BigUInt{Value: *loom.NewBigUIntFromInt(10)},
+		},
+	}))
+
+	feeMiddleware := NewTxFeeMiddleware(
+		store.NewMemStore(),
+		func(state loomchain.State) (contractpb.StaticContext, error) { return feesCtx, nil },
+		func(state loomchain.State) (contractpb.Context, error) { return coinCtx, nil },
+		func(state loomchain.State) (contractpb.Context, error) { return nil, nil },
+	)
+	feePayerMiddleware := NewFeePayerMiddleware()
+
+	state := loomchain.NewStoreState(nil, store.NewMemStore(), abci.Header{ChainID: "chain"}, nil, nil)
+	state.SetFeature(features.TxFeesFeature, true)
+
+	// Run the tx through the fee payer middleware, then through the fee middleware as the auth
+	// middleware would.
+	var txErr error
+	processTx := func(txBytes []byte, isCheckTx bool) error {
+		_, err := feePayerMiddleware.ProcessTx(state, txBytes,
+			func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+				ctx := context.WithValue(state.Context(), loomAuth.ContextKeyOrigin, user)
+				return feeMiddleware.ProcessTx(state.WithContext(ctx), txBytes,
+					func(state loomchain.State, txBytes []byte, isCheckTx bool) (loomchain.TxHandlerResult, error) {
+						if !isCheckTx {
+							loomchain.GasMeterFromContext(state.Context()).ConsumeGas(50)
+						}
+						return loomchain.TxHandlerResult{}, txErr
+					}, isCheckTx,
+				)
+			}, isCheckTx,
+		)
+		return err
+	}
+
+	userPubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	inner, err := proto.Marshal(&loomAuth.NonceTx{Inner: []byte{1, 2, 3}, Sequence: 1})
+	require.NoError(t, err)
+	sponsor := func(originPubKey []byte, signBytes []byte) []byte {
+		txBytes, err := proto.Marshal(&tx_fees.SponsoredTx{
+			Inner:     inner,
+			PublicKey: originPubKey,
+			FeePayer: &tx_fees.FeePayerSignature{
+				PublicKey: payerPubKey,
+				Signature: ed25519.Sign(payerPrivKey, signBytes),
+			},
+		})
+		require.NoError(t, err)
+		return txBytes
+	}
+
+	unsponsoredTx, err := proto.Marshal(&tx_fees.SponsoredTx{Inner: inner, PublicKey: userPubKey})
+	require.NoError(t, err)
+	sponsoredTx := sponsor(userPubKey, tx_fees.FeePayerSignBytes("chain", userPubKey, 1, inner))
+	forgedTx := sponsor(userPubKey, []byte{4, 5, 6})
+	// the sponsorship is bound to the origin, nonce & chain it was signed for
+	otherOriginTx := sponsor(otherPubKey, tx_fees.FeePayerSignBytes("chain", userPubKey, 1, inner))
+	otherNonceTx := sponsor(userPubKey, tx_fees.FeePayerSignBytes("chain", userPubKey, 2, inner))
+	otherChainTx := sponsor(userPubKey, tx_fees.FeePayerSignBytes("other", userPubKey, 1, inner))
+
+	// the user has no coins so can't pay for their own txs
+	require.Equal(t, ErrInsufficientFee, processTx(unsponsoredTx, true))
+	require.Error(t, processTx(forgedTx, true))
+	require.Error(t, processTx(otherOriginTx, true))
+	require.Error(t, processTx(otherNonceTx, true))
+	require.Error(t, processTx(otherChainTx, true))
+	require.NoError(t, processTx(sponsoredTx, true))
+	require.NoError(t, processTx(sponsoredTx, false))
+
+	// the fee should've been transferred from the fee payer to the DPOS contract
+	balance, err := coin.BalanceOf(coinCtx, dposAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000+10*50), balance.Int64())
+
+	// failed txs are still charged for the gas they used
+	txErr = errors.New("tx failed")
+	require.Equal(t, txErr, processTx(sponsoredTx, false))
+	balance, err = coin.BalanceOf(coinCtx, dposAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(2*(1000+10*50)), balance.Int64())
+	txErr = nil
+
+	// no fees should be charged until the feature is enabled
+	state.SetFeature(features.TxFeesFeature, false)
+	require.NoError(t, processTx(unsponsoredTx, true))
+	require.NoError(t, processTx(unsponsoredTx, false))
+}
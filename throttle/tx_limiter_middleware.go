@@ -66,7 +66,8 @@ func (txl *txLimiter) isAccountLimitReached(account loom.Address) bool {
 // NewTxLimiterMiddleware creates middleware that throttles txs (all types) in CheckTx, the rate
 // can be configured in loom.yml. Since this middleware only runs in CheckTx the rate limit can
 // differ between nodes on the same cluster, and private nodes don't really need to run the rate
-// limiter at all.
+// limiter at all. Use the BlockTxLimiterMiddleware to enforce a rate limit on all nodes, this
+// middleware can still be used as a pre-filter in front of it.
 func NewTxLimiterMiddleware(cfg *TxLimiterConfig) loomchain.TxMiddlewareFunc {
 	txl := newTxLimiter(cfg)
 	return loomchain.TxMiddlewareFunc(func(